@@ -17,6 +17,8 @@ const (
 	AgentDeploymentNameLabelKey = "agent.datadoghq.com/name"
 	// AgentDeploymentComponentLabelKey label key use to know with component is it
 	AgentDeploymentComponentLabelKey = "agent.datadoghq.com/component"
+	// AgentProfileLabelKey label key use to know which profile an Agent DaemonSet belongs to
+	AgentProfileLabelKey = "agent.datadoghq.com/profile"
 	// MD5AgentDeploymentAnnotationKey annotation key used on a Resource in order to identify which AgentDeployment have been used to generate it.
 	MD5AgentDeploymentAnnotationKey = "agent.datadoghq.com/agentspechash"
 	// MD5ChecksumAnnotationKey annotation key is used to identify customConfig configurations
@@ -223,6 +225,8 @@ const (
 	APMSocketVolumeLocalPath                         = "/var/run/datadog"
 	APMSocketName                                    = "apm.socket"
 	AdmissionControllerPortName                      = "admissioncontrollerport"
+	AdmissionControllerHostIPCommunicationMode       = "hostip"
+	AdmissionControllerServiceCommunicationMode      = "service"
 	AdmissionControllerSocketCommunicationMode       = "socket"
 	ExternalMetricsPortName                          = "metricsapi"
 	ExternalMetricsAPIServiceName                    = "v1beta1.external.metrics.k8s.io"
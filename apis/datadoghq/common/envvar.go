@@ -8,6 +8,7 @@ package common
 // Datadog env var names
 const (
 	DatadogHost                                       = "DATADOG_HOST"
+	DDAdditionalEndpoints                             = "DD_ADDITIONAL_ENDPOINTS"
 	DDAdmissionControllerEnabled                      = "DD_ADMISSION_CONTROLLER_ENABLED"
 	DDAdmissionControllerInjectConfig                 = "DD_ADMISSION_CONTROLLER_INJECT_CONFIG_ENABLED"
 	DDAdmissionControllerInjectConfigMode             = "DD_ADMISSION_CONTROLLER_INJECT_CONFIG_MODE"
@@ -17,8 +18,14 @@ const (
 	DDAdmissionControllerServiceName                  = "DD_ADMISSION_CONTROLLER_SERVICE_NAME"
 	DDAdmissionControllerFailurePolicy                = "DD_ADMISSION_CONTROLLER_FAILURE_POLICY"
 	DDAdmissionControllerWebhookName                  = "DD_ADMISSION_CONTROLLER_WEBHOOK_NAME"
+	DDAdmissionControllerAutoInstrumentationRegistry  = "DD_ADMISSION_CONTROLLER_AUTO_INSTRUMENTATION_CONTAINER_REGISTRY"
 	DDAPIKey                                          = "DD_API_KEY"
+	DDAPMAdditionalEndpoints                          = "DD_APM_ADDITIONAL_ENDPOINTS"
 	DDAPMEnabled                                      = "DD_APM_ENABLED"
+	DDAPMInstrumentationDisabledNamespaces            = "DD_APM_INSTRUMENTATION_DISABLED_NAMESPACES"
+	DDAPMInstrumentationEnabled                       = "DD_APM_INSTRUMENTATION_ENABLED"
+	DDAPMInstrumentationEnabledNamespaces             = "DD_APM_INSTRUMENTATION_ENABLED_NAMESPACES"
+	DDAPMInstrumentationLibVersions                   = "DD_APM_INSTRUMENTATION_LIB_VERSIONS"
 	DDAPMNonLocalTraffic                              = "DD_APM_NON_LOCAL_TRAFFIC"
 	DDAPMReceiverPort                                 = "DD_APM_RECEIVER_PORT"
 	DDAPMReceiverSocket                               = "DD_APM_RECEIVER_SOCKET"
@@ -39,6 +46,12 @@ const (
 	DDComplianceConfigEnabled                         = "DD_COMPLIANCE_CONFIG_ENABLED"
 	DDComplianceHostBenchmarksEnabled                 = "DD_COMPLIANCE_HOST_BENCHMARKS_ENABLED"
 	DDContainerCollectionEnabled                      = "DD_PROCESS_CONFIG_CONTAINER_COLLECTION_ENABLED"
+	DDContainerExclude                                = "DD_CONTAINER_EXCLUDE"
+	DDContainerExcludeLogs                            = "DD_CONTAINER_EXCLUDE_LOGS"
+	DDContainerExcludeMetrics                         = "DD_CONTAINER_EXCLUDE_METRICS"
+	DDContainerInclude                                = "DD_CONTAINER_INCLUDE"
+	DDContainerIncludeLogs                            = "DD_CONTAINER_INCLUDE_LOGS"
+	DDContainerIncludeMetrics                         = "DD_CONTAINER_INCLUDE_METRICS"
 	DDCriSocketPath                                   = "DD_CRI_SOCKET_PATH"
 	DDddURL                                           = "DD_DD_URL"
 	DDDogstatsdEnabled                                = "DD_USE_DOGSTATSD"
@@ -73,6 +86,7 @@ const (
 	DDLeaderElection                                  = "DD_LEADER_ELECTION"
 	DDLeaderLeaseName                                 = "DD_LEADER_LEASE_NAME"
 	DDLogLevel                                        = "DD_LOG_LEVEL"
+	DDLogsConfigAdditionalEndpoints                   = "DD_LOGS_CONFIG_ADDITIONAL_ENDPOINTS"
 	DDLogsConfigContainerCollectAll                   = "DD_LOGS_CONFIG_CONTAINER_COLLECT_ALL"
 	DDLogsConfigOpenFilesLimit                        = "DD_LOGS_CONFIG_OPEN_FILES_LIMIT"
 	DDLogsContainerCollectUsingFiles                  = "DD_LOGS_CONFIG_K8S_CONTAINER_USE_FILE"
@@ -88,6 +102,7 @@ const (
 	DDPodLabelsAsTags                                 = "DD_KUBERNETES_POD_LABELS_AS_TAGS"
 	DDPodName                                         = "DD_POD_NAME"
 	DDPPMReceiverSocket                               = "DD_APM_RECEIVER_SOCKET"
+	DDProcessAdditionalEndpoints                      = "DD_PROCESS_ADDITIONAL_ENDPOINTS"
 	DDProcessCollectionEnabled                        = "DD_PROCESS_CONFIG_PROCESS_COLLECTION_ENABLED"
 	DDProcessConfigScrubArgs                          = "DD_PROCESS_CONFIG_SCRUB_ARGS"
 	DDProcessConfigStripArgs                          = "DD_PROCESS_CONFIG_STRIP_PROC_ARGUMENTS"
@@ -110,7 +125,9 @@ const (
 	DDSBOMContainerImageAnalyzers                     = "DD_SBOM_CONTAINER_IMAGE_ANALYZERS"
 	DDSBOMHostEnabled                                 = "DD_SBOM_HOST_ENABLED"
 	DDSBOMHostAnalyzers                               = "DD_SBOM_HOST_ANALYZERS"
+	DDSecretBackendArguments                          = "DD_SECRET_BACKEND_ARGUMENTS"
 	DDSecretBackendCommand                            = "DD_SECRET_BACKEND_COMMAND"
+	DDSecretBackendTimeout                            = "DD_SECRET_BACKEND_TIMEOUT"
 	DDSite                                            = "DD_SITE"
 	DDSystemProbeAgentEnabled                         = "DD_SYSTEM_PROBE_ENABLED"
 	DDSystemProbeBPFDebugEnabled                      = DDSystemProbeEnvPrefix + "BPF_DEBUG"
@@ -131,6 +148,16 @@ const (
 	DDOTLPgRPCEndpoint = "DD_OTLP_CONFIG_RECEIVER_PROTOCOLS_GRPC_ENDPOINT"
 	DDOTLPHTTPEndpoint = "DD_OTLP_CONFIG_RECEIVER_PROTOCOLS_HTTP_ENDPOINT"
 
+	DDOTLPLogsEnabled                               = "DD_OTLP_CONFIG_LOGS_ENABLED"
+	DDOTLPMetricsEnabled                            = "DD_OTLP_CONFIG_METRICS_ENABLED"
+	DDOTLPMetricsResourceAttributesAsTags           = "DD_OTLP_CONFIG_METRICS_RESOURCE_ATTRIBUTES_AS_TAGS"
+	DDOTLPMetricsInstrumentationScopeMetadataAsTags = "DD_OTLP_CONFIG_METRICS_INSTRUMENTATION_SCOPE_METADATA_AS_TAGS"
+	DDOTLPMetricsTagCardinality                     = "DD_OTLP_CONFIG_METRICS_TAG_CARDINALITY"
+	DDOTLPMetricsHistogramsMode                     = "DD_OTLP_CONFIG_METRICS_HISTOGRAMS_MODE"
+	DDOTLPMetricsHistogramsSendAggregationMetrics   = "DD_OTLP_CONFIG_METRICS_HISTOGRAMS_SEND_AGGREGATION_METRICS"
+	DDOTLPMetricsSummariesMode                      = "DD_OTLP_CONFIG_METRICS_SUMMARIES_MODE"
+	DDOTLPTracesEnabled                             = "DD_OTLP_CONFIG_TRACES_ENABLED"
+
 	// KubernetesEnvvarName Env var used by the Datadog Agent container entrypoint
 	// to add kubelet config provider and listener
 	KubernetesEnvVar = "KUBERNETES"
@@ -8,6 +8,7 @@ package v1alpha1
 import (
 	"fmt"
 
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
 	"github.com/DataDog/datadog-operator/apis/utils"
@@ -75,6 +76,9 @@ func convertSpec(src *DatadogAgentSpec, dst *v2alpha1.DatadogAgent) error {
 	convertClusterAgentSpec(&src.ClusterAgent, dst)
 	convertCCRSpec(&src.ClusterChecksRunner, dst)
 
+	// Convert after the specs, as they set the environment variables of the containers
+	convertOrchestratorExplorerAdditionalEndpoints(src.Features.OrchestratorExplorer, dst)
+
 	// Convert some settings
 	if src.ClusterName != "" {
 		getV2GlobalConfig(dst).ClusterName = &src.ClusterName
@@ -109,7 +113,7 @@ func convertFeatures(src DatadogFeatures, dst *v2alpha1.DatadogAgent) {
 		if src.OrchestratorExplorer.DDUrl != nil {
 			dstFeatures.OrchestratorExplorer.DDUrl = src.OrchestratorExplorer.DDUrl
 		}
-		// TODO: Handle src ClusterChecks + AdditionalEndpoints, seems to be missing from V2
+		// TODO: Handle src ClusterChecks, seems to be missing from V2
 	}
 
 	if src.KubeStateMetricsCore != nil {
@@ -147,6 +151,25 @@ func convertFeatures(src DatadogFeatures, dst *v2alpha1.DatadogAgent) {
 	}
 }
 
+// convertOrchestratorExplorerAdditionalEndpoints carries the Orchestrator Explorer additional endpoints over as environment variables,
+// as they embed the API keys while the v2alpha1 additional endpoints read them from secrets.
+func convertOrchestratorExplorerAdditionalEndpoints(src *OrchestratorExplorerConfig, dst *v2alpha1.DatadogAgent) {
+	if src == nil || src.AdditionalEndpoints == nil {
+		return
+	}
+
+	envVar := v1.EnvVar{
+		Name:  apicommon.DDOrchestratorExplorerAdditionalEndpoints,
+		Value: *src.AdditionalEndpoints,
+	}
+
+	clusterAgent := getV2Container(getV2TemplateOverride(&dst.Spec, v2alpha1.ClusterAgentComponentName), commonv1.ClusterAgentContainerName)
+	clusterAgent.Env = append(clusterAgent.Env, envVar)
+
+	processAgent := getV2Container(getV2TemplateOverride(&dst.Spec, v2alpha1.NodeAgentComponentName), commonv1.ProcessAgentContainerName)
+	processAgent.Env = append(processAgent.Env, envVar)
+}
+
 // Converting internal structs
 func convertCredentials(src *DatadogCredentials) *v2alpha1.DatadogCredentials {
 	if src == nil {
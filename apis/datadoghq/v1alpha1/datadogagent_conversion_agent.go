@@ -31,6 +31,22 @@ func convertDatadogAgentSpec(src *DatadogAgentSpecAgentSpec, dst *v2alpha1.Datad
 		getV2TemplateOverride(&dst.Spec, v2alpha1.NodeAgentComponentName).Name = &src.DaemonsetName
 	}
 
+	if src.DeploymentStrategy != nil {
+		// RollingUpdate fields only supported by the ExtendedDaemonSet and ReconcileFrequency are not forwarded
+		updateStrategy := &v2alpha1.UpdateStrategy{
+			Canary: src.DeploymentStrategy.Canary,
+		}
+		if src.DeploymentStrategy.UpdateStrategyType != nil {
+			updateStrategy.Type = *src.DeploymentStrategy.UpdateStrategyType
+		}
+		if src.DeploymentStrategy.RollingUpdate.MaxUnavailable != nil {
+			updateStrategy.RollingUpdate = &v2alpha1.RollingUpdate{
+				MaxUnavailable: src.DeploymentStrategy.RollingUpdate.MaxUnavailable,
+			}
+		}
+		getV2TemplateOverride(&dst.Spec, v2alpha1.NodeAgentComponentName).UpdateStrategy = updateStrategy
+	}
+
 	if src.Config != nil {
 		if src.Config.SecurityContext != nil {
 			getV2TemplateOverride(&dst.Spec, v2alpha1.NodeAgentComponentName).SecurityContext = src.Config.SecurityContext
@@ -0,0 +1,214 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+)
+
+// DatadogDashboardSpec defines the desired state of a DatadogDashboard.
+// The dashboard is defined either with a list of widgets, or with its JSON definition.
+// +k8s:openapi-gen=true
+type DatadogDashboardSpec struct {
+	// Title is the title of the dashboard. Required unless the title is set in DashboardJSON,
+	// which it overrides when both are set.
+	Title string `json:"title,omitempty"`
+
+	// Description is the description of the dashboard. It overrides the description set in DashboardJSON.
+	Description string `json:"description,omitempty"`
+
+	// LayoutType is the layout type of the dashboard: ordered (default) or free.
+	LayoutType DatadogDashboardLayoutType `json:"layoutType,omitempty"`
+
+	// Widgets is the list of widgets of the dashboard. Required unless DashboardJSON is set.
+	// +listType=atomic
+	Widgets []DatadogDashboardWidget `json:"widgets,omitempty"`
+
+	// TemplateVariables is the list of template variables of the dashboard.
+	// +listType=map
+	// +listMapKey=name
+	TemplateVariables []DatadogDashboardTemplateVariable `json:"templateVariables,omitempty"`
+
+	// Tags is the list of tags to associate with the dashboard. They are added to the tags set in DashboardJSON.
+	// +listType=set
+	Tags []string `json:"tags,omitempty"`
+
+	// DashboardJSON is the JSON definition of the dashboard, as exported from Datadog.
+	// It cannot be used with Widgets, LayoutType, or TemplateVariables.
+	DashboardJSON *DatadogDashboardJSON `json:"dashboardJSON,omitempty"`
+}
+
+// DatadogDashboardLayoutType is the layout type of a dashboard
+type DatadogDashboardLayoutType string
+
+const (
+	// DatadogDashboardLayoutTypeOrdered places the widgets in a grid
+	DatadogDashboardLayoutTypeOrdered DatadogDashboardLayoutType = "ordered"
+	// DatadogDashboardLayoutTypeFree places the widgets at the position defined by their layout
+	DatadogDashboardLayoutTypeFree DatadogDashboardLayoutType = "free"
+)
+
+// IsValid returns true if the layout type is supported
+func (l DatadogDashboardLayoutType) IsValid() bool {
+	switch l {
+	case DatadogDashboardLayoutTypeOrdered, DatadogDashboardLayoutTypeFree:
+		return true
+	default:
+		return false
+	}
+}
+
+// DatadogDashboardWidget defines a widget of a dashboard
+// +k8s:openapi-gen=true
+type DatadogDashboardWidget struct {
+	// Type is the type of the widget, for instance timeseries, query_value, toplist, or note.
+	Type string `json:"type"`
+
+	// Title is the title of the widget.
+	Title string `json:"title,omitempty"`
+
+	// Requests are the queries displayed by the widget.
+	// +listType=atomic
+	Requests []DatadogDashboardWidgetRequest `json:"requests,omitempty"`
+
+	// Content is the content of note widgets, in Markdown.
+	Content string `json:"content,omitempty"`
+
+	// Layout is the position and size of the widget. Required if the layout type of the dashboard is free.
+	Layout *DatadogDashboardWidgetLayout `json:"layout,omitempty"`
+}
+
+// DatadogDashboardWidgetRequest defines a query displayed by a widget
+// +k8s:openapi-gen=true
+type DatadogDashboardWidgetRequest struct {
+	// Query is the metric query of the request, for instance `avg:system.cpu.user{*} by {host}`.
+	Query string `json:"query"`
+
+	// DisplayType is how the query is displayed by timeseries widgets: line, bars, or area.
+	DisplayType string `json:"displayType,omitempty"`
+
+	// Aggregator is the aggregator used by query value and toplist widgets, for instance avg or last.
+	Aggregator string `json:"aggregator,omitempty"`
+}
+
+// DatadogDashboardWidgetLayout defines the position and size of a widget
+// +k8s:openapi-gen=true
+type DatadogDashboardWidgetLayout struct {
+	// X is the position of the widget on the x axis.
+	X int64 `json:"x"`
+
+	// Y is the position of the widget on the y axis.
+	Y int64 `json:"y"`
+
+	// Width is the width of the widget.
+	Width int64 `json:"width"`
+
+	// Height is the height of the widget.
+	Height int64 `json:"height"`
+}
+
+// DatadogDashboardTemplateVariable defines a template variable of a dashboard
+// +k8s:openapi-gen=true
+type DatadogDashboardTemplateVariable struct {
+	// Name is the name of the variable.
+	Name string `json:"name"`
+
+	// Prefix is the tag prefix associated with the variable, for instance `env`.
+	Prefix string `json:"prefix,omitempty"`
+
+	// Defaults is the list of default values of the variable.
+	// +listType=atomic
+	Defaults []string `json:"defaults,omitempty"`
+}
+
+// DatadogDashboardJSON defines the JSON definition of a dashboard. Only one of Inline and ConfigMap can be set.
+// +k8s:openapi-gen=true
+type DatadogDashboardJSON struct {
+	// Inline is the JSON definition of the dashboard.
+	Inline string `json:"inline,omitempty"`
+
+	// ConfigMap references a ConfigMap of the namespace of the DatadogDashboard that holds the JSON definition of the dashboard.
+	// The definition is read from the key of the first item, `dashboard.json` by default. The path of the item is ignored.
+	ConfigMap *commonv1.ConfigMapConfig `json:"configMap,omitempty"`
+}
+
+// DatadogDashboardStatus defines the observed state of a DatadogDashboard
+// +k8s:openapi-gen=true
+type DatadogDashboardStatus struct {
+	// Conditions represents the latest available observations of the state of a DatadogDashboard.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ID is the dashboard ID generated in Datadog.
+	ID string `json:"id,omitempty"`
+
+	// URL is the path of the dashboard in the Datadog app, for instance `/dashboard/abc-def-ghi/service-overview`.
+	URL string `json:"url,omitempty"`
+
+	// Creator is the identity of the dashboard creator.
+	Creator string `json:"creator,omitempty"`
+
+	// Created is the time the dashboard was created.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// SyncStatus shows the health of syncing the dashboard state to Datadog.
+	SyncStatus DatadogDashboardSyncStatus `json:"syncStatus,omitempty"`
+
+	// LastForceSyncTime is the last time the API dashboard was last force synced with the DatadogDashboard resource.
+	LastForceSyncTime *metav1.Time `json:"lastForceSyncTime,omitempty"`
+
+	// CurrentHash tracks the hash of the current DatadogDashboardSpec, and of the JSON definition read from
+	// the ConfigMap, to know if the dashboard has changed and needs an update.
+	CurrentHash string `json:"currentHash,omitempty"`
+}
+
+// DatadogDashboardSyncStatus is the message reflecting the health of dashboard state syncs to Datadog.
+type DatadogDashboardSyncStatus string
+
+const (
+	// DatadogDashboardSyncStatusOK means syncing is OK.
+	DatadogDashboardSyncStatusOK DatadogDashboardSyncStatus = "OK"
+	// DatadogDashboardSyncStatusValidateError means there is a dashboard validation error.
+	DatadogDashboardSyncStatusValidateError DatadogDashboardSyncStatus = "error validating dashboard"
+	// DatadogDashboardSyncStatusCreateError means there is an error creating the dashboard.
+	DatadogDashboardSyncStatusCreateError DatadogDashboardSyncStatus = "error creating dashboard"
+	// DatadogDashboardSyncStatusUpdateError means there is a dashboard update error.
+	DatadogDashboardSyncStatusUpdateError DatadogDashboardSyncStatus = "error updating dashboard"
+	// DatadogDashboardSyncStatusGetError means there is an error getting the dashboard.
+	DatadogDashboardSyncStatusGetError DatadogDashboardSyncStatus = "error getting dashboard"
+)
+
+// DatadogDashboard allows to define and manage Datadog dashboards from your Kubernetes Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=datadogdashboards,scope=Namespaced,shortName=dddashboard
+// +kubebuilder:printcolumn:name="id",type="string",JSONPath=".status.id"
+// +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogDashboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogDashboardSpec   `json:"spec,omitempty"`
+	Status DatadogDashboardStatus `json:"status,omitempty"`
+}
+
+// DatadogDashboardList contains a list of DatadogDashboards
+// +kubebuilder:object:root=true
+type DatadogDashboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogDashboard `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogDashboard{}, &DatadogDashboardList{})
+}
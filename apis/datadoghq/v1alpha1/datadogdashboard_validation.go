@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// IsValidDatadogDashboard use to check if a DatadogDashboardSpec is valid by checking
+// that the required fields are defined and consistent
+func IsValidDatadogDashboard(spec *DatadogDashboardSpec) error {
+	var errs []error
+	if spec.DashboardJSON != nil {
+		errs = append(errs, isValidDatadogDashboardJSON(spec)...)
+		return utilserrors.NewAggregate(errs)
+	}
+
+	if spec.Title == "" {
+		errs = append(errs, fmt.Errorf("spec.Title must be defined"))
+	}
+
+	if len(spec.Widgets) == 0 {
+		errs = append(errs, fmt.Errorf("spec.Widgets or spec.DashboardJSON must be defined"))
+	}
+
+	if spec.LayoutType != "" && !spec.LayoutType.IsValid() {
+		errs = append(errs, fmt.Errorf("spec.LayoutType must be one of the values: %s or %s", DatadogDashboardLayoutTypeOrdered, DatadogDashboardLayoutTypeFree))
+	}
+
+	for _, widget := range spec.Widgets {
+		if widget.Type == "" {
+			errs = append(errs, fmt.Errorf("spec.Widgets must define a Type"))
+		}
+		if spec.LayoutType == DatadogDashboardLayoutTypeFree && widget.Layout == nil {
+			errs = append(errs, fmt.Errorf("spec.Widgets must define a Layout when spec.LayoutType is %s", DatadogDashboardLayoutTypeFree))
+		}
+		for _, request := range widget.Requests {
+			if request.Query == "" {
+				errs = append(errs, fmt.Errorf("spec.Widgets.Requests must define a Query"))
+			}
+		}
+	}
+
+	for _, variable := range spec.TemplateVariables {
+		if variable.Name == "" {
+			errs = append(errs, fmt.Errorf("spec.TemplateVariables must define a Name"))
+		}
+	}
+
+	return utilserrors.NewAggregate(errs)
+}
+
+func isValidDatadogDashboardJSON(spec *DatadogDashboardSpec) []error {
+	var errs []error
+	if len(spec.Widgets) > 0 || spec.LayoutType != "" || len(spec.TemplateVariables) > 0 {
+		errs = append(errs, fmt.Errorf("spec.Widgets, spec.LayoutType, and spec.TemplateVariables cannot be used with spec.DashboardJSON"))
+	}
+
+	dashboardJSON := spec.DashboardJSON
+	switch {
+	case dashboardJSON.Inline != "" && dashboardJSON.ConfigMap != nil:
+		errs = append(errs, fmt.Errorf("only one of spec.DashboardJSON.Inline and spec.DashboardJSON.ConfigMap can be defined"))
+	case dashboardJSON.Inline != "":
+		if !json.Valid([]byte(dashboardJSON.Inline)) {
+			errs = append(errs, fmt.Errorf("spec.DashboardJSON.Inline must be a valid JSON document"))
+		}
+	case dashboardJSON.ConfigMap != nil:
+		if dashboardJSON.ConfigMap.Name == "" {
+			errs = append(errs, fmt.Errorf("spec.DashboardJSON.ConfigMap.Name must be defined"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("spec.DashboardJSON.Inline or spec.DashboardJSON.ConfigMap must be defined"))
+	}
+
+	return errs
+}
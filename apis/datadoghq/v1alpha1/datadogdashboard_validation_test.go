@@ -0,0 +1,123 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+
+	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+)
+
+func TestIsValidDatadogDashboard(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *DatadogDashboardSpec
+		expected error
+	}{
+		{
+			name: "Valid dashboard with widgets",
+			spec: &DatadogDashboardSpec{
+				Title:      "Service overview",
+				LayoutType: DatadogDashboardLayoutTypeFree,
+				Widgets: []DatadogDashboardWidget{
+					{
+						Type:     "timeseries",
+						Requests: []DatadogDashboardWidgetRequest{{Query: "avg:system.cpu.user{*}"}},
+						Layout:   &DatadogDashboardWidgetLayout{X: 0, Y: 0, Width: 4, Height: 2},
+					},
+				},
+				TemplateVariables: []DatadogDashboardTemplateVariable{{Name: "env", Prefix: "env"}},
+			},
+			expected: nil,
+		},
+		{
+			name: "Valid dashboard with a JSON definition from a ConfigMap",
+			spec: &DatadogDashboardSpec{
+				DashboardJSON: &DatadogDashboardJSON{
+					ConfigMap: &commonv1.ConfigMapConfig{Name: "dashboards"},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing title and widgets",
+			spec: &DatadogDashboardSpec{
+				LayoutType: "grid",
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Title must be defined"),
+					errors.New("spec.Widgets or spec.DashboardJSON must be defined"),
+					errors.New("spec.LayoutType must be one of the values: ordered or free"),
+				},
+			),
+		},
+		{
+			name: "Invalid widgets",
+			spec: &DatadogDashboardSpec{
+				Title:      "Service overview",
+				LayoutType: DatadogDashboardLayoutTypeFree,
+				Widgets: []DatadogDashboardWidget{
+					{Requests: []DatadogDashboardWidgetRequest{{DisplayType: "bars"}}},
+				},
+				TemplateVariables: []DatadogDashboardTemplateVariable{{Prefix: "env"}},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Widgets must define a Type"),
+					errors.New("spec.Widgets must define a Layout when spec.LayoutType is free"),
+					errors.New("spec.Widgets.Requests must define a Query"),
+					errors.New("spec.TemplateVariables must define a Name"),
+				},
+			),
+		},
+		{
+			name: "Widgets and JSON definition",
+			spec: &DatadogDashboardSpec{
+				Widgets: []DatadogDashboardWidget{{Type: "note", Content: "Hello"}},
+				DashboardJSON: &DatadogDashboardJSON{
+					Inline:    `{"title": "Service overview"}`,
+					ConfigMap: &commonv1.ConfigMapConfig{Name: "dashboards"},
+				},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Widgets, spec.LayoutType, and spec.TemplateVariables cannot be used with spec.DashboardJSON"),
+					errors.New("only one of spec.DashboardJSON.Inline and spec.DashboardJSON.ConfigMap can be defined"),
+				},
+			),
+		},
+		{
+			name: "Invalid inline JSON definition",
+			spec: &DatadogDashboardSpec{
+				DashboardJSON: &DatadogDashboardJSON{Inline: `{"title": `},
+			},
+			expected: errors.New("spec.DashboardJSON.Inline must be a valid JSON document"),
+		},
+		{
+			name: "Empty JSON definition",
+			spec: &DatadogDashboardSpec{
+				DashboardJSON: &DatadogDashboardJSON{},
+			},
+			expected: errors.New("spec.DashboardJSON.Inline or spec.DashboardJSON.ConfigMap must be defined"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidDatadogDashboard(tt.spec)
+			if tt.expected != nil {
+				assert.EqualError(t, result, tt.expected.Error())
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
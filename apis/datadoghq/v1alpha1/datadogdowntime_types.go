@@ -0,0 +1,190 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogDowntimeSpec defines the desired state of a DatadogDowntime
+// +k8s:openapi-gen=true
+type DatadogDowntimeSpec struct {
+	// Scope is the list of scopes to which the downtime applies, for example `env:prod`.
+	// Use `*` to apply the downtime to all the groups of the selected monitors.
+	// +listType=set
+	Scope []string `json:"scope"`
+
+	// MonitorSelector selects the monitors to which the downtime applies.
+	// If not set, the downtime applies to all monitors within the scope.
+	MonitorSelector *DatadogDowntimeMonitorSelector `json:"monitorSelector,omitempty"`
+
+	// Start is the time the downtime starts. If not set, the downtime starts as soon as it is created.
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the time the downtime ends. If not set, the downtime continues until it is deleted.
+	End *metav1.Time `json:"end,omitempty"`
+
+	// Timezone is the timezone in which to display the downtime's start and end times in Datadog applications.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Message is a message to include with notifications for this downtime.
+	Message string `json:"message,omitempty"`
+
+	// Recurrence defines how the downtime repeats.
+	Recurrence *DatadogDowntimeRecurrence `json:"recurrence,omitempty"`
+
+	// MuteFirstRecoveryNotification mutes the first recovery notification during the downtime.
+	MuteFirstRecoveryNotification *bool `json:"muteFirstRecoveryNotification,omitempty"`
+}
+
+// DatadogDowntimeMonitorSelector selects the monitors to which a downtime applies.
+// Only one of LabelSelector and MonitorTags can be set.
+// +k8s:openapi-gen=true
+type DatadogDowntimeMonitorSelector struct {
+	// LabelSelector selects DatadogMonitor resources in the namespace of the DatadogDowntime.
+	// A downtime is created in Datadog for each selected monitor.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+
+	// MonitorTags is a list of monitor tags. The downtime applies to the monitors that have all of these tags.
+	// +listType=set
+	MonitorTags []string `json:"monitorTags,omitempty"`
+}
+
+// DatadogDowntimeRecurrence defines how a downtime repeats
+// +k8s:openapi-gen=true
+type DatadogDowntimeRecurrence struct {
+	// Type is the type of recurrence.
+	Type DatadogDowntimeRecurrenceType `json:"type"`
+
+	// Period is how often to repeat, as an integer. For example, to repeat every 3 days, select a type of `days` and a period of `3`.
+	Period int32 `json:"period,omitempty"`
+
+	// WeekDays is the list of week days to repeat on. Only applicable when type is `weeks`.
+	// Choose from `Mon`, `Tue`, `Wed`, `Thu`, `Fri`, `Sat`, or `Sun`.
+	// +listType=set
+	WeekDays []string `json:"weekDays,omitempty"`
+
+	// UntilDate is the time at which the recurrence should end. Only one of UntilDate and UntilOccurrences can be set.
+	UntilDate *metav1.Time `json:"untilDate,omitempty"`
+
+	// UntilOccurrences is how many times the downtime is rescheduled. Only one of UntilDate and UntilOccurrences can be set.
+	UntilOccurrences *int32 `json:"untilOccurrences,omitempty"`
+}
+
+// DatadogDowntimeRecurrenceType defines the type of recurrence of a downtime
+type DatadogDowntimeRecurrenceType string
+
+const (
+	// DatadogDowntimeRecurrenceTypeDays repeats the downtime every `period` days
+	DatadogDowntimeRecurrenceTypeDays DatadogDowntimeRecurrenceType = "days"
+	// DatadogDowntimeRecurrenceTypeWeeks repeats the downtime every `period` weeks
+	DatadogDowntimeRecurrenceTypeWeeks DatadogDowntimeRecurrenceType = "weeks"
+	// DatadogDowntimeRecurrenceTypeMonths repeats the downtime every `period` months
+	DatadogDowntimeRecurrenceTypeMonths DatadogDowntimeRecurrenceType = "months"
+	// DatadogDowntimeRecurrenceTypeYears repeats the downtime every `period` years
+	DatadogDowntimeRecurrenceTypeYears DatadogDowntimeRecurrenceType = "years"
+)
+
+// IsValid returns true if the recurrence type is supported by the Datadog API
+func (t DatadogDowntimeRecurrenceType) IsValid() bool {
+	switch t {
+	case DatadogDowntimeRecurrenceTypeDays, DatadogDowntimeRecurrenceTypeWeeks, DatadogDowntimeRecurrenceTypeMonths, DatadogDowntimeRecurrenceTypeYears:
+		return true
+	default:
+		return false
+	}
+}
+
+// DatadogDowntimeStatus defines the observed state of a DatadogDowntime
+// +k8s:openapi-gen=true
+type DatadogDowntimeStatus struct {
+	// Conditions represents the latest available observations of the state of a DatadogDowntime.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Downtimes are the downtimes created in Datadog: one per selected DatadogMonitor when the MonitorSelector
+	// uses a LabelSelector, a single one otherwise.
+	// +listType=atomic
+	Downtimes []DatadogDowntimeInstance `json:"downtimes,omitempty"`
+
+	// Active is true when at least one of the downtimes is currently active.
+	Active bool `json:"active,omitempty"`
+
+	// SyncStatus shows the health of syncing the downtime state to Datadog.
+	SyncStatus DatadogDowntimeSyncStatus `json:"syncStatus,omitempty"`
+
+	// LastForceSyncTime is the last time the API downtimes were last force synced with the DatadogDowntime resource.
+	LastForceSyncTime *metav1.Time `json:"lastForceSyncTime,omitempty"`
+
+	// StateLastUpdateTime is the last time the downtime state was updated.
+	StateLastUpdateTime *metav1.Time `json:"stateLastUpdateTime,omitempty"`
+
+	// CurrentHash tracks the hash of the current DatadogDowntimeSpec and selected monitors to know
+	// if the downtimes need an update.
+	CurrentHash string `json:"currentHash,omitempty"`
+}
+
+// DatadogDowntimeInstance represents a downtime created in Datadog
+// +k8s:openapi-gen=true
+type DatadogDowntimeInstance struct {
+	// ID is the downtime ID generated in Datadog.
+	ID int64 `json:"id"`
+
+	// MonitorID is the ID of the monitor the downtime applies to, if the downtime is specific to a monitor.
+	MonitorID int64 `json:"monitorID,omitempty"`
+
+	// Monitor is the namespace/name of the DatadogMonitor the downtime applies to, if any.
+	Monitor string `json:"monitor,omitempty"`
+
+	// Active is true when the downtime is currently active.
+	Active bool `json:"active,omitempty"`
+}
+
+// DatadogDowntimeSyncStatus is the message reflecting the health of downtime state syncs to Datadog.
+type DatadogDowntimeSyncStatus string
+
+const (
+	// DatadogDowntimeSyncStatusOK means syncing is OK.
+	DatadogDowntimeSyncStatusOK DatadogDowntimeSyncStatus = "OK"
+	// DatadogDowntimeSyncStatusValidateError means there is a downtime validation error.
+	DatadogDowntimeSyncStatusValidateError DatadogDowntimeSyncStatus = "error validating downtime"
+	// DatadogDowntimeSyncStatusCreateError means there is an error creating the downtime.
+	DatadogDowntimeSyncStatusCreateError DatadogDowntimeSyncStatus = "error creating downtime"
+	// DatadogDowntimeSyncStatusUpdateError means there is a downtime update error.
+	DatadogDowntimeSyncStatusUpdateError DatadogDowntimeSyncStatus = "error updating downtime"
+	// DatadogDowntimeSyncStatusGetError means there is an error getting the downtime.
+	DatadogDowntimeSyncStatusGetError DatadogDowntimeSyncStatus = "error getting downtime"
+)
+
+// DatadogDowntime allows to define and manage downtimes from your Kubernetes Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=datadogdowntimes,scope=Namespaced,shortName=dddowntime
+// +kubebuilder:printcolumn:name="active",type="boolean",JSONPath=".status.active"
+// +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogDowntime struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogDowntimeSpec   `json:"spec,omitempty"`
+	Status DatadogDowntimeStatus `json:"status,omitempty"`
+}
+
+// DatadogDowntimeList contains a list of DatadogDowntimes
+// +kubebuilder:object:root=true
+type DatadogDowntimeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogDowntime `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogDowntime{}, &DatadogDowntimeList{})
+}
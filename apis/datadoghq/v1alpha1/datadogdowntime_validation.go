@@ -0,0 +1,53 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// IsValidDatadogDowntime use to check if a DatadogDowntimeSpec is valid by checking
+// that the required fields are defined and consistent
+func IsValidDatadogDowntime(spec *DatadogDowntimeSpec) error {
+	var errs []error
+	if len(spec.Scope) == 0 {
+		errs = append(errs, fmt.Errorf("spec.Scope must be defined"))
+	}
+
+	if spec.MonitorSelector != nil && spec.MonitorSelector.LabelSelector != nil && len(spec.MonitorSelector.MonitorTags) > 0 {
+		errs = append(errs, fmt.Errorf("spec.MonitorSelector.LabelSelector and spec.MonitorSelector.MonitorTags cannot be both defined"))
+	}
+
+	if spec.Start != nil && spec.End != nil && !spec.Start.Before(spec.End) {
+		errs = append(errs, fmt.Errorf("spec.End must be after spec.Start"))
+	}
+
+	if spec.Recurrence != nil {
+		if !spec.Recurrence.Type.IsValid() {
+			errs = append(errs, fmt.Errorf("spec.Recurrence.Type must be one of the values: %s, %s, %s or %s", DatadogDowntimeRecurrenceTypeDays, DatadogDowntimeRecurrenceTypeWeeks, DatadogDowntimeRecurrenceTypeMonths, DatadogDowntimeRecurrenceTypeYears))
+		}
+
+		if spec.Recurrence.Period < 0 {
+			errs = append(errs, fmt.Errorf("spec.Recurrence.Period must be a positive integer"))
+		}
+
+		if len(spec.Recurrence.WeekDays) > 0 && spec.Recurrence.Type != DatadogDowntimeRecurrenceTypeWeeks {
+			errs = append(errs, fmt.Errorf("spec.Recurrence.WeekDays can only be defined when spec.Recurrence.Type is %s", DatadogDowntimeRecurrenceTypeWeeks))
+		}
+
+		if spec.Recurrence.UntilDate != nil && spec.Recurrence.UntilOccurrences != nil {
+			errs = append(errs, fmt.Errorf("spec.Recurrence.UntilDate and spec.Recurrence.UntilOccurrences cannot be both defined"))
+		}
+
+		if spec.Start == nil {
+			errs = append(errs, fmt.Errorf("spec.Start must be defined when spec.Recurrence is defined"))
+		}
+	}
+
+	return utilserrors.NewAggregate(errs)
+}
@@ -0,0 +1,103 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+func TestIsValidDatadogDowntime(t *testing.T) {
+	start := metav1.NewTime(time.Date(2023, time.March, 1, 8, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(start.Add(2 * time.Hour))
+	occurrences := int32(3)
+
+	tests := []struct {
+		name     string
+		spec     *DatadogDowntimeSpec
+		expected error
+	}{
+		{
+			name: "Valid spec",
+			spec: &DatadogDowntimeSpec{
+				Scope: []string{"env:prod"},
+				MonitorSelector: &DatadogDowntimeMonitorSelector{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}},
+				},
+				Start: &start,
+				End:   &end,
+				Recurrence: &DatadogDowntimeRecurrence{
+					Type:             DatadogDowntimeRecurrenceTypeWeeks,
+					Period:           1,
+					WeekDays:         []string{"Mon", "Wed"},
+					UntilOccurrences: &occurrences,
+				},
+			},
+			expected: nil,
+		},
+		{
+			name:     "Missing Scope",
+			spec:     &DatadogDowntimeSpec{},
+			expected: errors.New("spec.Scope must be defined"),
+		},
+		{
+			name: "Both LabelSelector and MonitorTags",
+			spec: &DatadogDowntimeSpec{
+				Scope: []string{"*"},
+				MonitorSelector: &DatadogDowntimeMonitorSelector{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}},
+					MonitorTags:   []string{"team:foo"},
+				},
+			},
+			expected: errors.New("spec.MonitorSelector.LabelSelector and spec.MonitorSelector.MonitorTags cannot be both defined"),
+		},
+		{
+			name: "End before Start",
+			spec: &DatadogDowntimeSpec{
+				Scope: []string{"*"},
+				Start: &end,
+				End:   &start,
+			},
+			expected: errors.New("spec.End must be after spec.Start"),
+		},
+		{
+			name: "Invalid recurrence",
+			spec: &DatadogDowntimeSpec{
+				Scope: []string{"*"},
+				Recurrence: &DatadogDowntimeRecurrence{
+					Type:             "hours",
+					WeekDays:         []string{"Mon"},
+					UntilDate:        &end,
+					UntilOccurrences: &occurrences,
+				},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Recurrence.Type must be one of the values: days, weeks, months or years"),
+					errors.New("spec.Recurrence.WeekDays can only be defined when spec.Recurrence.Type is weeks"),
+					errors.New("spec.Recurrence.UntilDate and spec.Recurrence.UntilOccurrences cannot be both defined"),
+					errors.New("spec.Start must be defined when spec.Recurrence is defined"),
+				},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidDatadogDowntime(tt.spec)
+			if tt.expected != nil {
+				assert.EqualError(t, result, tt.expected.Error())
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
@@ -73,39 +73,150 @@ const (
 type DatadogMonitorOptions struct {
 	// A Boolean indicating whether to send a log sample when the log monitor triggers.
 	EnableLogsSample *bool `json:"enableLogsSample,omitempty"`
+	// A Boolean indicating whether to send a list of samples when the monitor triggers. This is only used by CI Test
+	// and Pipeline monitors.
+	EnableSamples *bool `json:"enableSamples,omitempty"`
 	// A message to include with a re-notification.
 	EscalationMessage *string `json:"escalationMessage,omitempty"`
 	// Time (in seconds) to delay evaluation, as a non-negative integer. For example, if the value is set to 300 (5min),
 	// the timeframe is set to last_5m and the time is 7:00, the monitor evaluates data from 6:50 to 6:55.
 	// This is useful for AWS CloudWatch and other backfilled metrics to ensure the monitor always has data during evaluation.
 	EvaluationDelay *int64 `json:"evaluationDelay,omitempty"`
+	// The time span after which groups with missing data are dropped from the monitor state. The minimum value is one
+	// hour, and the maximum value is 72 hours. Example values are: "60m", "1h", and "2d". This option is only available
+	// for APM Trace Analytics, Audit Trail, CI, Error Tracking, Event, Logs, and RUM monitors.
+	GroupRetentionDuration *string `json:"groupRetentionDuration,omitempty"`
+	// A Boolean indicating whether the log alert monitor triggers a single alert or multiple alerts when any group
+	// breaches a threshold.
+	GroupbySimpleMonitor *bool `json:"groupbySimpleMonitor,omitempty"`
 	// A Boolean indicating whether notifications from this monitor automatically inserts its triggering tags into the title.
 	IncludeTags *bool `json:"includeTags,omitempty"`
 	// Whether or not the monitor is locked (only editable by creator and admins).
 	Locked *bool `json:"locked,omitempty"`
+	// How long the test should be in failure before alerting, in seconds (maximum 7200).
+	MinFailureDuration *int64 `json:"minFailureDuration,omitempty"`
+	// The minimum number of locations in failure at the same time during at least one moment in the
+	// MinFailureDuration period. This is only used by Synthetic monitors.
+	MinLocationFailed *int64 `json:"minLocationFailed,omitempty"`
 	// Time (in seconds) to allow a host to boot and applications to fully start before starting the evaluation of
 	// monitor results. Should be a non negative integer.
 	NewGroupDelay *int64 `json:"newGroupDelay,omitempty"`
+	// Time (in seconds) to allow a host to boot and applications to fully start before starting the evaluation of
+	// monitor results. Should be a non negative integer. NewGroupDelay should be preferred.
+	NewHostDelay *int64 `json:"newHostDelay,omitempty"`
 	// The number of minutes before a monitor notifies after data stops reporting. Datadog recommends at least 2x the
 	// monitor timeframe for metric alerts or 2 minutes for service checks. If omitted, 2x the evaluation timeframe
 	// is used for metric alerts, and 24 hours is used for service checks.
 	NoDataTimeframe *int64 `json:"noDataTimeframe,omitempty"`
+	// Toggles the display of additional content sent in the monitor notification: show_all, hide_query, hide_handles,
+	// or hide_all.
+	NotificationPresetName *DatadogMonitorNotificationPreset `json:"notificationPresetName,omitempty"`
 	// A Boolean indicating whether tagged users are notified on changes to this monitor.
 	NotifyAudit *bool `json:"notifyAudit,omitempty"`
+	// Controls what granularity a monitor alerts on. Only available for monitors with groupings. For instance, a
+	// monitor grouped by cluster, namespace, and pod can be configured to only notify on each new cluster violating the
+	// alert conditions by setting NotifyBy to ["cluster"]. Tags mentioned in NotifyBy must be a subset of the grouping
+	// tags in the query. Use ["*"] to configure the monitor to notify as a simple-alert.
+	// +listType=set
+	NotifyBy []string `json:"notifyBy,omitempty"`
 	// A Boolean indicating whether this monitor notifies when data stops reporting.
 	NotifyNoData *bool `json:"notifyNoData,omitempty"`
+	// Controls how groups or monitors are treated if an evaluation does not return any data points: default,
+	// show_no_data, show_and_notify_no_data, or resolve. This option is only available for APM Trace Analytics,
+	// Audit Trail, CI, Error Tracking, Event, Logs, and RUM monitors, and cannot be used with NotifyNoData and
+	// NoDataTimeframe.
+	OnMissingData *DatadogMonitorOnMissingData `json:"onMissingData,omitempty"`
 	// The number of minutes after the last notification before a monitor re-notifies on the current status.
 	// It only re-notifies if it’s not resolved.
 	RenotifyInterval *int64 `json:"renotifyInterval,omitempty"`
+	// The number of times re-notification messages should be sent on the current status at the provided
+	// re-notification interval.
+	RenotifyOccurrences *int64 `json:"renotifyOccurrences,omitempty"`
+	// The types of monitor statuses for which re-notification messages are sent: alert, warn, and no data.
+	// +listType=set
+	RenotifyStatuses []DatadogMonitorRenotifyStatus `json:"renotifyStatuses,omitempty"`
 	// A Boolean indicating whether this monitor needs a full window of data before it’s evaluated. We highly
 	// recommend you set this to false for sparse metrics, otherwise some evaluations are skipped. Default is false.
 	RequireFullWindow *bool `json:"requireFullWindow,omitempty"`
+	// Configuration options for scheduling: the cumulative evaluation window and the custom schedule of the monitor.
+	SchedulingOptions *DatadogMonitorOptionsSchedulingOptions `json:"schedulingOptions,omitempty"`
 	// The number of hours of the monitor not reporting data before it automatically resolves from a triggered state.
 	TimeoutH *int64 `json:"timeoutH,omitempty"`
 	// A struct of the different monitor threshold values.
 	Thresholds *DatadogMonitorOptionsThresholds `json:"thresholds,omitempty"`
 	// A struct of the alerting time window options.
 	ThresholdWindows *DatadogMonitorOptionsThresholdWindows `json:"thresholdWindows,omitempty"`
+	// List of requests that can be used in the monitor query of formula and functions monitors.
+	// +listType=map
+	// +listMapKey=name
+	Variables []DatadogMonitorFormulaAndFunctionEventQueryDefinition `json:"variables,omitempty"`
+}
+
+// DatadogMonitorOnMissingData controls how groups or monitors are treated if an evaluation does not return any data points
+type DatadogMonitorOnMissingData string
+
+const (
+	// DatadogMonitorOnMissingDataDefault keeps the default behavior of the monitor query type
+	DatadogMonitorOnMissingDataDefault DatadogMonitorOnMissingData = "default"
+	// DatadogMonitorOnMissingDataShowNoData shows a NO DATA state without notifying
+	DatadogMonitorOnMissingDataShowNoData DatadogMonitorOnMissingData = "show_no_data"
+	// DatadogMonitorOnMissingDataShowAndNotifyNoData shows a NO DATA state and notifies
+	DatadogMonitorOnMissingDataShowAndNotifyNoData DatadogMonitorOnMissingData = "show_and_notify_no_data"
+	// DatadogMonitorOnMissingDataResolve resolves the monitor
+	DatadogMonitorOnMissingDataResolve DatadogMonitorOnMissingData = "resolve"
+)
+
+// IsValid returns true if the on missing data option is supported
+func (o DatadogMonitorOnMissingData) IsValid() bool {
+	switch o {
+	case DatadogMonitorOnMissingDataDefault, DatadogMonitorOnMissingDataShowNoData, DatadogMonitorOnMissingDataShowAndNotifyNoData, DatadogMonitorOnMissingDataResolve:
+		return true
+	}
+	return false
+}
+
+// DatadogMonitorNotificationPreset toggles the display of additional content sent in the monitor notification
+type DatadogMonitorNotificationPreset string
+
+const (
+	// DatadogMonitorNotificationPresetShowAll shows all the content
+	DatadogMonitorNotificationPresetShowAll DatadogMonitorNotificationPreset = "show_all"
+	// DatadogMonitorNotificationPresetHideQuery hides the monitor query
+	DatadogMonitorNotificationPresetHideQuery DatadogMonitorNotificationPreset = "hide_query"
+	// DatadogMonitorNotificationPresetHideHandles hides the notified handles
+	DatadogMonitorNotificationPresetHideHandles DatadogMonitorNotificationPreset = "hide_handles"
+	// DatadogMonitorNotificationPresetHideAll hides all the additional content
+	DatadogMonitorNotificationPresetHideAll DatadogMonitorNotificationPreset = "hide_all"
+)
+
+// IsValid returns true if the notification preset is supported
+func (p DatadogMonitorNotificationPreset) IsValid() bool {
+	switch p {
+	case DatadogMonitorNotificationPresetShowAll, DatadogMonitorNotificationPresetHideQuery, DatadogMonitorNotificationPresetHideHandles, DatadogMonitorNotificationPresetHideAll:
+		return true
+	}
+	return false
+}
+
+// DatadogMonitorRenotifyStatus is a monitor status for which re-notification messages are sent
+type DatadogMonitorRenotifyStatus string
+
+const (
+	// DatadogMonitorRenotifyStatusAlert re-notifies on the Alert status
+	DatadogMonitorRenotifyStatusAlert DatadogMonitorRenotifyStatus = "alert"
+	// DatadogMonitorRenotifyStatusWarn re-notifies on the Warn status
+	DatadogMonitorRenotifyStatusWarn DatadogMonitorRenotifyStatus = "warn"
+	// DatadogMonitorRenotifyStatusNoData re-notifies on the No Data status
+	DatadogMonitorRenotifyStatusNoData DatadogMonitorRenotifyStatus = "no data"
+)
+
+// IsValid returns true if the re-notification status is supported
+func (s DatadogMonitorRenotifyStatus) IsValid() bool {
+	switch s {
+	case DatadogMonitorRenotifyStatusAlert, DatadogMonitorRenotifyStatusWarn, DatadogMonitorRenotifyStatusNoData:
+		return true
+	}
+	return false
 }
 
 // DatadogMonitorOptionsThresholds is a struct of the different monitor threshold values
@@ -134,11 +245,126 @@ type DatadogMonitorOptionsThresholdWindows struct {
 	TriggerWindow *string `json:"triggerWindow,omitempty"`
 }
 
+// DatadogMonitorOptionsSchedulingOptions is a struct of the scheduling options of a monitor
+// +k8s:openapi-gen=true
+type DatadogMonitorOptionsSchedulingOptions struct {
+	// Configuration options for the cumulative evaluation window. If HourStarts is set, no other fields may be set.
+	// Otherwise, DayStarts and MonthStarts must be set together.
+	EvaluationWindow *DatadogMonitorOptionsSchedulingOptionsEvaluationWindow `json:"evaluationWindow,omitempty"`
+	// Configuration options for the custom schedule of the monitor evaluations.
+	CustomSchedule *DatadogMonitorOptionsCustomSchedule `json:"customSchedule,omitempty"`
+}
+
+// DatadogMonitorOptionsSchedulingOptionsEvaluationWindow is a struct of the cumulative evaluation window options
+// +k8s:openapi-gen=true
+type DatadogMonitorOptionsSchedulingOptionsEvaluationWindow struct {
+	// The time of the day at which a one day cumulative evaluation window starts, in the HH:mm format (UTC).
+	DayStarts *string `json:"dayStarts,omitempty"`
+	// The minute of the hour at which a one hour cumulative evaluation window starts.
+	HourStarts *int32 `json:"hourStarts,omitempty"`
+	// The day of the month at which a one month cumulative evaluation window starts.
+	MonthStarts *int32 `json:"monthStarts,omitempty"`
+}
+
+// DatadogMonitorOptionsCustomSchedule is a struct of the custom schedule options
+// +k8s:openapi-gen=true
+type DatadogMonitorOptionsCustomSchedule struct {
+	// Recurrences is the list of recurrences of the custom schedule.
+	// +listType=atomic
+	Recurrences []DatadogMonitorOptionsCustomScheduleRecurrence `json:"recurrences"`
+}
+
+// DatadogMonitorOptionsCustomScheduleRecurrence is a recurrence of a custom schedule
+// +k8s:openapi-gen=true
+type DatadogMonitorOptionsCustomScheduleRecurrence struct {
+	// RRule is the recurrence rule (RRULE) of the schedule, for instance `FREQ=DAILY;INTERVAL=1`.
+	RRule string `json:"rrule"`
+	// Start is the start date and time of the schedule, in the yyyy-MM-ddThh:mm:ss format.
+	Start *string `json:"start,omitempty"`
+	// Timezone is the timezone the schedule runs on, for instance `America/New_York`.
+	Timezone string `json:"timezone"`
+}
+
+// DatadogMonitorFormulaAndFunctionEventQueryDefinition is a formula and functions events query, used as a variable
+// in the query of formula and functions monitors
+// +k8s:openapi-gen=true
+type DatadogMonitorFormulaAndFunctionEventQueryDefinition struct {
+	// Name of the query for use in formulas.
+	Name string `json:"name"`
+	// Data source for event platform-based queries: rum, ci_pipelines, ci_tests, audit, events, logs, or spans.
+	DataSource string `json:"dataSource"`
+	// Compute options of the query.
+	Compute DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute `json:"compute"`
+	// Search options of the query.
+	Search *DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch `json:"search,omitempty"`
+	// An array of index names to query in the stream. Omit to query all indexes at once.
+	// +listType=set
+	Indexes []string `json:"indexes,omitempty"`
+	// Group by options of the query.
+	// +listType=atomic
+	GroupBy []DatadogMonitorFormulaAndFunctionEventQueryGroupBy `json:"groupBy,omitempty"`
+}
+
+// DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute is a struct of the compute options of an events query
+// +k8s:openapi-gen=true
+type DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute struct {
+	// Aggregation methods for event platform queries, for instance count, cardinality, or avg.
+	Aggregation string `json:"aggregation"`
+	// A time interval in milliseconds.
+	Interval *int64 `json:"interval,omitempty"`
+	// Measurable attribute to compute.
+	Metric *string `json:"metric,omitempty"`
+}
+
+// DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch is a struct of the search options of an events query
+// +k8s:openapi-gen=true
+type DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch struct {
+	// Events search string.
+	Query string `json:"query"`
+}
+
+// DatadogMonitorFormulaAndFunctionEventQueryGroupBy is a struct of the group by options of an events query
+// +k8s:openapi-gen=true
+type DatadogMonitorFormulaAndFunctionEventQueryGroupBy struct {
+	// Event facet.
+	Facet string `json:"facet"`
+	// Number of groups to return.
+	Limit *int64 `json:"limit,omitempty"`
+	// Options for sorting the group by results.
+	Sort *DatadogMonitorFormulaAndFunctionEventQueryGroupBySort `json:"sort,omitempty"`
+}
+
+// DatadogMonitorFormulaAndFunctionEventQueryGroupBySort is a struct of the sort options of an events query group by
+// +k8s:openapi-gen=true
+type DatadogMonitorFormulaAndFunctionEventQueryGroupBySort struct {
+	// Aggregation methods for event platform queries, for instance count, cardinality, or avg.
+	Aggregation string `json:"aggregation"`
+	// Metric to sort by.
+	Metric *string `json:"metric,omitempty"`
+	// Direction of the sort: asc or desc.
+	Order *string `json:"order,omitempty"`
+}
+
 // DatadogMonitorControllerOptions defines options in the DatadogMonitor controller
 // +k8s:openapi-gen=true
 type DatadogMonitorControllerOptions struct {
 	// DisableRequiredTags disables the automatic addition of required tags to monitors.
 	DisableRequiredTags *bool `json:"disableRequiredTags,omitempty"`
+	// AdoptMonitorID is the ID of an existing monitor in Datadog to adopt instead of creating a new one.
+	// The adopted monitor is overwritten with the DatadogMonitor spec and deleted with the DatadogMonitor.
+	AdoptMonitorID *int64 `json:"adoptMonitorID,omitempty"`
+	// AdoptByNameAndTags makes the controller adopt the existing monitor that has the same name and all the tags
+	// of the DatadogMonitor instead of creating a new one. Adoption fails if several monitors match.
+	AdoptByNameAndTags *bool `json:"adoptByNameAndTags,omitempty"`
+	// DriftPolicy defines how the controller handles the changes made to the monitor outside of the DatadogMonitor.
+	// `enforce` (default) periodically overwrites them with the spec. `detect` reports them in the Drifted condition
+	// instead, while still pushing the changes of the spec. `observe` only reports the differences with the spec and
+	// never creates, updates, or deletes the monitor.
+	DriftPolicy DatadogDriftPolicy `json:"driftPolicy,omitempty"`
+	// MaxTriggeredStateGroups is the maximum number of triggered groups listed in Status.TriggeredState, between 0 and 100.
+	// The most severe groups are listed first. The counts of groups by state are always reported in Status.GroupStates.
+	// Defaults to 10.
+	MaxTriggeredStateGroups *int32 `json:"maxTriggeredStateGroups,omitempty"`
 }
 
 // DatadogMonitorStatus defines the observed state of DatadogMonitor
@@ -169,6 +395,8 @@ type DatadogMonitorStatus struct {
 	// +listType=map
 	// +listMapKey=monitorGroup
 	TriggeredState []DatadogMonitorTriggeredState `json:"triggeredState,omitempty"`
+	// GroupStates summarizes the states of all the monitor groups, including the ones not listed in TriggeredState
+	GroupStates *DatadogMonitorGroupStates `json:"groupStates,omitempty"`
 	// DowntimeStatus defines whether the monitor is downtimed
 	DowntimeStatus DatadogMonitorDowntimeStatus `json:"downtimeStatus,omitempty"`
 
@@ -214,6 +442,8 @@ const (
 	DatadogMonitorConditionTypeUpdated DatadogMonitorConditionType = "Updated"
 	// DatadogMonitorConditionTypeError means the DatadogMonitor has an error
 	DatadogMonitorConditionTypeError DatadogMonitorConditionType = "Error"
+	// DatadogMonitorConditionTypeDrifted means the monitor in Datadog differs from the DatadogMonitor spec
+	DatadogMonitorConditionTypeDrifted DatadogMonitorConditionType = "Drifted"
 )
 
 // DatadogMonitorState represents the overall DatadogMonitor state
@@ -260,6 +490,25 @@ type DatadogMonitorTriggeredState struct {
 	LastTransitionTime metav1.Time         `json:"lastTransitionTime,omitempty"`
 }
 
+// DatadogMonitorGroupStates counts the groups of a DatadogMonitor by state
+// +k8s:openapi-gen=true
+type DatadogMonitorGroupStates struct {
+	// TotalCount is the number of groups of the monitor
+	TotalCount int32 `json:"totalCount"`
+	// OKCount is the number of groups in OK
+	OKCount int32 `json:"okCount,omitempty"`
+	// AlertCount is the number of groups in Alert
+	AlertCount int32 `json:"alertCount,omitempty"`
+	// WarnCount is the number of groups in Warn
+	WarnCount int32 `json:"warnCount,omitempty"`
+	// NoDataCount is the number of groups in No Data
+	NoDataCount int32 `json:"noDataCount,omitempty"`
+	// TriggeredCount is the number of groups in Alert, Warn, or No Data
+	TriggeredCount int32 `json:"triggeredCount,omitempty"`
+	// Truncated is true when TriggeredState doesn't list all the triggered groups
+	Truncated bool `json:"truncated,omitempty"`
+}
+
 // DatadogMonitorDowntimeStatus represents the downtime status of a DatadogMonitor
 // +k8s:openapi-gen=true
 type DatadogMonitorDowntimeStatus struct {
@@ -7,6 +7,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"time"
 
 	utilserrors "k8s.io/apimachinery/pkg/util/errors"
 )
@@ -31,5 +32,92 @@ func IsValidDatadogMonitor(spec *DatadogMonitorSpec) error {
 		errs = append(errs, fmt.Errorf("spec.Message must be defined"))
 	}
 
+	if spec.ControllerOptions.AdoptMonitorID != nil && *spec.ControllerOptions.AdoptMonitorID <= 0 {
+		errs = append(errs, fmt.Errorf("spec.ControllerOptions.AdoptMonitorID must be a positive monitor ID"))
+	}
+
+	if spec.ControllerOptions.AdoptMonitorID != nil && spec.ControllerOptions.AdoptByNameAndTags != nil && *spec.ControllerOptions.AdoptByNameAndTags {
+		errs = append(errs, fmt.Errorf("spec.ControllerOptions.AdoptMonitorID and spec.ControllerOptions.AdoptByNameAndTags cannot be used together"))
+	}
+
+	if !spec.ControllerOptions.DriftPolicy.IsValid() {
+		errs = append(errs, fmt.Errorf("spec.ControllerOptions.DriftPolicy must be one of the values: %s, %s, or %s", DatadogDriftPolicyEnforce, DatadogDriftPolicyDetect, DatadogDriftPolicyObserve))
+	}
+
+	if m := spec.ControllerOptions.MaxTriggeredStateGroups; m != nil && (*m < 0 || *m > 100) {
+		errs = append(errs, fmt.Errorf("spec.ControllerOptions.MaxTriggeredStateGroups must be between 0 and 100"))
+	}
+
+	errs = append(errs, isValidDatadogMonitorOptions(&spec.Options)...)
+
 	return utilserrors.NewAggregate(errs)
 }
+
+func isValidDatadogMonitorOptions(options *DatadogMonitorOptions) []error {
+	var errs []error
+
+	if options.OnMissingData != nil {
+		if !options.OnMissingData.IsValid() {
+			errs = append(errs, fmt.Errorf("spec.Options.OnMissingData must be one of the values: %s, %s, %s, or %s", DatadogMonitorOnMissingDataDefault, DatadogMonitorOnMissingDataShowNoData, DatadogMonitorOnMissingDataShowAndNotifyNoData, DatadogMonitorOnMissingDataResolve))
+		}
+		if options.NotifyNoData != nil || options.NoDataTimeframe != nil {
+			errs = append(errs, fmt.Errorf("spec.Options.OnMissingData cannot be used with spec.Options.NotifyNoData or spec.Options.NoDataTimeframe"))
+		}
+	}
+
+	if options.NotificationPresetName != nil && !options.NotificationPresetName.IsValid() {
+		errs = append(errs, fmt.Errorf("spec.Options.NotificationPresetName must be one of the values: %s, %s, %s, or %s", DatadogMonitorNotificationPresetShowAll, DatadogMonitorNotificationPresetHideQuery, DatadogMonitorNotificationPresetHideHandles, DatadogMonitorNotificationPresetHideAll))
+	}
+
+	for _, status := range options.RenotifyStatuses {
+		if !status.IsValid() {
+			errs = append(errs, fmt.Errorf("spec.Options.RenotifyStatuses must only contain the values: %s, %s, or %s", DatadogMonitorRenotifyStatusAlert, DatadogMonitorRenotifyStatusWarn, DatadogMonitorRenotifyStatusNoData))
+			break
+		}
+	}
+
+	if options.SchedulingOptions != nil {
+		if w := options.SchedulingOptions.EvaluationWindow; w != nil {
+			if w.HourStarts != nil {
+				if *w.HourStarts < 0 || *w.HourStarts > 59 {
+					errs = append(errs, fmt.Errorf("spec.Options.SchedulingOptions.EvaluationWindow.HourStarts must be between 0 and 59"))
+				}
+				if w.DayStarts != nil || w.MonthStarts != nil {
+					errs = append(errs, fmt.Errorf("spec.Options.SchedulingOptions.EvaluationWindow.HourStarts cannot be used with DayStarts or MonthStarts"))
+				}
+			}
+			if w.DayStarts != nil {
+				if _, err := time.Parse("15:04", *w.DayStarts); err != nil {
+					errs = append(errs, fmt.Errorf("spec.Options.SchedulingOptions.EvaluationWindow.DayStarts must use the HH:mm format"))
+				}
+			}
+		}
+		if s := options.SchedulingOptions.CustomSchedule; s != nil {
+			if len(s.Recurrences) == 0 {
+				errs = append(errs, fmt.Errorf("spec.Options.SchedulingOptions.CustomSchedule.Recurrences must be defined"))
+			}
+			for _, r := range s.Recurrences {
+				if r.RRule == "" || r.Timezone == "" {
+					errs = append(errs, fmt.Errorf("spec.Options.SchedulingOptions.CustomSchedule.Recurrences must define a RRule and a Timezone"))
+					break
+				}
+			}
+		}
+	}
+
+	names := make(map[string]bool, len(options.Variables))
+	for _, v := range options.Variables {
+		if v.Name == "" {
+			errs = append(errs, fmt.Errorf("spec.Options.Variables must define a Name"))
+		} else if names[v.Name] {
+			errs = append(errs, fmt.Errorf("spec.Options.Variables name %s is duplicated", v.Name))
+		}
+		names[v.Name] = true
+
+		if v.DataSource == "" || v.Compute.Aggregation == "" {
+			errs = append(errs, fmt.Errorf("spec.Options.Variables %s must define a DataSource and a Compute.Aggregation", v.Name))
+		}
+	}
+
+	return errs
+}
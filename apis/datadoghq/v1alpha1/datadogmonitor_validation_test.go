@@ -9,6 +9,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
 )
 
 func TestIsValidDatadogMonitor(t *testing.T) {
@@ -39,6 +41,66 @@ func TestIsValidDatadogMonitor(t *testing.T) {
 		Type:  "metric alert",
 		Name:  "Test Monitor",
 	}
+	invalidAdoptMonitorID := minimumValid.DeepCopy()
+	invalidAdoptMonitorID.ControllerOptions.AdoptMonitorID = apiutils.NewInt64Pointer(0)
+	conflictingAdoptOptions := minimumValid.DeepCopy()
+	conflictingAdoptOptions.ControllerOptions.AdoptMonitorID = apiutils.NewInt64Pointer(12345)
+	conflictingAdoptOptions.ControllerOptions.AdoptByNameAndTags = apiutils.NewBoolPointer(true)
+	invalidDriftPolicy := minimumValid.DeepCopy()
+	invalidDriftPolicy.ControllerOptions.DriftPolicy = "ignore"
+	invalidMaxTriggeredStateGroups := minimumValid.DeepCopy()
+	invalidMaxTriggeredStateGroups.ControllerOptions.MaxTriggeredStateGroups = apiutils.NewInt32Pointer(500)
+	validOptions := minimumValid.DeepCopy()
+	onMissingData := DatadogMonitorOnMissingDataShowNoData
+	preset := DatadogMonitorNotificationPresetHideQuery
+	validOptions.Options = DatadogMonitorOptions{
+		OnMissingData:          &onMissingData,
+		NotificationPresetName: &preset,
+		RenotifyStatuses:       []DatadogMonitorRenotifyStatus{DatadogMonitorRenotifyStatusAlert, DatadogMonitorRenotifyStatusNoData},
+		SchedulingOptions: &DatadogMonitorOptionsSchedulingOptions{
+			EvaluationWindow: &DatadogMonitorOptionsSchedulingOptionsEvaluationWindow{
+				DayStarts:   apiutils.NewStringPointer("04:00"),
+				MonthStarts: apiutils.NewInt32Pointer(1),
+			},
+			CustomSchedule: &DatadogMonitorOptionsCustomSchedule{
+				Recurrences: []DatadogMonitorOptionsCustomScheduleRecurrence{{RRule: "FREQ=DAILY;INTERVAL=1", Timezone: "UTC"}},
+			},
+		},
+		Variables: []DatadogMonitorFormulaAndFunctionEventQueryDefinition{
+			{Name: "query1", DataSource: "logs", Compute: DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute{Aggregation: "count"}},
+		},
+	}
+	invalidOnMissingData := minimumValid.DeepCopy()
+	unknownMissingData := DatadogMonitorOnMissingData("ignore")
+	invalidOnMissingData.Options.OnMissingData = &unknownMissingData
+	conflictingMissingData := validOptions.DeepCopy()
+	conflictingMissingData.Options.NotifyNoData = apiutils.NewBoolPointer(true)
+	invalidPreset := minimumValid.DeepCopy()
+	unknownPreset := DatadogMonitorNotificationPreset("hide_everything")
+	invalidPreset.Options.NotificationPresetName = &unknownPreset
+	invalidRenotifyStatuses := minimumValid.DeepCopy()
+	invalidRenotifyStatuses.Options.RenotifyStatuses = []DatadogMonitorRenotifyStatus{"alert", "ok"}
+	invalidEvaluationWindow := minimumValid.DeepCopy()
+	invalidEvaluationWindow.Options.SchedulingOptions = &DatadogMonitorOptionsSchedulingOptions{
+		EvaluationWindow: &DatadogMonitorOptionsSchedulingOptionsEvaluationWindow{
+			HourStarts: apiutils.NewInt32Pointer(60),
+		},
+	}
+	conflictingEvaluationWindow := minimumValid.DeepCopy()
+	conflictingEvaluationWindow.Options.SchedulingOptions = &DatadogMonitorOptionsSchedulingOptions{
+		EvaluationWindow: &DatadogMonitorOptionsSchedulingOptionsEvaluationWindow{
+			HourStarts: apiutils.NewInt32Pointer(15),
+			DayStarts:  apiutils.NewStringPointer("4am"),
+		},
+	}
+	invalidCustomSchedule := minimumValid.DeepCopy()
+	invalidCustomSchedule.Options.SchedulingOptions = &DatadogMonitorOptionsSchedulingOptions{
+		CustomSchedule: &DatadogMonitorOptionsCustomSchedule{
+			Recurrences: []DatadogMonitorOptionsCustomScheduleRecurrence{{RRule: "FREQ=DAILY;INTERVAL=1"}},
+		},
+	}
+	invalidVariables := validOptions.DeepCopy()
+	invalidVariables.Options.Variables = append(invalidVariables.Options.Variables, DatadogMonitorFormulaAndFunctionEventQueryDefinition{Name: "query1"})
 
 	testCases := []struct {
 		name    string
@@ -69,6 +131,70 @@ func TestIsValidDatadogMonitor(t *testing.T) {
 			spec:    missingMessage,
 			wantErr: "spec.Message must be defined",
 		},
+		{
+			name:    "monitor with invalid monitor ID to adopt",
+			spec:    invalidAdoptMonitorID,
+			wantErr: "spec.ControllerOptions.AdoptMonitorID must be a positive monitor ID",
+		},
+		{
+			name:    "monitor with conflicting adoption options",
+			spec:    conflictingAdoptOptions,
+			wantErr: "spec.ControllerOptions.AdoptMonitorID and spec.ControllerOptions.AdoptByNameAndTags cannot be used together",
+		},
+		{
+			name:    "monitor with invalid drift policy",
+			spec:    invalidDriftPolicy,
+			wantErr: "spec.ControllerOptions.DriftPolicy must be one of the values: enforce, detect, or observe",
+		},
+		{
+			name:    "monitor with too many triggered state groups",
+			spec:    invalidMaxTriggeredStateGroups,
+			wantErr: "spec.ControllerOptions.MaxTriggeredStateGroups must be between 0 and 100",
+		},
+		{
+			name: "monitor with valid options",
+			spec: validOptions,
+		},
+		{
+			name:    "monitor with invalid on missing data option",
+			spec:    invalidOnMissingData,
+			wantErr: "spec.Options.OnMissingData must be one of the values: default, show_no_data, show_and_notify_no_data, or resolve",
+		},
+		{
+			name:    "monitor with on missing data and notify no data options",
+			spec:    conflictingMissingData,
+			wantErr: "spec.Options.OnMissingData cannot be used with spec.Options.NotifyNoData or spec.Options.NoDataTimeframe",
+		},
+		{
+			name:    "monitor with invalid notification preset",
+			spec:    invalidPreset,
+			wantErr: "spec.Options.NotificationPresetName must be one of the values: show_all, hide_query, hide_handles, or hide_all",
+		},
+		{
+			name:    "monitor with invalid renotify statuses",
+			spec:    invalidRenotifyStatuses,
+			wantErr: "spec.Options.RenotifyStatuses must only contain the values: alert, warn, or no data",
+		},
+		{
+			name:    "monitor with invalid evaluation window",
+			spec:    invalidEvaluationWindow,
+			wantErr: "spec.Options.SchedulingOptions.EvaluationWindow.HourStarts must be between 0 and 59",
+		},
+		{
+			name:    "monitor with conflicting evaluation window options",
+			spec:    conflictingEvaluationWindow,
+			wantErr: "[spec.Options.SchedulingOptions.EvaluationWindow.HourStarts cannot be used with DayStarts or MonthStarts, spec.Options.SchedulingOptions.EvaluationWindow.DayStarts must use the HH:mm format]",
+		},
+		{
+			name:    "monitor with invalid custom schedule",
+			spec:    invalidCustomSchedule,
+			wantErr: "spec.Options.SchedulingOptions.CustomSchedule.Recurrences must define a RRule and a Timezone",
+		},
+		{
+			name:    "monitor with invalid variables",
+			spec:    invalidVariables,
+			wantErr: "[spec.Options.Variables name query1 is duplicated, spec.Options.Variables query1 must define a DataSource and a Compute.Aggregation]",
+		},
 	}
 	for _, test := range testCases {
 		t.Run(test.name, func(t *testing.T) {
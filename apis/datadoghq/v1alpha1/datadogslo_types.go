@@ -42,15 +42,28 @@ type DatadogSLOSpec struct {
 	// Type is the type of the service level objective.
 	Type DatadogSLOType `json:"type"`
 
+	// TimeSlice is the SLI specification of a time-slice SLO. Required if type is time_slice.
+	TimeSlice *DatadogSLOTimeSlice `json:"timeSlice,omitempty"`
+
 	// The SLO time window options.
-	Timeframe DatadogSLOTimeFrame `json:"timeframe"`
+	// When Thresholds is set, Timeframe is optional and selects the primary threshold, which defaults to the first one.
+	// +optional
+	Timeframe DatadogSLOTimeFrame `json:"timeframe,omitempty"`
 
 	// TargetThreshold is the target threshold such that when the service level indicator is above this threshold over the given timeframe, the objective is being met.
-	TargetThreshold resource.Quantity `json:"targetThreshold"`
+	// Required unless Thresholds is set.
+	// +optional
+	TargetThreshold resource.Quantity `json:"targetThreshold,omitempty"`
 
 	// WarningThreshold is a optional warning threshold such that when the service level indicator is below this value for the given threshold, but above the target threshold, the objective appears in a "warning" state. This value must be greater than the target threshold.
 	WarningThreshold *resource.Quantity `json:"warningThreshold,omitempty"`
 
+	// Thresholds is a list of thresholds, one per timeframe. It replaces TargetThreshold and WarningThreshold
+	// to define targets over several timeframes.
+	// +listType=map
+	// +listMapKey=timeframe
+	Thresholds []DatadogSLOThreshold `json:"thresholds,omitempty"`
+
 	// ControllerOptions are the optional parameters in the DatadogSLO controller
 	ControllerOptions *DatadogSLOControllerOptions `json:"controllerOptions,omitempty"`
 }
@@ -63,16 +76,66 @@ type DatadogSLOQuery struct {
 	Denominator string `json:"denominator"`
 }
 
+// DatadogSLOTimeSlice defines the SLI specification of a time-slice SLO: the SLI query is evaluated on each
+// time slice, and a slice is good when the comparison of its value with the threshold is true.
+// +k8s:openapi-gen=true
+type DatadogSLOTimeSlice struct {
+	// Query is a Datadog metric query evaluated on each time slice.
+	Query string `json:"query"`
+
+	// Comparator is the comparator used to compare the SLI value to the threshold: >, >=, <, or <=.
+	Comparator DatadogSLOTimeSliceComparator `json:"comparator"`
+
+	// Threshold is the value the SLI value of each time slice is compared to.
+	Threshold resource.Quantity `json:"threshold"`
+
+	// QueryIntervalSeconds is the interval used when querying data, in seconds. Valid values are 60 and 300.
+	// Defaults to 300.
+	QueryIntervalSeconds *int32 `json:"queryIntervalSeconds,omitempty"`
+}
+
+type DatadogSLOTimeSliceComparator string
+
+const (
+	DatadogSLOTimeSliceComparatorGreater        DatadogSLOTimeSliceComparator = ">"
+	DatadogSLOTimeSliceComparatorGreaterOrEqual DatadogSLOTimeSliceComparator = ">="
+	DatadogSLOTimeSliceComparatorLess           DatadogSLOTimeSliceComparator = "<"
+	DatadogSLOTimeSliceComparatorLessOrEqual    DatadogSLOTimeSliceComparator = "<="
+)
+
+func (c DatadogSLOTimeSliceComparator) IsValid() bool {
+	switch c {
+	case DatadogSLOTimeSliceComparatorGreater, DatadogSLOTimeSliceComparatorGreaterOrEqual, DatadogSLOTimeSliceComparatorLess, DatadogSLOTimeSliceComparatorLessOrEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+// DatadogSLOThreshold defines the target and warning thresholds of an SLO over a timeframe.
+// +k8s:openapi-gen=true
+type DatadogSLOThreshold struct {
+	// Timeframe is the SLO time window of the threshold.
+	Timeframe DatadogSLOTimeFrame `json:"timeframe"`
+
+	// Target is the target threshold such that when the service level indicator is above this threshold over the timeframe, the objective is being met.
+	Target resource.Quantity `json:"target"`
+
+	// Warning is an optional warning threshold such that when the service level indicator is below this value for the timeframe, but above the target threshold, the objective appears in a "warning" state. This value must be greater than the target threshold.
+	Warning *resource.Quantity `json:"warning,omitempty"`
+}
+
 type DatadogSLOType string
 
 const (
-	DatadogSLOTypeMetric  DatadogSLOType = "metric"
-	DatadogSLOTypeMonitor DatadogSLOType = "monitor"
+	DatadogSLOTypeMetric    DatadogSLOType = "metric"
+	DatadogSLOTypeMonitor   DatadogSLOType = "monitor"
+	DatadogSLOTypeTimeSlice DatadogSLOType = "time_slice"
 )
 
 func (t DatadogSLOType) IsValid() bool {
 	switch t {
-	case DatadogSLOTypeMetric, DatadogSLOTypeMonitor:
+	case DatadogSLOTypeMetric, DatadogSLOTypeMonitor, DatadogSLOTypeTimeSlice:
 		return true
 	default:
 		return false
@@ -87,11 +150,36 @@ const (
 	DatadogSLOTimeFrame90d DatadogSLOTimeFrame = "90d"
 )
 
+func (t DatadogSLOTimeFrame) IsValid() bool {
+	switch t {
+	case DatadogSLOTimeFrame7d, DatadogSLOTimeFrame30d, DatadogSLOTimeFrame90d:
+		return true
+	default:
+		return false
+	}
+}
+
+// PrimaryTimeframe returns the timeframe of the primary threshold of the SLO.
+func (spec *DatadogSLOSpec) PrimaryTimeframe() DatadogSLOTimeFrame {
+	if spec.Timeframe == "" && len(spec.Thresholds) > 0 {
+		return spec.Thresholds[0].Timeframe
+	}
+	return spec.Timeframe
+}
+
 // DatadogSLOControllerOptions defines options in the DatadogSLO controller.
 // +k8s:openapi-gen=true
 type DatadogSLOControllerOptions struct {
 	// DisableRequiredTags disables the automatic addition of required tags to SLOs.
 	DisableRequiredTags *bool `json:"disableRequiredTags,omitempty"`
+	// AdoptSLOID is the ID of an existing SLO in Datadog to adopt instead of creating a new one.
+	// The adopted SLO is overwritten with the DatadogSLO spec and deleted with the DatadogSLO, unless the drift policy is `observe`.
+	AdoptSLOID *string `json:"adoptSLOID,omitempty"`
+	// DriftPolicy defines how the controller handles the changes made to the SLO outside of the DatadogSLO.
+	// `enforce` (default) periodically overwrites them with the spec. `detect` reports them in the Drifted condition
+	// instead, while still pushing the changes of the spec. `observe` only reports the differences with the spec and
+	// never creates, updates, or deletes the SLO.
+	DriftPolicy DatadogDriftPolicy `json:"driftPolicy,omitempty"`
 }
 
 // DatadogSLOStatus defines the observed state of a DatadogSLO.
@@ -120,6 +208,16 @@ type DatadogSLOStatus struct {
 	// CurrentHash tracks the hash of the current DatadogSLOSpec to know
 	// if the Spec has changed and needs an update.
 	CurrentHash string `json:"currentHash,omitempty"`
+
+	// SLIValue is the current value of the service level indicator over the SLO timeframe, as a percentage.
+	SLIValue *string `json:"sliValue,omitempty"`
+
+	// ErrorBudgetRemaining is the percentage of the error budget remaining over the SLO timeframe.
+	// It is negative when the error budget is exhausted.
+	ErrorBudgetRemaining *string `json:"errorBudgetRemaining,omitempty"`
+
+	// StateLastUpdateTime is the last time the SLI value and the error budget were updated.
+	StateLastUpdateTime *metav1.Time `json:"stateLastUpdateTime,omitempty"`
 }
 
 // DatadogSLOSyncStatus is the message reflecting the health of SLO state syncs to Datadog.
@@ -142,6 +240,8 @@ const (
 // +kubebuilder:resource:path=datadogslos,scope=Namespaced,shortName=ddslo
 // +kubebuilder:printcolumn:name="id",type="string",JSONPath=".status.id"
 // +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="sli",type="string",JSONPath=".status.sliValue"
+// +kubebuilder:printcolumn:name="error budget",type="string",JSONPath=".status.errorBudgetRemaining"
 // +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
 // +k8s:openapi-gen=true
 // +genclient
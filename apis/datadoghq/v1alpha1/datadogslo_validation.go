@@ -24,7 +24,7 @@ func IsValidDatadogSLO(spec *DatadogSLOSpec) error {
 	}
 
 	if spec.Type != "" && !spec.Type.IsValid() {
-		errs = append(errs, fmt.Errorf("spec.Type must be one of the values: %s or %s", DatadogSLOTypeMonitor, DatadogSLOTypeMetric))
+		errs = append(errs, fmt.Errorf("spec.Type must be one of the values: %s, %s, or %s", DatadogSLOTypeMonitor, DatadogSLOTypeMetric, DatadogSLOTypeTimeSlice))
 	}
 
 	if spec.Type == DatadogSLOTypeMetric && spec.Query == nil {
@@ -35,6 +35,19 @@ func IsValidDatadogSLO(spec *DatadogSLOSpec) error {
 		errs = append(errs, fmt.Errorf("spec.MonitorIDs must be defined when spec.Type is monitor"))
 	}
 
+	if spec.Type == DatadogSLOTypeTimeSlice {
+		errs = append(errs, isValidTimeSlice(spec.TimeSlice)...)
+	}
+
+	if spec.ControllerOptions != nil && !spec.ControllerOptions.DriftPolicy.IsValid() {
+		errs = append(errs, fmt.Errorf("spec.ControllerOptions.DriftPolicy must be one of the values: %s, %s, or %s", DatadogDriftPolicyEnforce, DatadogDriftPolicyDetect, DatadogDriftPolicyObserve))
+	}
+
+	if len(spec.Thresholds) > 0 {
+		errs = append(errs, isValidThresholds(spec)...)
+		return utilserrors.NewAggregate(errs)
+	}
+
 	if spec.TargetThreshold.AsApproximateFloat64() <= 0 || spec.TargetThreshold.AsApproximateFloat64() >= 100 {
 		errs = append(errs, fmt.Errorf("spec.TargetThreshold must be greater than 0 and less than 100"))
 	}
@@ -43,12 +56,67 @@ func IsValidDatadogSLO(spec *DatadogSLOSpec) error {
 		errs = append(errs, fmt.Errorf("spec.WarningThreshold must be greater than 0 and less than 100"))
 	}
 
-	switch spec.Timeframe {
-	case DatadogSLOTimeFrame7d, DatadogSLOTimeFrame30d, DatadogSLOTimeFrame90d:
-		break
-	default:
+	if !spec.Timeframe.IsValid() {
 		errs = append(errs, fmt.Errorf("spec.Timeframe must be defined as one of the values: 7d, 30d, or 90d"))
 	}
 
 	return utilserrors.NewAggregate(errs)
 }
+
+func isValidTimeSlice(timeSlice *DatadogSLOTimeSlice) []error {
+	if timeSlice == nil {
+		return []error{fmt.Errorf("spec.TimeSlice must be defined when spec.Type is time_slice")}
+	}
+
+	var errs []error
+	if timeSlice.Query == "" {
+		errs = append(errs, fmt.Errorf("spec.TimeSlice.Query must be defined"))
+	}
+
+	if !timeSlice.Comparator.IsValid() {
+		errs = append(errs, fmt.Errorf("spec.TimeSlice.Comparator must be one of the values: >, >=, <, or <="))
+	}
+
+	if timeSlice.QueryIntervalSeconds != nil && *timeSlice.QueryIntervalSeconds != 60 && *timeSlice.QueryIntervalSeconds != 300 {
+		errs = append(errs, fmt.Errorf("spec.TimeSlice.QueryIntervalSeconds must be one of the values: 60 or 300"))
+	}
+
+	return errs
+}
+
+func isValidThresholds(spec *DatadogSLOSpec) []error {
+	var errs []error
+	if !spec.TargetThreshold.IsZero() || spec.WarningThreshold != nil {
+		errs = append(errs, fmt.Errorf("spec.TargetThreshold and spec.WarningThreshold cannot be used with spec.Thresholds"))
+	}
+
+	timeframes := map[DatadogSLOTimeFrame]bool{}
+	for i, threshold := range spec.Thresholds {
+		if !threshold.Timeframe.IsValid() {
+			errs = append(errs, fmt.Errorf("spec.Thresholds[%d].Timeframe must be defined as one of the values: 7d, 30d, or 90d", i))
+		} else if timeframes[threshold.Timeframe] {
+			errs = append(errs, fmt.Errorf("spec.Thresholds[%d].Timeframe %s is already used by another threshold", i, threshold.Timeframe))
+		}
+		timeframes[threshold.Timeframe] = true
+
+		target := threshold.Target.AsApproximateFloat64()
+		if target <= 0 || target >= 100 {
+			errs = append(errs, fmt.Errorf("spec.Thresholds[%d].Target must be greater than 0 and less than 100", i))
+		}
+
+		if threshold.Warning != nil {
+			warning := threshold.Warning.AsApproximateFloat64()
+			if warning <= 0 || warning >= 100 {
+				errs = append(errs, fmt.Errorf("spec.Thresholds[%d].Warning must be greater than 0 and less than 100", i))
+			} else if warning <= target {
+				errs = append(errs, fmt.Errorf("spec.Thresholds[%d].Warning must be greater than the target threshold", i))
+			}
+		}
+	}
+
+	if spec.Timeframe != "" && !timeframes[spec.Timeframe] {
+		errs = append(errs, fmt.Errorf("spec.Timeframe must match the timeframe of one of spec.Thresholds"))
+	}
+
+	return errs
+}
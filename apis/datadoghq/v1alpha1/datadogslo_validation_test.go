@@ -83,7 +83,7 @@ func TestIsValidDatadogSLO(t *testing.T) {
 				TargetThreshold: resource.MustParse("99.99"),
 				Timeframe:       DatadogSLOTimeFrame30d,
 			},
-			expected: errors.New("spec.Type must be one of the values: monitor or metric"),
+			expected: errors.New("spec.Type must be one of the values: monitor, metric, or time_slice"),
 		},
 		{
 			name: "Missing Threshold and Timeframe",
@@ -114,6 +114,18 @@ func TestIsValidDatadogSLO(t *testing.T) {
 			},
 			expected: errors.New("spec.MonitorIDs must be defined when spec.Type is monitor"),
 		},
+		{
+			name: "Invalid DriftPolicy",
+			spec: &DatadogSLOSpec{
+				Name:              "MySLO",
+				Type:              DatadogSLOTypeMonitor,
+				TargetThreshold:   resource.MustParse("99.99"),
+				Timeframe:         DatadogSLOTimeFrame30d,
+				MonitorIDs:        []int64{12345},
+				ControllerOptions: &DatadogSLOControllerOptions{DriftPolicy: "ignore"},
+			},
+			expected: errors.New("spec.ControllerOptions.DriftPolicy must be one of the values: enforce, detect, or observe"),
+		},
 		{
 			name: "Invalid Thresholds",
 			spec: &DatadogSLOSpec{
@@ -157,6 +169,90 @@ func TestIsValidDatadogSLO(t *testing.T) {
 			},
 			expected: errors.New("spec.Timeframe must be defined as one of the values: 7d, 30d, or 90d"),
 		},
+		{
+			name: "Valid time slice spec",
+			spec: &DatadogSLOSpec{
+				Name: "MySLO",
+				Type: DatadogSLOTypeTimeSlice,
+				TimeSlice: &DatadogSLOTimeSlice{
+					Query:      "avg:trace.servlet.request.duration{env:prod}",
+					Comparator: DatadogSLOTimeSliceComparatorLess,
+					Threshold:  resource.MustParse("0.5"),
+				},
+				TargetThreshold: resource.MustParse("99.9"),
+				Timeframe:       DatadogSLOTimeFrame7d,
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing TimeSlice",
+			spec: &DatadogSLOSpec{
+				Name:            "MySLO",
+				Type:            DatadogSLOTypeTimeSlice,
+				TargetThreshold: resource.MustParse("99.9"),
+				Timeframe:       DatadogSLOTimeFrame7d,
+			},
+			expected: errors.New("spec.TimeSlice must be defined when spec.Type is time_slice"),
+		},
+		{
+			name: "Invalid TimeSlice",
+			spec: &DatadogSLOSpec{
+				Name: "MySLO",
+				Type: DatadogSLOTypeTimeSlice,
+				TimeSlice: &DatadogSLOTimeSlice{
+					Comparator:           "==",
+					QueryIntervalSeconds: ptrInt32(120),
+				},
+				TargetThreshold: resource.MustParse("99.9"),
+				Timeframe:       DatadogSLOTimeFrame7d,
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.TimeSlice.Query must be defined"),
+					errors.New("spec.TimeSlice.Comparator must be one of the values: >, >=, <, or <="),
+					errors.New("spec.TimeSlice.QueryIntervalSeconds must be one of the values: 60 or 300"),
+				},
+			),
+		},
+		{
+			name: "Valid Thresholds",
+			spec: &DatadogSLOSpec{
+				Name:       "MySLO",
+				Type:       DatadogSLOTypeMonitor,
+				MonitorIDs: []int64{12345},
+				Timeframe:  DatadogSLOTimeFrame30d,
+				Thresholds: []DatadogSLOThreshold{
+					{Timeframe: DatadogSLOTimeFrame7d, Target: resource.MustParse("99"), Warning: ptrResourceQuantity(resource.MustParse("99.5"))},
+					{Timeframe: DatadogSLOTimeFrame30d, Target: resource.MustParse("99.9")},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Invalid Thresholds list",
+			spec: &DatadogSLOSpec{
+				Name:            "MySLO",
+				Type:            DatadogSLOTypeMonitor,
+				MonitorIDs:      []int64{12345},
+				Timeframe:       DatadogSLOTimeFrame90d,
+				TargetThreshold: resource.MustParse("99"),
+				Thresholds: []DatadogSLOThreshold{
+					{Timeframe: DatadogSLOTimeFrame7d, Target: resource.MustParse("99"), Warning: ptrResourceQuantity(resource.MustParse("98"))},
+					{Timeframe: DatadogSLOTimeFrame7d, Target: resource.MustParse("100")},
+					{Timeframe: "1d", Target: resource.MustParse("99")},
+				},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.TargetThreshold and spec.WarningThreshold cannot be used with spec.Thresholds"),
+					errors.New("spec.Thresholds[0].Warning must be greater than the target threshold"),
+					errors.New("spec.Thresholds[1].Timeframe 7d is already used by another threshold"),
+					errors.New("spec.Thresholds[1].Target must be greater than 0 and less than 100"),
+					errors.New("spec.Thresholds[2].Timeframe must be defined as one of the values: 7d, 30d, or 90d"),
+					errors.New("spec.Timeframe must match the timeframe of one of spec.Thresholds"),
+				},
+			),
+		},
 	}
 
 	for _, tt := range tests {
@@ -174,3 +270,7 @@ func TestIsValidDatadogSLO(t *testing.T) {
 func ptrResourceQuantity(n resource.Quantity) *resource.Quantity {
 	return &n
 }
+
+func ptrInt32(n int32) *int32 {
+	return &n
+}
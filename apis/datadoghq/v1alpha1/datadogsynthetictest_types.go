@@ -0,0 +1,306 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatadogSyntheticTestSpec defines the desired state of a DatadogSyntheticTest
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestSpec struct {
+	// Name is the name of the test.
+	Name string `json:"name"`
+
+	// Message is the notification message of the test monitor.
+	Message string `json:"message,omitempty"`
+
+	// Subtype is the type of API test: http, ssl, dns, tcp, grpc, or multi.
+	Subtype DatadogSyntheticTestSubtype `json:"subtype"`
+
+	// Request is the request performed by the test. Required unless Subtype is multi.
+	Request *DatadogSyntheticTestRequest `json:"request,omitempty"`
+
+	// Assertions are the assertions the response must satisfy for the test to pass. Required unless Subtype is multi.
+	// +listType=atomic
+	Assertions []DatadogSyntheticTestAssertion `json:"assertions,omitempty"`
+
+	// Steps are the steps of a multistep test. Required if Subtype is multi.
+	// +listType=atomic
+	Steps []DatadogSyntheticTestStep `json:"steps,omitempty"`
+
+	// Locations is the list of locations the test runs from, for instance `aws:eu-west-1`.
+	// +listType=set
+	Locations []string `json:"locations"`
+
+	// Options are the optional parameters of the test.
+	Options DatadogSyntheticTestOptions `json:"options,omitempty"`
+
+	// Tags is the list of tags to associate with the test.
+	// +listType=set
+	Tags []string `json:"tags,omitempty"`
+
+	// Paused pauses the test: it doesn't run until Paused is set back to false.
+	Paused bool `json:"paused,omitempty"`
+}
+
+// DatadogSyntheticTestSubtype is the type of a synthetic API test
+type DatadogSyntheticTestSubtype string
+
+const (
+	// DatadogSyntheticTestSubtypeHTTP is an HTTP test
+	DatadogSyntheticTestSubtypeHTTP DatadogSyntheticTestSubtype = "http"
+	// DatadogSyntheticTestSubtypeSSL is an SSL test
+	DatadogSyntheticTestSubtypeSSL DatadogSyntheticTestSubtype = "ssl"
+	// DatadogSyntheticTestSubtypeDNS is a DNS test
+	DatadogSyntheticTestSubtypeDNS DatadogSyntheticTestSubtype = "dns"
+	// DatadogSyntheticTestSubtypeTCP is a TCP test
+	DatadogSyntheticTestSubtypeTCP DatadogSyntheticTestSubtype = "tcp"
+	// DatadogSyntheticTestSubtypeGRPC is a gRPC test
+	DatadogSyntheticTestSubtypeGRPC DatadogSyntheticTestSubtype = "grpc"
+	// DatadogSyntheticTestSubtypeMulti is a multistep test
+	DatadogSyntheticTestSubtypeMulti DatadogSyntheticTestSubtype = "multi"
+)
+
+// IsValid returns true if the subtype is supported
+func (s DatadogSyntheticTestSubtype) IsValid() bool {
+	switch s {
+	case DatadogSyntheticTestSubtypeHTTP, DatadogSyntheticTestSubtypeSSL, DatadogSyntheticTestSubtypeDNS, DatadogSyntheticTestSubtypeTCP, DatadogSyntheticTestSubtypeGRPC, DatadogSyntheticTestSubtypeMulti:
+		return true
+	default:
+		return false
+	}
+}
+
+// DatadogSyntheticTestRequest defines the request performed by a synthetic test
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestRequest struct {
+	// Method is the HTTP method of HTTP requests, for instance GET.
+	Method string `json:"method,omitempty"`
+
+	// URL is the URL of HTTP requests.
+	URL string `json:"url,omitempty"`
+
+	// Host is the host name of SSL, DNS, TCP, and gRPC requests.
+	Host string `json:"host,omitempty"`
+
+	// Port is the port of SSL, TCP, and gRPC requests.
+	Port *int64 `json:"port,omitempty"`
+
+	// Headers are the headers of HTTP requests.
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is the body of HTTP requests.
+	Body string `json:"body,omitempty"`
+
+	// Timeout is the timeout of the request, in seconds.
+	Timeout *int64 `json:"timeout,omitempty"`
+
+	// DNSServer is the DNS server used by DNS requests.
+	DNSServer string `json:"dnsServer,omitempty"`
+
+	// DNSServerPort is the port of the DNS server used by DNS requests.
+	DNSServerPort *int32 `json:"dnsServerPort,omitempty"`
+
+	// Service is the gRPC service of gRPC health checks.
+	Service string `json:"service,omitempty"`
+}
+
+// DatadogSyntheticTestAssertion defines an assertion of a synthetic test
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestAssertion struct {
+	// Type is the type of assertion, for instance statusCode, responseTime, header, body, certificate,
+	// recordEvery, recordSome, or grpcHealthcheckStatus.
+	Type string `json:"type"`
+
+	// Operator is the assertion operator, for instance is, lessThan, contains, or validatesJSONPath.
+	Operator string `json:"operator"`
+
+	// Property is the property the assertion applies to, for instance the name of a header.
+	Property string `json:"property,omitempty"`
+
+	// Target is the value the property is compared to. Numbers are sent as numbers.
+	Target string `json:"target,omitempty"`
+
+	// JSONPath is the JSON path of the value to compare to Target, when Operator is validatesJSONPath.
+	JSONPath string `json:"jsonPath,omitempty"`
+
+	// JSONPathOperator is the operator used to compare the value at JSONPath to Target. Defaults to is.
+	JSONPathOperator string `json:"jsonPathOperator,omitempty"`
+}
+
+// DatadogSyntheticTestStep defines a step of a multistep synthetic test
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestStep struct {
+	// Name is the name of the step.
+	Name string `json:"name"`
+
+	// Request is the HTTP request performed by the step.
+	Request DatadogSyntheticTestRequest `json:"request"`
+
+	// Assertions are the assertions the response of the step must satisfy.
+	// +listType=atomic
+	Assertions []DatadogSyntheticTestAssertion `json:"assertions,omitempty"`
+
+	// ExtractedValues are the values extracted from the response, usable as variables in the next steps.
+	// +listType=atomic
+	ExtractedValues []DatadogSyntheticTestExtractedValue `json:"extractedValues,omitempty"`
+
+	// AllowFailure lets the test continue when the step fails.
+	AllowFailure *bool `json:"allowFailure,omitempty"`
+
+	// IsCritical makes the test fail when the step fails.
+	IsCritical *bool `json:"isCritical,omitempty"`
+}
+
+// DatadogSyntheticTestExtractedValue defines a value extracted from the response of a step
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestExtractedValue struct {
+	// Name is the name of the variable.
+	Name string `json:"name"`
+
+	// Type is the part of the response the value is extracted from: http_body or http_header.
+	Type string `json:"type"`
+
+	// Field is the name of the header to extract the value from, when Type is http_header.
+	Field string `json:"field,omitempty"`
+
+	// ParserType is the parser used to extract the value: raw, json_path, regex, or x_path.
+	ParserType string `json:"parserType"`
+
+	// ParserValue is the expression used by the parser, for instance a JSON path.
+	ParserValue string `json:"parserValue,omitempty"`
+}
+
+// DatadogSyntheticTestOptions defines the optional parameters of a synthetic test
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestOptions struct {
+	// TickEvery is the frequency of the test, in seconds, between 30 and 604800.
+	TickEvery *int64 `json:"tickEvery,omitempty"`
+
+	// MinFailureDuration is how long the test should be in failure before alerting, in seconds.
+	MinFailureDuration *int64 `json:"minFailureDuration,omitempty"`
+
+	// MinLocationFailed is the minimum number of locations in failure before alerting.
+	MinLocationFailed *int64 `json:"minLocationFailed,omitempty"`
+
+	// RetryCount is the number of times a failed test is retried before being marked as failed.
+	RetryCount *int64 `json:"retryCount,omitempty"`
+
+	// RetryInterval is the time between two retries, in milliseconds.
+	RetryInterval *int64 `json:"retryInterval,omitempty"`
+
+	// RenotifyInterval is the number of minutes after the last notification before the test monitor re-notifies.
+	RenotifyInterval *int64 `json:"renotifyInterval,omitempty"`
+
+	// MonitorPriority is the priority of the test monitor, from 1 (high) to 5 (low).
+	MonitorPriority *int32 `json:"monitorPriority,omitempty"`
+
+	// FollowRedirects makes HTTP requests follow redirects.
+	FollowRedirects *bool `json:"followRedirects,omitempty"`
+
+	// AcceptSelfSigned makes the test accept self-signed certificates.
+	AcceptSelfSigned *bool `json:"acceptSelfSigned,omitempty"`
+
+	// AllowInsecure allows insecure connections, for instance invalid certificates, in HTTP requests.
+	AllowInsecure *bool `json:"allowInsecure,omitempty"`
+}
+
+// DatadogSyntheticTestStatus defines the observed state of a DatadogSyntheticTest
+// +k8s:openapi-gen=true
+type DatadogSyntheticTestStatus struct {
+	// Conditions represents the latest available observations of the state of a DatadogSyntheticTest.
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// PublicID is the public ID of the test generated in Datadog.
+	PublicID string `json:"publicID,omitempty"`
+
+	// MonitorID is the ID of the monitor of the test generated in Datadog.
+	MonitorID int64 `json:"monitorID,omitempty"`
+
+	// Creator is the identity of the test creator.
+	Creator string `json:"creator,omitempty"`
+
+	// Created is the time the test was created.
+	Created *metav1.Time `json:"created,omitempty"`
+
+	// LastResultState is the state of the last result of the test: passed or failed.
+	LastResultState DatadogSyntheticTestResultState `json:"lastResultState,omitempty"`
+
+	// LastResultTime is the time of the last result of the test.
+	LastResultTime *metav1.Time `json:"lastResultTime,omitempty"`
+
+	// SyncStatus shows the health of syncing the test state to Datadog.
+	SyncStatus DatadogSyntheticTestSyncStatus `json:"syncStatus,omitempty"`
+
+	// LastForceSyncTime is the last time the API test was last force synced with the DatadogSyntheticTest resource.
+	LastForceSyncTime *metav1.Time `json:"lastForceSyncTime,omitempty"`
+
+	// StateLastUpdateTime is the last time the result state was updated.
+	StateLastUpdateTime *metav1.Time `json:"stateLastUpdateTime,omitempty"`
+
+	// CurrentHash tracks the hash of the current DatadogSyntheticTestSpec to know
+	// if the Spec has changed and needs an update.
+	CurrentHash string `json:"currentHash,omitempty"`
+}
+
+// DatadogSyntheticTestResultState is the state of a synthetic test result
+type DatadogSyntheticTestResultState string
+
+const (
+	// DatadogSyntheticTestResultStatePassed means the last result of the test passed
+	DatadogSyntheticTestResultStatePassed DatadogSyntheticTestResultState = "passed"
+	// DatadogSyntheticTestResultStateFailed means the last result of the test failed
+	DatadogSyntheticTestResultStateFailed DatadogSyntheticTestResultState = "failed"
+)
+
+// DatadogSyntheticTestSyncStatus is the message reflecting the health of synthetic test state syncs to Datadog.
+type DatadogSyntheticTestSyncStatus string
+
+const (
+	// DatadogSyntheticTestSyncStatusOK means syncing is OK.
+	DatadogSyntheticTestSyncStatusOK DatadogSyntheticTestSyncStatus = "OK"
+	// DatadogSyntheticTestSyncStatusValidateError means there is a test validation error.
+	DatadogSyntheticTestSyncStatusValidateError DatadogSyntheticTestSyncStatus = "error validating synthetic test"
+	// DatadogSyntheticTestSyncStatusCreateError means there is an error creating the test.
+	DatadogSyntheticTestSyncStatusCreateError DatadogSyntheticTestSyncStatus = "error creating synthetic test"
+	// DatadogSyntheticTestSyncStatusUpdateError means there is a test update error.
+	DatadogSyntheticTestSyncStatusUpdateError DatadogSyntheticTestSyncStatus = "error updating synthetic test"
+	// DatadogSyntheticTestSyncStatusGetError means there is an error getting the test.
+	DatadogSyntheticTestSyncStatusGetError DatadogSyntheticTestSyncStatus = "error getting synthetic test"
+)
+
+// DatadogSyntheticTest allows to define and manage synthetic API tests from your Kubernetes Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=datadogsynthetictests,scope=Namespaced,shortName=ddsynthetic
+// +kubebuilder:printcolumn:name="public id",type="string",JSONPath=".status.publicID"
+// +kubebuilder:printcolumn:name="last result",type="string",JSONPath=".status.lastResultState"
+// +kubebuilder:printcolumn:name="sync status",type="string",JSONPath=".status.syncStatus"
+// +kubebuilder:printcolumn:name="age",type="date",JSONPath=".metadata.creationTimestamp"
+// +k8s:openapi-gen=true
+// +genclient
+type DatadogSyntheticTest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatadogSyntheticTestSpec   `json:"spec,omitempty"`
+	Status DatadogSyntheticTestStatus `json:"status,omitempty"`
+}
+
+// DatadogSyntheticTestList contains a list of DatadogSyntheticTests
+// +kubebuilder:object:root=true
+type DatadogSyntheticTestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatadogSyntheticTest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatadogSyntheticTest{}, &DatadogSyntheticTestList{})
+}
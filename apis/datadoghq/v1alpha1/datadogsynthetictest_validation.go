@@ -0,0 +1,92 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// IsValidDatadogSyntheticTest use to check if a DatadogSyntheticTestSpec is valid by checking
+// that the required fields are defined and consistent
+func IsValidDatadogSyntheticTest(spec *DatadogSyntheticTestSpec) error {
+	var errs []error
+	if spec.Name == "" {
+		errs = append(errs, fmt.Errorf("spec.Name must be defined"))
+	}
+
+	if len(spec.Locations) == 0 {
+		errs = append(errs, fmt.Errorf("spec.Locations must be defined"))
+	}
+
+	if !spec.Subtype.IsValid() {
+		errs = append(errs, fmt.Errorf("spec.Subtype must be one of the values: %s, %s, %s, %s, %s, or %s", DatadogSyntheticTestSubtypeHTTP, DatadogSyntheticTestSubtypeSSL, DatadogSyntheticTestSubtypeDNS, DatadogSyntheticTestSubtypeTCP, DatadogSyntheticTestSubtypeGRPC, DatadogSyntheticTestSubtypeMulti))
+	}
+
+	if spec.Subtype == DatadogSyntheticTestSubtypeMulti {
+		if len(spec.Steps) == 0 {
+			errs = append(errs, fmt.Errorf("spec.Steps must be defined when spec.Subtype is %s", DatadogSyntheticTestSubtypeMulti))
+		}
+		if spec.Request != nil || len(spec.Assertions) > 0 {
+			errs = append(errs, fmt.Errorf("spec.Request and spec.Assertions cannot be used when spec.Subtype is %s, use spec.Steps instead", DatadogSyntheticTestSubtypeMulti))
+		}
+		for _, step := range spec.Steps {
+			if step.Name == "" {
+				errs = append(errs, fmt.Errorf("spec.Steps must define a Name"))
+			}
+			if step.Request.URL == "" {
+				errs = append(errs, fmt.Errorf("spec.Steps must define a Request.URL"))
+			}
+			for _, value := range step.ExtractedValues {
+				if value.Name == "" || value.Type == "" || value.ParserType == "" {
+					errs = append(errs, fmt.Errorf("spec.Steps.ExtractedValues must define a Name, a Type, and a ParserType"))
+				}
+			}
+			errs = append(errs, isValidDatadogSyntheticTestAssertions("spec.Steps.Assertions", step.Assertions)...)
+		}
+	} else if spec.Subtype.IsValid() {
+		if len(spec.Steps) > 0 {
+			errs = append(errs, fmt.Errorf("spec.Steps can only be defined when spec.Subtype is %s", DatadogSyntheticTestSubtypeMulti))
+		}
+		if spec.Request == nil {
+			errs = append(errs, fmt.Errorf("spec.Request must be defined"))
+		} else if spec.Subtype == DatadogSyntheticTestSubtypeHTTP && spec.Request.URL == "" {
+			errs = append(errs, fmt.Errorf("spec.Request.URL must be defined when spec.Subtype is %s", DatadogSyntheticTestSubtypeHTTP))
+		} else if spec.Subtype != DatadogSyntheticTestSubtypeHTTP && spec.Request.Host == "" {
+			errs = append(errs, fmt.Errorf("spec.Request.Host must be defined when spec.Subtype is %s", spec.Subtype))
+		}
+		if len(spec.Assertions) == 0 {
+			errs = append(errs, fmt.Errorf("spec.Assertions must be defined"))
+		}
+		errs = append(errs, isValidDatadogSyntheticTestAssertions("spec.Assertions", spec.Assertions)...)
+	}
+
+	if tickEvery := spec.Options.TickEvery; tickEvery != nil && (*tickEvery < 30 || *tickEvery > 604800) {
+		errs = append(errs, fmt.Errorf("spec.Options.TickEvery must be between 30 and 604800"))
+	}
+
+	if priority := spec.Options.MonitorPriority; priority != nil && (*priority < 1 || *priority > 5) {
+		errs = append(errs, fmt.Errorf("spec.Options.MonitorPriority must be between 1 and 5"))
+	}
+
+	return utilserrors.NewAggregate(errs)
+}
+
+func isValidDatadogSyntheticTestAssertions(field string, assertions []DatadogSyntheticTestAssertion) []error {
+	var errs []error
+	for _, assertion := range assertions {
+		if assertion.Type == "" || assertion.Operator == "" {
+			errs = append(errs, fmt.Errorf("%s must define a Type and an Operator", field))
+			continue
+		}
+		if assertion.JSONPath != "" && assertion.Operator != "validatesJSONPath" {
+			errs = append(errs, fmt.Errorf("%s can only define a JSONPath when the Operator is validatesJSONPath", field))
+		}
+	}
+
+	return errs
+}
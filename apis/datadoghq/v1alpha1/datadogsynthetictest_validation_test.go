@@ -0,0 +1,136 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+)
+
+func TestIsValidDatadogSyntheticTest(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *DatadogSyntheticTestSpec
+		expected error
+	}{
+		{
+			name: "Valid HTTP test",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Check example.com",
+				Subtype:   DatadogSyntheticTestSubtypeHTTP,
+				Request:   &DatadogSyntheticTestRequest{Method: "GET", URL: "https://example.com"},
+				Locations: []string{"aws:eu-west-1"},
+				Assertions: []DatadogSyntheticTestAssertion{
+					{Type: "statusCode", Operator: "is", Target: "200"},
+					{Type: "body", Operator: "validatesJSONPath", JSONPath: "$.status", Target: "ok"},
+				},
+				Options: DatadogSyntheticTestOptions{TickEvery: apiutils.NewInt64Pointer(60)},
+			},
+			expected: nil,
+		},
+		{
+			name: "Valid multistep test",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Login flow",
+				Subtype:   DatadogSyntheticTestSubtypeMulti,
+				Locations: []string{"aws:eu-west-1"},
+				Steps: []DatadogSyntheticTestStep{
+					{
+						Name:            "Login",
+						Request:         DatadogSyntheticTestRequest{Method: "POST", URL: "https://example.com/login"},
+						Assertions:      []DatadogSyntheticTestAssertion{{Type: "statusCode", Operator: "is", Target: "200"}},
+						ExtractedValues: []DatadogSyntheticTestExtractedValue{{Name: "TOKEN", Type: "http_body", ParserType: "json_path", ParserValue: "$.token"}},
+					},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "Missing required fields",
+			spec: &DatadogSyntheticTestSpec{
+				Subtype: "browser",
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Name must be defined"),
+					errors.New("spec.Locations must be defined"),
+					errors.New("spec.Subtype must be one of the values: http, ssl, dns, tcp, grpc, or multi"),
+				},
+			),
+		},
+		{
+			name: "Test without request or assertions",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Check example.com",
+				Subtype:   DatadogSyntheticTestSubtypeSSL,
+				Locations: []string{"aws:eu-west-1"},
+				Request:   &DatadogSyntheticTestRequest{URL: "https://example.com"},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Request.Host must be defined when spec.Subtype is ssl"),
+					errors.New("spec.Assertions must be defined"),
+				},
+			),
+		},
+		{
+			name: "Multistep test with a request",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Login flow",
+				Subtype:   DatadogSyntheticTestSubtypeMulti,
+				Locations: []string{"aws:eu-west-1"},
+				Request:   &DatadogSyntheticTestRequest{URL: "https://example.com"},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Steps must be defined when spec.Subtype is multi"),
+					errors.New("spec.Request and spec.Assertions cannot be used when spec.Subtype is multi, use spec.Steps instead"),
+				},
+			),
+		},
+		{
+			name: "Invalid assertions and options",
+			spec: &DatadogSyntheticTestSpec{
+				Name:      "Check example.com",
+				Subtype:   DatadogSyntheticTestSubtypeHTTP,
+				Request:   &DatadogSyntheticTestRequest{Method: "GET", URL: "https://example.com"},
+				Locations: []string{"aws:eu-west-1"},
+				Assertions: []DatadogSyntheticTestAssertion{
+					{Type: "statusCode", Target: "200"},
+					{Type: "body", Operator: "contains", JSONPath: "$.status", Target: "ok"},
+				},
+				Options: DatadogSyntheticTestOptions{
+					TickEvery:       apiutils.NewInt64Pointer(10),
+					MonitorPriority: apiutils.NewInt32Pointer(0),
+				},
+			},
+			expected: utilserrors.NewAggregate(
+				[]error{
+					errors.New("spec.Assertions must define a Type and an Operator"),
+					errors.New("spec.Assertions can only define a JSONPath when the Operator is validatesJSONPath"),
+					errors.New("spec.Options.TickEvery must be between 30 and 604800"),
+					errors.New("spec.Options.MonitorPriority must be between 1 and 5"),
+				},
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsValidDatadogSyntheticTest(tt.spec)
+			if tt.expected != nil {
+				assert.EqualError(t, result, tt.expected.Error())
+			} else {
+				assert.Nil(t, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v1alpha1
+
+// DatadogDriftPolicy defines how a controller handles the changes made to a Datadog object outside of
+// its Kubernetes resource, for instance in the Datadog UI.
+type DatadogDriftPolicy string
+
+const (
+	// DatadogDriftPolicyEnforce periodically overwrites the Datadog object with the resource spec.
+	DatadogDriftPolicyEnforce DatadogDriftPolicy = "enforce"
+	// DatadogDriftPolicyDetect pushes the changes of the resource spec to Datadog, but only reports the
+	// changes made outside of the resource instead of overwriting them.
+	DatadogDriftPolicyDetect DatadogDriftPolicy = "detect"
+	// DatadogDriftPolicyObserve never writes to Datadog: the controller only reports the differences between
+	// the Datadog object and the resource spec.
+	DatadogDriftPolicyObserve DatadogDriftPolicy = "observe"
+)
+
+// IsValid returns true if the drift policy is supported. An empty policy defaults to enforce.
+func (p DatadogDriftPolicy) IsValid() bool {
+	switch p {
+	case "", DatadogDriftPolicyEnforce, DatadogDriftPolicyDetect, DatadogDriftPolicyObserve:
+		return true
+	}
+	return false
+}
+
+// OrDefault returns the drift policy, or DatadogDriftPolicyEnforce if it is not set.
+func (p DatadogDriftPolicy) OrDefault() DatadogDriftPolicy {
+	if p == "" {
+		return DatadogDriftPolicyEnforce
+	}
+	return p
+}
@@ -1005,66 +1005,26 @@ func (in *DatadogCredentials) DeepCopy() *DatadogCredentials {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogFeatures) DeepCopyInto(out *DatadogFeatures) {
-	*out = *in
-	if in.OrchestratorExplorer != nil {
-		in, out := &in.OrchestratorExplorer, &out.OrchestratorExplorer
-		*out = new(OrchestratorExplorerConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.KubeStateMetricsCore != nil {
-		in, out := &in.KubeStateMetricsCore, &out.KubeStateMetricsCore
-		*out = new(KubeStateMetricsCore)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.PrometheusScrape != nil {
-		in, out := &in.PrometheusScrape, &out.PrometheusScrape
-		*out = new(PrometheusScrapeConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.NetworkMonitoring != nil {
-		in, out := &in.NetworkMonitoring, &out.NetworkMonitoring
-		*out = new(NetworkMonitoringConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LogCollection != nil {
-		in, out := &in.LogCollection, &out.LogCollection
-		*out = new(LogCollectionConfig)
-		(*in).DeepCopyInto(*out)
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogFeatures.
-func (in *DatadogFeatures) DeepCopy() *DatadogFeatures {
-	if in == nil {
-		return nil
-	}
-	out := new(DatadogFeatures)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
+func (in *DatadogDashboard) DeepCopyInto(out *DatadogDashboard) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetric.
-func (in *DatadogMetric) DeepCopy() *DatadogMetric {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboard.
+func (in *DatadogDashboard) DeepCopy() *DatadogDashboard {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMetric)
+	out := new(DatadogDashboard)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogMetric) DeepCopyObject() runtime.Object {
+func (in *DatadogDashboard) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1072,48 +1032,51 @@ func (in *DatadogMetric) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMetricCondition) DeepCopyInto(out *DatadogMetricCondition) {
+func (in *DatadogDashboardJSON) DeepCopyInto(out *DatadogDashboardJSON) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
-	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(v1.ConfigMapConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricCondition.
-func (in *DatadogMetricCondition) DeepCopy() *DatadogMetricCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardJSON.
+func (in *DatadogDashboardJSON) DeepCopy() *DatadogDashboardJSON {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMetricCondition)
+	out := new(DatadogDashboardJSON)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMetricList) DeepCopyInto(out *DatadogMetricList) {
+func (in *DatadogDashboardList) DeepCopyInto(out *DatadogDashboardList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DatadogMetric, len(*in))
+		*out = make([]DatadogDashboard, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricList.
-func (in *DatadogMetricList) DeepCopy() *DatadogMetricList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardList.
+func (in *DatadogDashboardList) DeepCopy() *DatadogDashboardList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMetricList)
+	out := new(DatadogDashboardList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogMetricList) DeepCopyObject() runtime.Object {
+func (in *DatadogDashboardList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1121,149 +1084,217 @@ func (in *DatadogMetricList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMetricSpec) DeepCopyInto(out *DatadogMetricSpec) {
+func (in *DatadogDashboardSpec) DeepCopyInto(out *DatadogDashboardSpec) {
 	*out = *in
-	out.MaxAge = in.MaxAge
-	out.TimeWindow = in.TimeWindow
+	if in.Widgets != nil {
+		in, out := &in.Widgets, &out.Widgets
+		*out = make([]DatadogDashboardWidget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TemplateVariables != nil {
+		in, out := &in.TemplateVariables, &out.TemplateVariables
+		*out = make([]DatadogDashboardTemplateVariable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DashboardJSON != nil {
+		in, out := &in.DashboardJSON, &out.DashboardJSON
+		*out = new(DatadogDashboardJSON)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricSpec.
-func (in *DatadogMetricSpec) DeepCopy() *DatadogMetricSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardSpec.
+func (in *DatadogDashboardSpec) DeepCopy() *DatadogDashboardSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMetricSpec)
+	out := new(DatadogDashboardSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMetricStatus) DeepCopyInto(out *DatadogMetricStatus) {
+func (in *DatadogDashboardStatus) DeepCopyInto(out *DatadogDashboardStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]DatadogMetricCondition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.LastForceSyncTime != nil {
+		in, out := &in.LastForceSyncTime, &out.LastForceSyncTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricStatus.
-func (in *DatadogMetricStatus) DeepCopy() *DatadogMetricStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardStatus.
+func (in *DatadogDashboardStatus) DeepCopy() *DatadogDashboardStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMetricStatus)
+	out := new(DatadogDashboardStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitor) DeepCopyInto(out *DatadogMonitor) {
+func (in *DatadogDashboardTemplateVariable) DeepCopyInto(out *DatadogDashboardTemplateVariable) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitor.
-func (in *DatadogMonitor) DeepCopy() *DatadogMonitor {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardTemplateVariable.
+func (in *DatadogDashboardTemplateVariable) DeepCopy() *DatadogDashboardTemplateVariable {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitor)
+	out := new(DatadogDashboardTemplateVariable)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogMonitor) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDashboardWidget) DeepCopyInto(out *DatadogDashboardWidget) {
+	*out = *in
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make([]DatadogDashboardWidgetRequest, len(*in))
+		copy(*out, *in)
 	}
-	return nil
+	if in.Layout != nil {
+		in, out := &in.Layout, &out.Layout
+		*out = new(DatadogDashboardWidgetLayout)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardWidget.
+func (in *DatadogDashboardWidget) DeepCopy() *DatadogDashboardWidget {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDashboardWidget)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorCondition) DeepCopyInto(out *DatadogMonitorCondition) {
+func (in *DatadogDashboardWidgetLayout) DeepCopyInto(out *DatadogDashboardWidgetLayout) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
-	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorCondition.
-func (in *DatadogMonitorCondition) DeepCopy() *DatadogMonitorCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardWidgetLayout.
+func (in *DatadogDashboardWidgetLayout) DeepCopy() *DatadogDashboardWidgetLayout {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorCondition)
+	out := new(DatadogDashboardWidgetLayout)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorControllerOptions) DeepCopyInto(out *DatadogMonitorControllerOptions) {
+func (in *DatadogDashboardWidgetRequest) DeepCopyInto(out *DatadogDashboardWidgetRequest) {
 	*out = *in
-	if in.DisableRequiredTags != nil {
-		in, out := &in.DisableRequiredTags, &out.DisableRequiredTags
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDashboardWidgetRequest.
+func (in *DatadogDashboardWidgetRequest) DeepCopy() *DatadogDashboardWidgetRequest {
+	if in == nil {
+		return nil
 	}
+	out := new(DatadogDashboardWidgetRequest)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorControllerOptions.
-func (in *DatadogMonitorControllerOptions) DeepCopy() *DatadogMonitorControllerOptions {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntime) DeepCopyInto(out *DatadogDowntime) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntime.
+func (in *DatadogDowntime) DeepCopy() *DatadogDowntime {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorControllerOptions)
+	out := new(DatadogDowntime)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogDowntime) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorDowntimeStatus) DeepCopyInto(out *DatadogMonitorDowntimeStatus) {
+func (in *DatadogDowntimeInstance) DeepCopyInto(out *DatadogDowntimeInstance) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorDowntimeStatus.
-func (in *DatadogMonitorDowntimeStatus) DeepCopy() *DatadogMonitorDowntimeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeInstance.
+func (in *DatadogDowntimeInstance) DeepCopy() *DatadogDowntimeInstance {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorDowntimeStatus)
+	out := new(DatadogDowntimeInstance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorList) DeepCopyInto(out *DatadogMonitorList) {
+func (in *DatadogDowntimeList) DeepCopyInto(out *DatadogDowntimeList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DatadogMonitor, len(*in))
+		*out = make([]DatadogDowntime, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorList.
-func (in *DatadogMonitorList) DeepCopy() *DatadogMonitorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeList.
+func (in *DatadogDowntimeList) DeepCopy() *DatadogDowntimeList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorList)
+	out := new(DatadogDowntimeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogMonitorList) DeepCopyObject() runtime.Object {
+func (in *DatadogDowntimeList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1271,92 +1302,807 @@ func (in *DatadogMonitorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorOptions) DeepCopyInto(out *DatadogMonitorOptions) {
+func (in *DatadogDowntimeMonitorSelector) DeepCopyInto(out *DatadogDowntimeMonitorSelector) {
 	*out = *in
-	if in.EnableLogsSample != nil {
-		in, out := &in.EnableLogsSample, &out.EnableLogsSample
-		*out = new(bool)
-		**out = **in
-	}
-	if in.EscalationMessage != nil {
-		in, out := &in.EscalationMessage, &out.EscalationMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.EvaluationDelay != nil {
-		in, out := &in.EvaluationDelay, &out.EvaluationDelay
-		*out = new(int64)
-		**out = **in
-	}
-	if in.IncludeTags != nil {
-		in, out := &in.IncludeTags, &out.IncludeTags
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Locked != nil {
-		in, out := &in.Locked, &out.Locked
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NewGroupDelay != nil {
-		in, out := &in.NewGroupDelay, &out.NewGroupDelay
-		*out = new(int64)
-		**out = **in
-	}
-	if in.NoDataTimeframe != nil {
-		in, out := &in.NoDataTimeframe, &out.NoDataTimeframe
-		*out = new(int64)
-		**out = **in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.NotifyAudit != nil {
-		in, out := &in.NotifyAudit, &out.NotifyAudit
-		*out = new(bool)
-		**out = **in
+	if in.MonitorTags != nil {
+		in, out := &in.MonitorTags, &out.MonitorTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.NotifyNoData != nil {
-		in, out := &in.NotifyNoData, &out.NotifyNoData
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeMonitorSelector.
+func (in *DatadogDowntimeMonitorSelector) DeepCopy() *DatadogDowntimeMonitorSelector {
+	if in == nil {
+		return nil
 	}
-	if in.RenotifyInterval != nil {
-		in, out := &in.RenotifyInterval, &out.RenotifyInterval
-		*out = new(int64)
-		**out = **in
+	out := new(DatadogDowntimeMonitorSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeRecurrence) DeepCopyInto(out *DatadogDowntimeRecurrence) {
+	*out = *in
+	if in.WeekDays != nil {
+		in, out := &in.WeekDays, &out.WeekDays
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	if in.RequireFullWindow != nil {
-		in, out := &in.RequireFullWindow, &out.RequireFullWindow
-		*out = new(bool)
-		**out = **in
+	if in.UntilDate != nil {
+		in, out := &in.UntilDate, &out.UntilDate
+		*out = (*in).DeepCopy()
 	}
-	if in.TimeoutH != nil {
-		in, out := &in.TimeoutH, &out.TimeoutH
-		*out = new(int64)
+	if in.UntilOccurrences != nil {
+		in, out := &in.UntilOccurrences, &out.UntilOccurrences
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Thresholds != nil {
-		in, out := &in.Thresholds, &out.Thresholds
-		*out = new(DatadogMonitorOptionsThresholds)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ThresholdWindows != nil {
-		in, out := &in.ThresholdWindows, &out.ThresholdWindows
-		*out = new(DatadogMonitorOptionsThresholdWindows)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorOptions.
-func (in *DatadogMonitorOptions) DeepCopy() *DatadogMonitorOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeRecurrence.
+func (in *DatadogDowntimeRecurrence) DeepCopy() *DatadogDowntimeRecurrence {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorOptions)
+	out := new(DatadogDowntimeRecurrence)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorOptionsThresholdWindows) DeepCopyInto(out *DatadogMonitorOptionsThresholdWindows) {
+func (in *DatadogDowntimeSpec) DeepCopyInto(out *DatadogDowntimeSpec) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MonitorSelector != nil {
+		in, out := &in.MonitorSelector, &out.MonitorSelector
+		*out = new(DatadogDowntimeMonitorSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Recurrence != nil {
+		in, out := &in.Recurrence, &out.Recurrence
+		*out = new(DatadogDowntimeRecurrence)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MuteFirstRecoveryNotification != nil {
+		in, out := &in.MuteFirstRecoveryNotification, &out.MuteFirstRecoveryNotification
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeSpec.
+func (in *DatadogDowntimeSpec) DeepCopy() *DatadogDowntimeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogDowntimeStatus) DeepCopyInto(out *DatadogDowntimeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Downtimes != nil {
+		in, out := &in.Downtimes, &out.Downtimes
+		*out = make([]DatadogDowntimeInstance, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastForceSyncTime != nil {
+		in, out := &in.LastForceSyncTime, &out.LastForceSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.StateLastUpdateTime != nil {
+		in, out := &in.StateLastUpdateTime, &out.StateLastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogDowntimeStatus.
+func (in *DatadogDowntimeStatus) DeepCopy() *DatadogDowntimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogDowntimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogFeatures) DeepCopyInto(out *DatadogFeatures) {
+	*out = *in
+	if in.OrchestratorExplorer != nil {
+		in, out := &in.OrchestratorExplorer, &out.OrchestratorExplorer
+		*out = new(OrchestratorExplorerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeStateMetricsCore != nil {
+		in, out := &in.KubeStateMetricsCore, &out.KubeStateMetricsCore
+		*out = new(KubeStateMetricsCore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrometheusScrape != nil {
+		in, out := &in.PrometheusScrape, &out.PrometheusScrape
+		*out = new(PrometheusScrapeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkMonitoring != nil {
+		in, out := &in.NetworkMonitoring, &out.NetworkMonitoring
+		*out = new(NetworkMonitoringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogCollection != nil {
+		in, out := &in.LogCollection, &out.LogCollection
+		*out = new(LogCollectionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogFeatures.
+func (in *DatadogFeatures) DeepCopy() *DatadogFeatures {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogFeatures)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMetric) DeepCopyInto(out *DatadogMetric) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetric.
+func (in *DatadogMetric) DeepCopy() *DatadogMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMetric) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMetricCondition) DeepCopyInto(out *DatadogMetricCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricCondition.
+func (in *DatadogMetricCondition) DeepCopy() *DatadogMetricCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMetricCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMetricList) DeepCopyInto(out *DatadogMetricList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricList.
+func (in *DatadogMetricList) DeepCopy() *DatadogMetricList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMetricList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMetricList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMetricSpec) DeepCopyInto(out *DatadogMetricSpec) {
+	*out = *in
+	out.MaxAge = in.MaxAge
+	out.TimeWindow = in.TimeWindow
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricSpec.
+func (in *DatadogMetricSpec) DeepCopy() *DatadogMetricSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMetricSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMetricStatus) DeepCopyInto(out *DatadogMetricStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]DatadogMetricCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMetricStatus.
+func (in *DatadogMetricStatus) DeepCopy() *DatadogMetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitor) DeepCopyInto(out *DatadogMonitor) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitor.
+func (in *DatadogMonitor) DeepCopy() *DatadogMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMonitor) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorCondition) DeepCopyInto(out *DatadogMonitorCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorCondition.
+func (in *DatadogMonitorCondition) DeepCopy() *DatadogMonitorCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorControllerOptions) DeepCopyInto(out *DatadogMonitorControllerOptions) {
+	*out = *in
+	if in.DisableRequiredTags != nil {
+		in, out := &in.DisableRequiredTags, &out.DisableRequiredTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdoptMonitorID != nil {
+		in, out := &in.AdoptMonitorID, &out.AdoptMonitorID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AdoptByNameAndTags != nil {
+		in, out := &in.AdoptByNameAndTags, &out.AdoptByNameAndTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxTriggeredStateGroups != nil {
+		in, out := &in.MaxTriggeredStateGroups, &out.MaxTriggeredStateGroups
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorControllerOptions.
+func (in *DatadogMonitorControllerOptions) DeepCopy() *DatadogMonitorControllerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorControllerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorDowntimeStatus) DeepCopyInto(out *DatadogMonitorDowntimeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorDowntimeStatus.
+func (in *DatadogMonitorDowntimeStatus) DeepCopy() *DatadogMonitorDowntimeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorDowntimeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryDefinition) DeepCopyInto(out *DatadogMonitorFormulaAndFunctionEventQueryDefinition) {
+	*out = *in
+	in.Compute.DeepCopyInto(&out.Compute)
+	if in.Search != nil {
+		in, out := &in.Search, &out.Search
+		*out = new(DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch)
+		**out = **in
+	}
+	if in.Indexes != nil {
+		in, out := &in.Indexes, &out.Indexes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GroupBy != nil {
+		in, out := &in.GroupBy, &out.GroupBy
+		*out = make([]DatadogMonitorFormulaAndFunctionEventQueryGroupBy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorFormulaAndFunctionEventQueryDefinition.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryDefinition) DeepCopy() *DatadogMonitorFormulaAndFunctionEventQueryDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorFormulaAndFunctionEventQueryDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute) DeepCopyInto(out *DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute) DeepCopy() *DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch) DeepCopyInto(out *DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch) DeepCopy() *DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryGroupBy) DeepCopyInto(out *DatadogMonitorFormulaAndFunctionEventQueryGroupBy) {
+	*out = *in
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Sort != nil {
+		in, out := &in.Sort, &out.Sort
+		*out = new(DatadogMonitorFormulaAndFunctionEventQueryGroupBySort)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorFormulaAndFunctionEventQueryGroupBy.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryGroupBy) DeepCopy() *DatadogMonitorFormulaAndFunctionEventQueryGroupBy {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorFormulaAndFunctionEventQueryGroupBy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryGroupBySort) DeepCopyInto(out *DatadogMonitorFormulaAndFunctionEventQueryGroupBySort) {
+	*out = *in
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(string)
+		**out = **in
+	}
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorFormulaAndFunctionEventQueryGroupBySort.
+func (in *DatadogMonitorFormulaAndFunctionEventQueryGroupBySort) DeepCopy() *DatadogMonitorFormulaAndFunctionEventQueryGroupBySort {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorFormulaAndFunctionEventQueryGroupBySort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorGroupStates) DeepCopyInto(out *DatadogMonitorGroupStates) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorGroupStates.
+func (in *DatadogMonitorGroupStates) DeepCopy() *DatadogMonitorGroupStates {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorGroupStates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorList) DeepCopyInto(out *DatadogMonitorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogMonitor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorList.
+func (in *DatadogMonitorList) DeepCopy() *DatadogMonitorList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogMonitorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorOptions) DeepCopyInto(out *DatadogMonitorOptions) {
+	*out = *in
+	if in.EnableLogsSample != nil {
+		in, out := &in.EnableLogsSample, &out.EnableLogsSample
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableSamples != nil {
+		in, out := &in.EnableSamples, &out.EnableSamples
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EscalationMessage != nil {
+		in, out := &in.EscalationMessage, &out.EscalationMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.EvaluationDelay != nil {
+		in, out := &in.EvaluationDelay, &out.EvaluationDelay
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GroupRetentionDuration != nil {
+		in, out := &in.GroupRetentionDuration, &out.GroupRetentionDuration
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupbySimpleMonitor != nil {
+		in, out := &in.GroupbySimpleMonitor, &out.GroupbySimpleMonitor
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludeTags != nil {
+		in, out := &in.IncludeTags, &out.IncludeTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinFailureDuration != nil {
+		in, out := &in.MinFailureDuration, &out.MinFailureDuration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinLocationFailed != nil {
+		in, out := &in.MinLocationFailed, &out.MinLocationFailed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NewGroupDelay != nil {
+		in, out := &in.NewGroupDelay, &out.NewGroupDelay
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NewHostDelay != nil {
+		in, out := &in.NewHostDelay, &out.NewHostDelay
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NoDataTimeframe != nil {
+		in, out := &in.NoDataTimeframe, &out.NoDataTimeframe
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NotificationPresetName != nil {
+		in, out := &in.NotificationPresetName, &out.NotificationPresetName
+		*out = new(DatadogMonitorNotificationPreset)
+		**out = **in
+	}
+	if in.NotifyAudit != nil {
+		in, out := &in.NotifyAudit, &out.NotifyAudit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NotifyBy != nil {
+		in, out := &in.NotifyBy, &out.NotifyBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotifyNoData != nil {
+		in, out := &in.NotifyNoData, &out.NotifyNoData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnMissingData != nil {
+		in, out := &in.OnMissingData, &out.OnMissingData
+		*out = new(DatadogMonitorOnMissingData)
+		**out = **in
+	}
+	if in.RenotifyInterval != nil {
+		in, out := &in.RenotifyInterval, &out.RenotifyInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RenotifyOccurrences != nil {
+		in, out := &in.RenotifyOccurrences, &out.RenotifyOccurrences
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RenotifyStatuses != nil {
+		in, out := &in.RenotifyStatuses, &out.RenotifyStatuses
+		*out = make([]DatadogMonitorRenotifyStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.RequireFullWindow != nil {
+		in, out := &in.RequireFullWindow, &out.RequireFullWindow
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SchedulingOptions != nil {
+		in, out := &in.SchedulingOptions, &out.SchedulingOptions
+		*out = new(DatadogMonitorOptionsSchedulingOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutH != nil {
+		in, out := &in.TimeoutH, &out.TimeoutH
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = new(DatadogMonitorOptionsThresholds)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThresholdWindows != nil {
+		in, out := &in.ThresholdWindows, &out.ThresholdWindows
+		*out = new(DatadogMonitorOptionsThresholdWindows)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make([]DatadogMonitorFormulaAndFunctionEventQueryDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorOptions.
+func (in *DatadogMonitorOptions) DeepCopy() *DatadogMonitorOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorOptionsCustomSchedule) DeepCopyInto(out *DatadogMonitorOptionsCustomSchedule) {
+	*out = *in
+	if in.Recurrences != nil {
+		in, out := &in.Recurrences, &out.Recurrences
+		*out = make([]DatadogMonitorOptionsCustomScheduleRecurrence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorOptionsCustomSchedule.
+func (in *DatadogMonitorOptionsCustomSchedule) DeepCopy() *DatadogMonitorOptionsCustomSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorOptionsCustomSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorOptionsCustomScheduleRecurrence) DeepCopyInto(out *DatadogMonitorOptionsCustomScheduleRecurrence) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorOptionsCustomScheduleRecurrence.
+func (in *DatadogMonitorOptionsCustomScheduleRecurrence) DeepCopy() *DatadogMonitorOptionsCustomScheduleRecurrence {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorOptionsCustomScheduleRecurrence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorOptionsSchedulingOptions) DeepCopyInto(out *DatadogMonitorOptionsSchedulingOptions) {
+	*out = *in
+	if in.EvaluationWindow != nil {
+		in, out := &in.EvaluationWindow, &out.EvaluationWindow
+		*out = new(DatadogMonitorOptionsSchedulingOptionsEvaluationWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomSchedule != nil {
+		in, out := &in.CustomSchedule, &out.CustomSchedule
+		*out = new(DatadogMonitorOptionsCustomSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorOptionsSchedulingOptions.
+func (in *DatadogMonitorOptionsSchedulingOptions) DeepCopy() *DatadogMonitorOptionsSchedulingOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorOptionsSchedulingOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorOptionsSchedulingOptionsEvaluationWindow) DeepCopyInto(out *DatadogMonitorOptionsSchedulingOptionsEvaluationWindow) {
+	*out = *in
+	if in.DayStarts != nil {
+		in, out := &in.DayStarts, &out.DayStarts
+		*out = new(string)
+		**out = **in
+	}
+	if in.HourStarts != nil {
+		in, out := &in.HourStarts, &out.HourStarts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MonthStarts != nil {
+		in, out := &in.MonthStarts, &out.MonthStarts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorOptionsSchedulingOptionsEvaluationWindow.
+func (in *DatadogMonitorOptionsSchedulingOptionsEvaluationWindow) DeepCopy() *DatadogMonitorOptionsSchedulingOptionsEvaluationWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorOptionsSchedulingOptionsEvaluationWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorOptionsThresholdWindows) DeepCopyInto(out *DatadogMonitorOptionsThresholdWindows) {
 	*out = *in
 	if in.RecoveryWindow != nil {
 		in, out := &in.RecoveryWindow, &out.RecoveryWindow
@@ -1428,36 +2174,265 @@ func (in *DatadogMonitorOptionsThresholds) DeepCopy() *DatadogMonitorOptionsThre
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogMonitorSpec) DeepCopyInto(out *DatadogMonitorSpec) {
 	*out = *in
-	if in.RestrictedRoles != nil {
-		in, out := &in.RestrictedRoles, &out.RestrictedRoles
+	if in.RestrictedRoles != nil {
+		in, out := &in.RestrictedRoles, &out.RestrictedRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Options.DeepCopyInto(&out.Options)
+	in.ControllerOptions.DeepCopyInto(&out.ControllerOptions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorSpec.
+func (in *DatadogMonitorSpec) DeepCopy() *DatadogMonitorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorStatus) DeepCopyInto(out *DatadogMonitorStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]DatadogMonitorCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.MonitorLastForceSyncTime != nil {
+		in, out := &in.MonitorLastForceSyncTime, &out.MonitorLastForceSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MonitorStateLastUpdateTime != nil {
+		in, out := &in.MonitorStateLastUpdateTime, &out.MonitorStateLastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.MonitorStateLastTransitionTime != nil {
+		in, out := &in.MonitorStateLastTransitionTime, &out.MonitorStateLastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TriggeredState != nil {
+		in, out := &in.TriggeredState, &out.TriggeredState
+		*out = make([]DatadogMonitorTriggeredState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GroupStates != nil {
+		in, out := &in.GroupStates, &out.GroupStates
+		*out = new(DatadogMonitorGroupStates)
+		**out = **in
+	}
+	out.DowntimeStatus = in.DowntimeStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorStatus.
+func (in *DatadogMonitorStatus) DeepCopy() *DatadogMonitorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogMonitorTriggeredState) DeepCopyInto(out *DatadogMonitorTriggeredState) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTriggeredState.
+func (in *DatadogMonitorTriggeredState) DeepCopy() *DatadogMonitorTriggeredState {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogMonitorTriggeredState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLO) DeepCopyInto(out *DatadogSLO) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLO.
+func (in *DatadogSLO) DeepCopy() *DatadogSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogSLO) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOControllerOptions) DeepCopyInto(out *DatadogSLOControllerOptions) {
+	*out = *in
+	if in.DisableRequiredTags != nil {
+		in, out := &in.DisableRequiredTags, &out.DisableRequiredTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdoptSLOID != nil {
+		in, out := &in.AdoptSLOID, &out.AdoptSLOID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOControllerOptions.
+func (in *DatadogSLOControllerOptions) DeepCopy() *DatadogSLOControllerOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOControllerOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOList) DeepCopyInto(out *DatadogSLOList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogSLO, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOList.
+func (in *DatadogSLOList) DeepCopy() *DatadogSLOList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogSLOList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOQuery) DeepCopyInto(out *DatadogSLOQuery) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOQuery.
+func (in *DatadogSLOQuery) DeepCopy() *DatadogSLOQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSLOQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOSpec) DeepCopyInto(out *DatadogSLOSpec) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MonitorIDs != nil {
+		in, out := &in.MonitorIDs, &out.MonitorIDs
+		*out = make([]int64, len(*in))
+		copy(*out, *in)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	in.Options.DeepCopyInto(&out.Options)
-	in.ControllerOptions.DeepCopyInto(&out.ControllerOptions)
+	if in.Query != nil {
+		in, out := &in.Query, &out.Query
+		*out = new(DatadogSLOQuery)
+		**out = **in
+	}
+	if in.TimeSlice != nil {
+		in, out := &in.TimeSlice, &out.TimeSlice
+		*out = new(DatadogSLOTimeSlice)
+		(*in).DeepCopyInto(*out)
+	}
+	out.TargetThreshold = in.TargetThreshold.DeepCopy()
+	if in.WarningThreshold != nil {
+		in, out := &in.WarningThreshold, &out.WarningThreshold
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Thresholds != nil {
+		in, out := &in.Thresholds, &out.Thresholds
+		*out = make([]DatadogSLOThreshold, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ControllerOptions != nil {
+		in, out := &in.ControllerOptions, &out.ControllerOptions
+		*out = new(DatadogSLOControllerOptions)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorSpec.
-func (in *DatadogMonitorSpec) DeepCopy() *DatadogMonitorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOSpec.
+func (in *DatadogSLOSpec) DeepCopy() *DatadogSLOSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorSpec)
+	out := new(DatadogSLOSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorStatus) DeepCopyInto(out *DatadogMonitorStatus) {
+func (in *DatadogSLOStatus) DeepCopyInto(out *DatadogSLOStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]DatadogMonitorCondition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1466,56 +2441,80 @@ func (in *DatadogMonitorStatus) DeepCopyInto(out *DatadogMonitorStatus) {
 		in, out := &in.Created, &out.Created
 		*out = (*in).DeepCopy()
 	}
-	if in.MonitorLastForceSyncTime != nil {
-		in, out := &in.MonitorLastForceSyncTime, &out.MonitorLastForceSyncTime
+	if in.LastForceSyncTime != nil {
+		in, out := &in.LastForceSyncTime, &out.LastForceSyncTime
 		*out = (*in).DeepCopy()
 	}
-	if in.MonitorStateLastUpdateTime != nil {
-		in, out := &in.MonitorStateLastUpdateTime, &out.MonitorStateLastUpdateTime
-		*out = (*in).DeepCopy()
+	if in.SLIValue != nil {
+		in, out := &in.SLIValue, &out.SLIValue
+		*out = new(string)
+		**out = **in
 	}
-	if in.MonitorStateLastTransitionTime != nil {
-		in, out := &in.MonitorStateLastTransitionTime, &out.MonitorStateLastTransitionTime
+	if in.ErrorBudgetRemaining != nil {
+		in, out := &in.ErrorBudgetRemaining, &out.ErrorBudgetRemaining
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateLastUpdateTime != nil {
+		in, out := &in.StateLastUpdateTime, &out.StateLastUpdateTime
 		*out = (*in).DeepCopy()
 	}
-	if in.TriggeredState != nil {
-		in, out := &in.TriggeredState, &out.TriggeredState
-		*out = make([]DatadogMonitorTriggeredState, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOStatus.
+func (in *DatadogSLOStatus) DeepCopy() *DatadogSLOStatus {
+	if in == nil {
+		return nil
 	}
-	out.DowntimeStatus = in.DowntimeStatus
+	out := new(DatadogSLOStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorStatus.
-func (in *DatadogMonitorStatus) DeepCopy() *DatadogMonitorStatus {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSLOThreshold) DeepCopyInto(out *DatadogSLOThreshold) {
+	*out = *in
+	out.Target = in.Target.DeepCopy()
+	if in.Warning != nil {
+		in, out := &in.Warning, &out.Warning
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOThreshold.
+func (in *DatadogSLOThreshold) DeepCopy() *DatadogSLOThreshold {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorStatus)
+	out := new(DatadogSLOThreshold)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogMonitorTriggeredState) DeepCopyInto(out *DatadogMonitorTriggeredState) {
+func (in *DatadogSLOTimeSlice) DeepCopyInto(out *DatadogSLOTimeSlice) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	out.Threshold = in.Threshold.DeepCopy()
+	if in.QueryIntervalSeconds != nil {
+		in, out := &in.QueryIntervalSeconds, &out.QueryIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogMonitorTriggeredState.
-func (in *DatadogMonitorTriggeredState) DeepCopy() *DatadogMonitorTriggeredState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOTimeSlice.
+func (in *DatadogSLOTimeSlice) DeepCopy() *DatadogSLOTimeSlice {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogMonitorTriggeredState)
+	out := new(DatadogSLOTimeSlice)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLO) DeepCopyInto(out *DatadogSLO) {
+func (in *DatadogSyntheticTest) DeepCopyInto(out *DatadogSyntheticTest) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1523,18 +2522,18 @@ func (in *DatadogSLO) DeepCopyInto(out *DatadogSLO) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLO.
-func (in *DatadogSLO) DeepCopy() *DatadogSLO {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTest.
+func (in *DatadogSyntheticTest) DeepCopy() *DatadogSyntheticTest {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLO)
+	out := new(DatadogSyntheticTest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogSLO) DeepCopyObject() runtime.Object {
+func (in *DatadogSyntheticTest) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1542,51 +2541,61 @@ func (in *DatadogSLO) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOControllerOptions) DeepCopyInto(out *DatadogSLOControllerOptions) {
+func (in *DatadogSyntheticTestAssertion) DeepCopyInto(out *DatadogSyntheticTestAssertion) {
 	*out = *in
-	if in.DisableRequiredTags != nil {
-		in, out := &in.DisableRequiredTags, &out.DisableRequiredTags
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestAssertion.
+func (in *DatadogSyntheticTestAssertion) DeepCopy() *DatadogSyntheticTestAssertion {
+	if in == nil {
+		return nil
 	}
+	out := new(DatadogSyntheticTestAssertion)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOControllerOptions.
-func (in *DatadogSLOControllerOptions) DeepCopy() *DatadogSLOControllerOptions {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestExtractedValue) DeepCopyInto(out *DatadogSyntheticTestExtractedValue) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestExtractedValue.
+func (in *DatadogSyntheticTestExtractedValue) DeepCopy() *DatadogSyntheticTestExtractedValue {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOControllerOptions)
+	out := new(DatadogSyntheticTestExtractedValue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOList) DeepCopyInto(out *DatadogSLOList) {
+func (in *DatadogSyntheticTestList) DeepCopyInto(out *DatadogSyntheticTestList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DatadogSLO, len(*in))
+		*out = make([]DatadogSyntheticTest, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOList.
-func (in *DatadogSLOList) DeepCopy() *DatadogSLOList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestList.
+func (in *DatadogSyntheticTestList) DeepCopy() *DatadogSyntheticTestList {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOList)
+	out := new(DatadogSyntheticTestList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatadogSLOList) DeepCopyObject() runtime.Object {
+func (in *DatadogSyntheticTestList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1594,73 +2603,152 @@ func (in *DatadogSLOList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOQuery) DeepCopyInto(out *DatadogSLOQuery) {
+func (in *DatadogSyntheticTestOptions) DeepCopyInto(out *DatadogSyntheticTestOptions) {
 	*out = *in
+	if in.TickEvery != nil {
+		in, out := &in.TickEvery, &out.TickEvery
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinFailureDuration != nil {
+		in, out := &in.MinFailureDuration, &out.MinFailureDuration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinLocationFailed != nil {
+		in, out := &in.MinLocationFailed, &out.MinLocationFailed
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryCount != nil {
+		in, out := &in.RetryCount, &out.RetryCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RetryInterval != nil {
+		in, out := &in.RetryInterval, &out.RetryInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RenotifyInterval != nil {
+		in, out := &in.RenotifyInterval, &out.RenotifyInterval
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MonitorPriority != nil {
+		in, out := &in.MonitorPriority, &out.MonitorPriority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FollowRedirects != nil {
+		in, out := &in.FollowRedirects, &out.FollowRedirects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AcceptSelfSigned != nil {
+		in, out := &in.AcceptSelfSigned, &out.AcceptSelfSigned
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowInsecure != nil {
+		in, out := &in.AllowInsecure, &out.AllowInsecure
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOQuery.
-func (in *DatadogSLOQuery) DeepCopy() *DatadogSLOQuery {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestOptions.
+func (in *DatadogSyntheticTestOptions) DeepCopy() *DatadogSyntheticTestOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOQuery)
+	out := new(DatadogSyntheticTestOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOSpec) DeepCopyInto(out *DatadogSLOSpec) {
+func (in *DatadogSyntheticTestRequest) DeepCopyInto(out *DatadogSyntheticTestRequest) {
 	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int64)
 		**out = **in
 	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]string, len(*in))
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DNSServerPort != nil {
+		in, out := &in.DNSServerPort, &out.DNSServerPort
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestRequest.
+func (in *DatadogSyntheticTestRequest) DeepCopy() *DatadogSyntheticTestRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestSpec) DeepCopyInto(out *DatadogSyntheticTestSpec) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(DatadogSyntheticTestRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Assertions != nil {
+		in, out := &in.Assertions, &out.Assertions
+		*out = make([]DatadogSyntheticTestAssertion, len(*in))
 		copy(*out, *in)
 	}
-	if in.MonitorIDs != nil {
-		in, out := &in.MonitorIDs, &out.MonitorIDs
-		*out = make([]int64, len(*in))
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DatadogSyntheticTestStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Locations != nil {
+		in, out := &in.Locations, &out.Locations
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.Options.DeepCopyInto(&out.Options)
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Query != nil {
-		in, out := &in.Query, &out.Query
-		*out = new(DatadogSLOQuery)
-		**out = **in
-	}
-	out.TargetThreshold = in.TargetThreshold.DeepCopy()
-	if in.WarningThreshold != nil {
-		in, out := &in.WarningThreshold, &out.WarningThreshold
-		x := (*in).DeepCopy()
-		*out = &x
-	}
-	if in.ControllerOptions != nil {
-		in, out := &in.ControllerOptions, &out.ControllerOptions
-		*out = new(DatadogSLOControllerOptions)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOSpec.
-func (in *DatadogSLOSpec) DeepCopy() *DatadogSLOSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestSpec.
+func (in *DatadogSyntheticTestSpec) DeepCopy() *DatadogSyntheticTestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOSpec)
+	out := new(DatadogSyntheticTestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatadogSLOStatus) DeepCopyInto(out *DatadogSLOStatus) {
+func (in *DatadogSyntheticTestStatus) DeepCopyInto(out *DatadogSyntheticTestStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1673,18 +2761,62 @@ func (in *DatadogSLOStatus) DeepCopyInto(out *DatadogSLOStatus) {
 		in, out := &in.Created, &out.Created
 		*out = (*in).DeepCopy()
 	}
+	if in.LastResultTime != nil {
+		in, out := &in.LastResultTime, &out.LastResultTime
+		*out = (*in).DeepCopy()
+	}
 	if in.LastForceSyncTime != nil {
 		in, out := &in.LastForceSyncTime, &out.LastForceSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.StateLastUpdateTime != nil {
+		in, out := &in.StateLastUpdateTime, &out.StateLastUpdateTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSLOStatus.
-func (in *DatadogSLOStatus) DeepCopy() *DatadogSLOStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestStatus.
+func (in *DatadogSyntheticTestStatus) DeepCopy() *DatadogSyntheticTestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DatadogSLOStatus)
+	out := new(DatadogSyntheticTestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogSyntheticTestStep) DeepCopyInto(out *DatadogSyntheticTestStep) {
+	*out = *in
+	in.Request.DeepCopyInto(&out.Request)
+	if in.Assertions != nil {
+		in, out := &in.Assertions, &out.Assertions
+		*out = make([]DatadogSyntheticTestAssertion, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtractedValues != nil {
+		in, out := &in.ExtractedValues, &out.ExtractedValues
+		*out = make([]DatadogSyntheticTestExtractedValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowFailure != nil {
+		in, out := &in.AllowFailure, &out.AllowFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IsCritical != nil {
+		in, out := &in.IsCritical, &out.IsCritical
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogSyntheticTestStep.
+func (in *DatadogSyntheticTestStep) DeepCopy() *DatadogSyntheticTestStep {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogSyntheticTestStep)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -19,66 +19,100 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"./apis/datadoghq/v1alpha1.APMSpec":                                 schema__apis_datadoghq_v1alpha1_APMSpec(ref),
-		"./apis/datadoghq/v1alpha1.APMUnixDomainSocketSpec":                 schema__apis_datadoghq_v1alpha1_APMUnixDomainSocketSpec(ref),
-		"./apis/datadoghq/v1alpha1.AdmissionControllerConfig":               schema__apis_datadoghq_v1alpha1_AdmissionControllerConfig(ref),
-		"./apis/datadoghq/v1alpha1.AgentCredentials":                        schema__apis_datadoghq_v1alpha1_AgentCredentials(ref),
-		"./apis/datadoghq/v1alpha1.CRISocketConfig":                         schema__apis_datadoghq_v1alpha1_CRISocketConfig(ref),
-		"./apis/datadoghq/v1alpha1.ClusterAgentConfig":                      schema__apis_datadoghq_v1alpha1_ClusterAgentConfig(ref),
-		"./apis/datadoghq/v1alpha1.ClusterChecksRunnerConfig":               schema__apis_datadoghq_v1alpha1_ClusterChecksRunnerConfig(ref),
-		"./apis/datadoghq/v1alpha1.ComplianceSpec":                          schema__apis_datadoghq_v1alpha1_ComplianceSpec(ref),
-		"./apis/datadoghq/v1alpha1.ConfigDirSpec":                           schema__apis_datadoghq_v1alpha1_ConfigDirSpec(ref),
-		"./apis/datadoghq/v1alpha1.ConfigFileConfigMapSpec":                 schema__apis_datadoghq_v1alpha1_ConfigFileConfigMapSpec(ref),
-		"./apis/datadoghq/v1alpha1.CustomConfigSpec":                        schema__apis_datadoghq_v1alpha1_CustomConfigSpec(ref),
-		"./apis/datadoghq/v1alpha1.DSDUnixDomainSocketSpec":                 schema__apis_datadoghq_v1alpha1_DSDUnixDomainSocketSpec(ref),
-		"./apis/datadoghq/v1alpha1.DaemonSetDeploymentStrategy":             schema__apis_datadoghq_v1alpha1_DaemonSetDeploymentStrategy(ref),
-		"./apis/datadoghq/v1alpha1.DaemonSetRollingUpdateSpec":              schema__apis_datadoghq_v1alpha1_DaemonSetRollingUpdateSpec(ref),
-		"./apis/datadoghq/v1alpha1.DatadogAgent":                            schema__apis_datadoghq_v1alpha1_DatadogAgent(ref),
-		"./apis/datadoghq/v1alpha1.DatadogAgentCondition":                   schema__apis_datadoghq_v1alpha1_DatadogAgentCondition(ref),
-		"./apis/datadoghq/v1alpha1.DatadogAgentSpec":                        schema__apis_datadoghq_v1alpha1_DatadogAgentSpec(ref),
-		"./apis/datadoghq/v1alpha1.DatadogAgentSpecAgentSpec":               schema__apis_datadoghq_v1alpha1_DatadogAgentSpecAgentSpec(ref),
-		"./apis/datadoghq/v1alpha1.DatadogAgentSpecClusterAgentSpec":        schema__apis_datadoghq_v1alpha1_DatadogAgentSpecClusterAgentSpec(ref),
-		"./apis/datadoghq/v1alpha1.DatadogAgentSpecClusterChecksRunnerSpec": schema__apis_datadoghq_v1alpha1_DatadogAgentSpecClusterChecksRunnerSpec(ref),
-		"./apis/datadoghq/v1alpha1.DatadogAgentStatus":                      schema__apis_datadoghq_v1alpha1_DatadogAgentStatus(ref),
-		"./apis/datadoghq/v1alpha1.DatadogCredentials":                      schema__apis_datadoghq_v1alpha1_DatadogCredentials(ref),
-		"./apis/datadoghq/v1alpha1.DatadogFeatures":                         schema__apis_datadoghq_v1alpha1_DatadogFeatures(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMetric":                           schema__apis_datadoghq_v1alpha1_DatadogMetric(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMetricCondition":                  schema__apis_datadoghq_v1alpha1_DatadogMetricCondition(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitor":                          schema__apis_datadoghq_v1alpha1_DatadogMonitor(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorCondition":                 schema__apis_datadoghq_v1alpha1_DatadogMonitorCondition(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorControllerOptions":         schema__apis_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus":            schema__apis_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorOptions":                   schema__apis_datadoghq_v1alpha1_DatadogMonitorOptions(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows":   schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds":         schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorSpec":                      schema__apis_datadoghq_v1alpha1_DatadogMonitorSpec(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorStatus":                    schema__apis_datadoghq_v1alpha1_DatadogMonitorStatus(ref),
-		"./apis/datadoghq/v1alpha1.DatadogMonitorTriggeredState":            schema__apis_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref),
-		"./apis/datadoghq/v1alpha1.DatadogSLO":                              schema__apis_datadoghq_v1alpha1_DatadogSLO(ref),
-		"./apis/datadoghq/v1alpha1.DatadogSLOControllerOptions":             schema__apis_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref),
-		"./apis/datadoghq/v1alpha1.DatadogSLOQuery":                         schema__apis_datadoghq_v1alpha1_DatadogSLOQuery(ref),
-		"./apis/datadoghq/v1alpha1.DatadogSLOSpec":                          schema__apis_datadoghq_v1alpha1_DatadogSLOSpec(ref),
-		"./apis/datadoghq/v1alpha1.DatadogSLOStatus":                        schema__apis_datadoghq_v1alpha1_DatadogSLOStatus(ref),
-		"./apis/datadoghq/v1alpha1.DogstatsdConfig":                         schema__apis_datadoghq_v1alpha1_DogstatsdConfig(ref),
-		"./apis/datadoghq/v1alpha1.ExternalMetricsConfig":                   schema__apis_datadoghq_v1alpha1_ExternalMetricsConfig(ref),
-		"./apis/datadoghq/v1alpha1.KubeStateMetricsCore":                    schema__apis_datadoghq_v1alpha1_KubeStateMetricsCore(ref),
-		"./apis/datadoghq/v1alpha1.LocalService":                            schema__apis_datadoghq_v1alpha1_LocalService(ref),
-		"./apis/datadoghq/v1alpha1.LogCollectionConfig":                     schema__apis_datadoghq_v1alpha1_LogCollectionConfig(ref),
-		"./apis/datadoghq/v1alpha1.NetworkPolicySpec":                       schema__apis_datadoghq_v1alpha1_NetworkPolicySpec(ref),
-		"./apis/datadoghq/v1alpha1.NodeAgentConfig":                         schema__apis_datadoghq_v1alpha1_NodeAgentConfig(ref),
-		"./apis/datadoghq/v1alpha1.OTLPGRPCSpec":                            schema__apis_datadoghq_v1alpha1_OTLPGRPCSpec(ref),
-		"./apis/datadoghq/v1alpha1.OTLPHTTPSpec":                            schema__apis_datadoghq_v1alpha1_OTLPHTTPSpec(ref),
-		"./apis/datadoghq/v1alpha1.OTLPProtocolsSpec":                       schema__apis_datadoghq_v1alpha1_OTLPProtocolsSpec(ref),
-		"./apis/datadoghq/v1alpha1.OTLPReceiverSpec":                        schema__apis_datadoghq_v1alpha1_OTLPReceiverSpec(ref),
-		"./apis/datadoghq/v1alpha1.OTLPSpec":                                schema__apis_datadoghq_v1alpha1_OTLPSpec(ref),
-		"./apis/datadoghq/v1alpha1.OrchestratorExplorerConfig":              schema__apis_datadoghq_v1alpha1_OrchestratorExplorerConfig(ref),
-		"./apis/datadoghq/v1alpha1.ProcessSpec":                             schema__apis_datadoghq_v1alpha1_ProcessSpec(ref),
-		"./apis/datadoghq/v1alpha1.PrometheusScrapeConfig":                  schema__apis_datadoghq_v1alpha1_PrometheusScrapeConfig(ref),
-		"./apis/datadoghq/v1alpha1.RbacConfig":                              schema__apis_datadoghq_v1alpha1_RbacConfig(ref),
-		"./apis/datadoghq/v1alpha1.RuntimeSecuritySpec":                     schema__apis_datadoghq_v1alpha1_RuntimeSecuritySpec(ref),
-		"./apis/datadoghq/v1alpha1.SecuritySpec":                            schema__apis_datadoghq_v1alpha1_SecuritySpec(ref),
-		"./apis/datadoghq/v1alpha1.SyscallMonitorSpec":                      schema__apis_datadoghq_v1alpha1_SyscallMonitorSpec(ref),
-		"./apis/datadoghq/v1alpha1.SystemProbeSpec":                         schema__apis_datadoghq_v1alpha1_SystemProbeSpec(ref),
+		"./apis/datadoghq/v1alpha1.APMSpec":                                                     schema__apis_datadoghq_v1alpha1_APMSpec(ref),
+		"./apis/datadoghq/v1alpha1.APMUnixDomainSocketSpec":                                     schema__apis_datadoghq_v1alpha1_APMUnixDomainSocketSpec(ref),
+		"./apis/datadoghq/v1alpha1.AdmissionControllerConfig":                                   schema__apis_datadoghq_v1alpha1_AdmissionControllerConfig(ref),
+		"./apis/datadoghq/v1alpha1.AgentCredentials":                                            schema__apis_datadoghq_v1alpha1_AgentCredentials(ref),
+		"./apis/datadoghq/v1alpha1.CRISocketConfig":                                             schema__apis_datadoghq_v1alpha1_CRISocketConfig(ref),
+		"./apis/datadoghq/v1alpha1.ClusterAgentConfig":                                          schema__apis_datadoghq_v1alpha1_ClusterAgentConfig(ref),
+		"./apis/datadoghq/v1alpha1.ClusterChecksRunnerConfig":                                   schema__apis_datadoghq_v1alpha1_ClusterChecksRunnerConfig(ref),
+		"./apis/datadoghq/v1alpha1.ComplianceSpec":                                              schema__apis_datadoghq_v1alpha1_ComplianceSpec(ref),
+		"./apis/datadoghq/v1alpha1.ConfigDirSpec":                                               schema__apis_datadoghq_v1alpha1_ConfigDirSpec(ref),
+		"./apis/datadoghq/v1alpha1.ConfigFileConfigMapSpec":                                     schema__apis_datadoghq_v1alpha1_ConfigFileConfigMapSpec(ref),
+		"./apis/datadoghq/v1alpha1.CustomConfigSpec":                                            schema__apis_datadoghq_v1alpha1_CustomConfigSpec(ref),
+		"./apis/datadoghq/v1alpha1.DSDUnixDomainSocketSpec":                                     schema__apis_datadoghq_v1alpha1_DSDUnixDomainSocketSpec(ref),
+		"./apis/datadoghq/v1alpha1.DaemonSetDeploymentStrategy":                                 schema__apis_datadoghq_v1alpha1_DaemonSetDeploymentStrategy(ref),
+		"./apis/datadoghq/v1alpha1.DaemonSetRollingUpdateSpec":                                  schema__apis_datadoghq_v1alpha1_DaemonSetRollingUpdateSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogAgent":                                                schema__apis_datadoghq_v1alpha1_DatadogAgent(ref),
+		"./apis/datadoghq/v1alpha1.DatadogAgentCondition":                                       schema__apis_datadoghq_v1alpha1_DatadogAgentCondition(ref),
+		"./apis/datadoghq/v1alpha1.DatadogAgentSpec":                                            schema__apis_datadoghq_v1alpha1_DatadogAgentSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogAgentSpecAgentSpec":                                   schema__apis_datadoghq_v1alpha1_DatadogAgentSpecAgentSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogAgentSpecClusterAgentSpec":                            schema__apis_datadoghq_v1alpha1_DatadogAgentSpecClusterAgentSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogAgentSpecClusterChecksRunnerSpec":                     schema__apis_datadoghq_v1alpha1_DatadogAgentSpecClusterChecksRunnerSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogAgentStatus":                                          schema__apis_datadoghq_v1alpha1_DatadogAgentStatus(ref),
+		"./apis/datadoghq/v1alpha1.DatadogCredentials":                                          schema__apis_datadoghq_v1alpha1_DatadogCredentials(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboard":                                            schema__apis_datadoghq_v1alpha1_DatadogDashboard(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboardJSON":                                        schema__apis_datadoghq_v1alpha1_DatadogDashboardJSON(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboardSpec":                                        schema__apis_datadoghq_v1alpha1_DatadogDashboardSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboardStatus":                                      schema__apis_datadoghq_v1alpha1_DatadogDashboardStatus(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboardTemplateVariable":                            schema__apis_datadoghq_v1alpha1_DatadogDashboardTemplateVariable(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboardWidget":                                      schema__apis_datadoghq_v1alpha1_DatadogDashboardWidget(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboardWidgetLayout":                                schema__apis_datadoghq_v1alpha1_DatadogDashboardWidgetLayout(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDashboardWidgetRequest":                               schema__apis_datadoghq_v1alpha1_DatadogDashboardWidgetRequest(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDowntime":                                             schema__apis_datadoghq_v1alpha1_DatadogDowntime(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDowntimeInstance":                                     schema__apis_datadoghq_v1alpha1_DatadogDowntimeInstance(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDowntimeMonitorSelector":                              schema__apis_datadoghq_v1alpha1_DatadogDowntimeMonitorSelector(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDowntimeRecurrence":                                   schema__apis_datadoghq_v1alpha1_DatadogDowntimeRecurrence(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDowntimeSpec":                                         schema__apis_datadoghq_v1alpha1_DatadogDowntimeSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogDowntimeStatus":                                       schema__apis_datadoghq_v1alpha1_DatadogDowntimeStatus(ref),
+		"./apis/datadoghq/v1alpha1.DatadogFeatures":                                             schema__apis_datadoghq_v1alpha1_DatadogFeatures(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMetric":                                               schema__apis_datadoghq_v1alpha1_DatadogMetric(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMetricCondition":                                      schema__apis_datadoghq_v1alpha1_DatadogMetricCondition(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitor":                                              schema__apis_datadoghq_v1alpha1_DatadogMonitor(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorCondition":                                     schema__apis_datadoghq_v1alpha1_DatadogMonitorCondition(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorControllerOptions":                             schema__apis_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus":                                schema__apis_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinition":        schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryDefinition(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute": schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch":  schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBy":           schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryGroupBy(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBySort":       schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryGroupBySort(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorGroupStates":                                   schema__apis_datadoghq_v1alpha1_DatadogMonitorGroupStates(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorOptions":                                       schema__apis_datadoghq_v1alpha1_DatadogMonitorOptions(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsCustomSchedule":                         schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsCustomSchedule(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsCustomScheduleRecurrence":               schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsCustomScheduleRecurrence(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsSchedulingOptions":                      schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsSchedulingOptions(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsSchedulingOptionsEvaluationWindow":      schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsSchedulingOptionsEvaluationWindow(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows":                       schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds":                             schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorSpec":                                          schema__apis_datadoghq_v1alpha1_DatadogMonitorSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorStatus":                                        schema__apis_datadoghq_v1alpha1_DatadogMonitorStatus(ref),
+		"./apis/datadoghq/v1alpha1.DatadogMonitorTriggeredState":                                schema__apis_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSLO":                                                  schema__apis_datadoghq_v1alpha1_DatadogSLO(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSLOControllerOptions":                                 schema__apis_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSLOQuery":                                             schema__apis_datadoghq_v1alpha1_DatadogSLOQuery(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSLOSpec":                                              schema__apis_datadoghq_v1alpha1_DatadogSLOSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSLOStatus":                                            schema__apis_datadoghq_v1alpha1_DatadogSLOStatus(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSLOThreshold":                                         schema__apis_datadoghq_v1alpha1_DatadogSLOThreshold(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSLOTimeSlice":                                         schema__apis_datadoghq_v1alpha1_DatadogSLOTimeSlice(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTest":                                        schema__apis_datadoghq_v1alpha1_DatadogSyntheticTest(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTestAssertion":                               schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestAssertion(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTestExtractedValue":                          schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestExtractedValue(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTestOptions":                                 schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestOptions(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTestRequest":                                 schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestRequest(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTestSpec":                                    schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestSpec(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTestStatus":                                  schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestStatus(ref),
+		"./apis/datadoghq/v1alpha1.DatadogSyntheticTestStep":                                    schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestStep(ref),
+		"./apis/datadoghq/v1alpha1.DogstatsdConfig":                                             schema__apis_datadoghq_v1alpha1_DogstatsdConfig(ref),
+		"./apis/datadoghq/v1alpha1.ExternalMetricsConfig":                                       schema__apis_datadoghq_v1alpha1_ExternalMetricsConfig(ref),
+		"./apis/datadoghq/v1alpha1.KubeStateMetricsCore":                                        schema__apis_datadoghq_v1alpha1_KubeStateMetricsCore(ref),
+		"./apis/datadoghq/v1alpha1.LocalService":                                                schema__apis_datadoghq_v1alpha1_LocalService(ref),
+		"./apis/datadoghq/v1alpha1.LogCollectionConfig":                                         schema__apis_datadoghq_v1alpha1_LogCollectionConfig(ref),
+		"./apis/datadoghq/v1alpha1.NetworkPolicySpec":                                           schema__apis_datadoghq_v1alpha1_NetworkPolicySpec(ref),
+		"./apis/datadoghq/v1alpha1.NodeAgentConfig":                                             schema__apis_datadoghq_v1alpha1_NodeAgentConfig(ref),
+		"./apis/datadoghq/v1alpha1.OTLPGRPCSpec":                                                schema__apis_datadoghq_v1alpha1_OTLPGRPCSpec(ref),
+		"./apis/datadoghq/v1alpha1.OTLPHTTPSpec":                                                schema__apis_datadoghq_v1alpha1_OTLPHTTPSpec(ref),
+		"./apis/datadoghq/v1alpha1.OTLPProtocolsSpec":                                           schema__apis_datadoghq_v1alpha1_OTLPProtocolsSpec(ref),
+		"./apis/datadoghq/v1alpha1.OTLPReceiverSpec":                                            schema__apis_datadoghq_v1alpha1_OTLPReceiverSpec(ref),
+		"./apis/datadoghq/v1alpha1.OTLPSpec":                                                    schema__apis_datadoghq_v1alpha1_OTLPSpec(ref),
+		"./apis/datadoghq/v1alpha1.OrchestratorExplorerConfig":                                  schema__apis_datadoghq_v1alpha1_OrchestratorExplorerConfig(ref),
+		"./apis/datadoghq/v1alpha1.ProcessSpec":                                                 schema__apis_datadoghq_v1alpha1_ProcessSpec(ref),
+		"./apis/datadoghq/v1alpha1.PrometheusScrapeConfig":                                      schema__apis_datadoghq_v1alpha1_PrometheusScrapeConfig(ref),
+		"./apis/datadoghq/v1alpha1.RbacConfig":                                                  schema__apis_datadoghq_v1alpha1_RbacConfig(ref),
+		"./apis/datadoghq/v1alpha1.RuntimeSecuritySpec":                                         schema__apis_datadoghq_v1alpha1_RuntimeSecuritySpec(ref),
+		"./apis/datadoghq/v1alpha1.SecuritySpec":                                                schema__apis_datadoghq_v1alpha1_SecuritySpec(ref),
+		"./apis/datadoghq/v1alpha1.SyscallMonitorSpec":                                          schema__apis_datadoghq_v1alpha1_SyscallMonitorSpec(ref),
+		"./apis/datadoghq/v1alpha1.SystemProbeSpec":                                             schema__apis_datadoghq_v1alpha1_SystemProbeSpec(ref),
 	}
 }
 
@@ -1785,546 +1819,623 @@ func schema__apis_datadoghq_v1alpha1_DatadogCredentials(ref common.ReferenceCall
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogFeatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDashboard(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogFeatures are Features running on the Agent and Cluster Agent.",
+				Description: "DatadogDashboard allows to define and manage Datadog dashboards from your Kubernetes Cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"orchestratorExplorer": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OrchestratorExplorer configuration.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.OrchestratorExplorerConfig"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubeStateMetricsCore": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeStateMetricsCore configuration.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.KubeStateMetricsCore"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"prometheusScrape": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PrometheusScrape configuration.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.PrometheusScrapeConfig"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"networkMonitoring": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NetworkMonitoring configuration.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.NetworkMonitoringConfig"),
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDashboardSpec"),
 						},
 					},
-					"logCollection": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LogCollection configuration.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.LogCollectionConfig"),
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDashboardStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.KubeStateMetricsCore", "./apis/datadoghq/v1alpha1.LogCollectionConfig", "./apis/datadoghq/v1alpha1.NetworkMonitoringConfig", "./apis/datadoghq/v1alpha1.OrchestratorExplorerConfig", "./apis/datadoghq/v1alpha1.PrometheusScrapeConfig"},
+			"./apis/datadoghq/v1alpha1.DatadogDashboardSpec", "./apis/datadoghq/v1alpha1.DatadogDashboardStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDashboardJSON(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMetric allows autoscaling on arbitrary Datadog query",
+				Description: "DatadogDashboardJSON defines the JSON definition of a dashboard. Only one of Inline and ConfigMap can be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"inline": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Inline is the JSON definition of the dashboard.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"configMap": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ConfigMap references a ConfigMap of the namespace of the DatadogDashboard that holds the JSON definition of the dashboard. The definition is read from the key of the first item, `dashboard.json` by default. The path of the item is ignored.",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.ConfigMapConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.ConfigMapConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogDashboardSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDashboardSpec defines the desired state of a DatadogDashboard. The dashboard is defined either with a list of widgets, or with its JSON definition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Title is the title of the dashboard. Required unless the title is set in DashboardJSON, which it overrides when both are set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Description is the description of the dashboard. It overrides the description set in DashboardJSON.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"layoutType": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMetricSpec"),
+							Description: "LayoutType is the layout type of the dashboard: ordered (default) or free.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"widgets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMetricStatus"),
+							Description: "Widgets is the list of widgets of the dashboard. Required unless DashboardJSON is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDashboardWidget"),
+									},
+								},
+							},
+						},
+					},
+					"templateVariables": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateVariables is the list of template variables of the dashboard.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDashboardTemplateVariable"),
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is the list of tags to associate with the dashboard. They are added to the tags set in DashboardJSON.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"dashboardJSON": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DashboardJSON is the JSON definition of the dashboard, as exported from Datadog. It cannot be used with Widgets, LayoutType, or TemplateVariables.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogDashboardJSON"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.DatadogMetricSpec", "./apis/datadoghq/v1alpha1.DatadogMetricStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"./apis/datadoghq/v1alpha1.DatadogDashboardJSON", "./apis/datadoghq/v1alpha1.DatadogDashboardTemplateVariable", "./apis/datadoghq/v1alpha1.DatadogDashboardWidget"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMetricCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDashboardStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMetricCondition describes the state of a DatadogMetric at a certain point.",
+				Description: "DatadogDashboardStatus defines the observed state of a DatadogDashboard",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of DatadogMetric condition.",
-							Default:     "",
+							Description: "Conditions represents the latest available observations of the state of a DatadogDashboard.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the dashboard ID generated in Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "URL is the path of the dashboard in the Datadog app, for instance `/dashboard/abc-def-ghi/service-overview`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"creator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Creator is the identity of the dashboard creator.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"created": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Default:     map[string]interface{}{},
+							Description: "Created is the time the dashboard was created.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"reason": {
+					"syncStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "SyncStatus shows the health of syncing the dashboard state to Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"lastForceSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "LastForceSyncTime is the last time the API dashboard was last force synced with the DatadogDashboard resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogDashboardSpec, and of the JSON definition read from the ConfigMap, to know if the dashboard has changed and needs an update.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDashboardTemplateVariable(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitor allows to define and manage Monitors from your Kubernetes Cluster",
+				Description: "DatadogDashboardTemplateVariable defines a template variable of a dashboard",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name is the name of the variable.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Prefix is the tag prefix associated with the variable, for instance `env`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorSpec"),
+					"defaults": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"status": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorStatus"),
+							Description: "Defaults is the list of default values of the variable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.DatadogMonitorSpec", "./apis/datadoghq/v1alpha1.DatadogMonitorStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDashboardWidget(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorCondition describes the current state of a DatadogMonitor",
+				Description: "DatadogDashboardWidget defines a widget of a dashboard",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of DatadogMonitor condition",
+							Description: "Type is the type of the widget, for instance timeseries, query_value, toplist, or note.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"title": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "Title is the title of the widget.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+					"requests": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition was updated.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Requests are the queries displayed by the widget.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDashboardWidgetRequest"),
+									},
+								},
+							},
 						},
 					},
-					"reason": {
+					"content": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "Content is the content of note widgets, in Markdown.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"layout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Layout is the position and size of the widget. Required if the layout type of the dashboard is free.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogDashboardWidgetLayout"),
 						},
 					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"./apis/datadoghq/v1alpha1.DatadogDashboardWidgetLayout", "./apis/datadoghq/v1alpha1.DatadogDashboardWidgetRequest"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDashboardWidgetLayout(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorControllerOptions defines options in the DatadogMonitor controller",
+				Description: "DatadogDashboardWidgetLayout defines the position and size of a widget",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"disableRequiredTags": {
+					"x": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisableRequiredTags disables the automatic addition of required tags to monitors.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "X is the position of the widget on the x axis.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorDowntimeStatus represents the downtime status of a DatadogMonitor",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"isDowntimed": {
+					"y": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "Y is the position of the widget on the y axis.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"downtimeId": {
+					"width": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Width is the width of the widget.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"height": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Height is the height of the widget.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"x", "y", "width", "height"},
 			},
 		},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDashboardWidgetRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorOptions define the optional parameters of a monitor",
+				Description: "DatadogDashboardWidgetRequest defines a query displayed by a widget",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enableLogsSample": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether to send a log sample when the log monitor triggers.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"escalationMessage": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A message to include with a re-notification.",
+							Description: "Query is the metric query of the request, for instance `avg:system.cpu.user{*} by {host}`.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"evaluationDelay": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Time (in seconds) to delay evaluation, as a non-negative integer. For example, if the value is set to 300 (5min), the timeframe is set to last_5m and the time is 7:00, the monitor evaluates data from 6:50 to 6:55. This is useful for AWS CloudWatch and other backfilled metrics to ensure the monitor always has data during evaluation.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"includeTags": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether notifications from this monitor automatically inserts its triggering tags into the title.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"locked": {
+					"displayType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether or not the monitor is locked (only editable by creator and admins).",
-							Type:        []string{"boolean"},
+							Description: "DisplayType is how the query is displayed by timeseries widgets: line, bars, or area.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"newGroupDelay": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Time (in seconds) to allow a host to boot and applications to fully start before starting the evaluation of monitor results. Should be a non negative integer.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"noDataTimeframe": {
+					"aggregator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of minutes before a monitor notifies after data stops reporting. Datadog recommends at least 2x the monitor timeframe for metric alerts or 2 minutes for service checks. If omitted, 2x the evaluation timeframe is used for metric alerts, and 24 hours is used for service checks.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"notifyAudit": {
-						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether tagged users are notified on changes to this monitor.",
-							Type:        []string{"boolean"},
+							Description: "Aggregator is the aggregator used by query value and toplist widgets, for instance avg or last.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"notifyNoData": {
+				},
+				Required: []string{"query"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogDowntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDowntime allows to define and manage downtimes from your Kubernetes Cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether this monitor notifies when data stops reporting.",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"renotifyInterval": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The number of minutes after the last notification before a monitor re-notifies on the current status. It only re-notifies if it’s not resolved.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"requireFullWindow": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A Boolean indicating whether this monitor needs a full window of data before it’s evaluated. We highly recommend you set this to false for sparse metrics, otherwise some evaluations are skipped. Default is false.",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"timeoutH": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The number of hours of the monitor not reporting data before it automatically resolves from a triggered state.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"thresholds": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A struct of the different monitor threshold values.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"),
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDowntimeSpec"),
 						},
 					},
-					"thresholdWindows": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A struct of the alerting time window options.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows"),
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDowntimeStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows", "./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"},
+			"./apis/datadoghq/v1alpha1.DatadogDowntimeSpec", "./apis/datadoghq/v1alpha1.DatadogDowntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDowntimeInstance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorOptionsThresholdWindows is a struct of the alerting time window options",
+				Description: "DatadogDowntimeInstance represents a downtime created in Datadog",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"recoveryWindow": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Describes how long an anomalous metric must be normal before the alert recovers.",
+							Description: "ID is the downtime ID generated in Datadog.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"monitorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorID is the ID of the monitor the downtime applies to, if the downtime is specific to a monitor.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"monitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Monitor is the namespace/name of the DatadogMonitor the downtime applies to, if any.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"triggerWindow": {
+					"active": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Describes how long a metric must be anomalous before an alert triggers.",
-							Type:        []string{"string"},
+							Description: "Active is true when the downtime is currently active.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"id"},
 			},
 		},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDowntimeMonitorSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorOptionsThresholds is a struct of the different monitor threshold values",
+				Description: "DatadogDowntimeMonitorSelector selects the monitors to which a downtime applies. Only one of LabelSelector and MonitorTags can be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"critical": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The monitor CRITICAL threshold.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"criticalRecovery": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The monitor CRITICAL recovery threshold.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"ok": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The monitor OK threshold.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"unknown": {
+					"labelSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The monitor UNKNOWN threshold.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LabelSelector selects DatadogMonitor resources in the namespace of the DatadogDowntime. A downtime is created in Datadog for each selected monitor.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"warning": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The monitor WARNING threshold.",
-							Type:        []string{"string"},
-							Format:      "",
+					"monitorTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
 						},
-					},
-					"warningRecovery": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The monitor WARNING recovery threshold.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MonitorTags is a list of monitor tags. The downtime applies to the monitors that have all of these tags.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDowntimeRecurrence(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorSpec defines the desired state of DatadogMonitor",
+				Description: "DatadogDowntimeRecurrence defines how a downtime repeats",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the monitor name",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"message": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Message is a message to include with notifications for this monitor",
+							Description: "Type is the type of recurrence.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"priority": {
+					"period": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Priority is an integer from 1 (high) to 5 (low) indicating alert severity",
+							Description: "Period is how often to repeat, as an integer. For example, to repeat every 3 days, select a type of `days` and a period of `3`.",
 							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
-					"query": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Query is the Datadog monitor query",
-							Type:        []string{"string"},
-							Format:      "",
+							Format:      "int32",
 						},
 					},
-					"restrictedRoles": {
+					"weekDays": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "RestrictedRoles is a list of unique role identifiers to define which roles are allowed to edit the monitor. `restricted_roles` is the successor of `locked`. For more information about `locked` and `restricted_roles`, see the [monitor options docs](https://docs.datadoghq.com/monitors/guide/monitor_api_options/#permissions-options).",
+							Description: "WeekDays is the list of week days to repeat on. Only applicable when type is `weeks`. Choose from `Mon`, `Tue`, `Wed`, `Thu`, `Fri`, `Sat`, or `Sun`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2337,14 +2448,43 @@ func schema__apis_datadoghq_v1alpha1_DatadogMonitorSpec(ref common.ReferenceCall
 							},
 						},
 					},
-					"tags": {
+					"untilDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UntilDate is the time at which the recurrence should end. Only one of UntilDate and UntilOccurrences can be set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"untilOccurrences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UntilOccurrences is how many times the downtime is rescheduled. Only one of UntilDate and UntilOccurrences can be set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogDowntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogDowntimeSpec defines the desired state of a DatadogDowntime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scope": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Tags is the monitor tags associated with your monitor",
+							Description: "Scope is the list of scopes to which the downtime applies, for example `env:prod`. Use `*` to apply the downtime to all the groups of the selected monitors.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2357,40 +2497,65 @@ func schema__apis_datadoghq_v1alpha1_DatadogMonitorSpec(ref common.ReferenceCall
 							},
 						},
 					},
-					"type": {
+					"monitorSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the monitor type",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MonitorSelector selects the monitors to which the downtime applies. If not set, the downtime applies to all monitors within the scope.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogDowntimeMonitorSelector"),
 						},
 					},
-					"options": {
+					"start": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Options are the optional parameters associated with your monitor",
-							Default:     map[string]interface{}{},
-							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptions"),
+							Description: "Start is the time the downtime starts. If not set, the downtime starts as soon as it is created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"controllerOptions": {
+					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ControllerOptions are the optional parameters in the DatadogMonitor controller",
-							Default:     map[string]interface{}{},
-							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorControllerOptions"),
+							Description: "End is the time the downtime ends. If not set, the downtime continues until it is deleted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the timezone in which to display the downtime's start and end times in Datadog applications.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a message to include with notifications for this downtime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"recurrence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recurrence defines how the downtime repeats.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogDowntimeRecurrence"),
+						},
+					},
+					"muteFirstRecoveryNotification": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MuteFirstRecoveryNotification mutes the first recovery notification during the downtime.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"scope"},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.DatadogMonitorControllerOptions", "./apis/datadoghq/v1alpha1.DatadogMonitorOptions"},
+			"./apis/datadoghq/v1alpha1.DatadogDowntimeMonitorSelector", "./apis/datadoghq/v1alpha1.DatadogDowntimeRecurrence", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogDowntimeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorStatus defines the observed state of DatadogMonitor",
+				Description: "DatadogDowntimeStatus defines the observed state of a DatadogDowntime",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -2403,322 +2568,2286 @@ func schema__apis_datadoghq_v1alpha1_DatadogMonitorStatus(ref common.ReferenceCa
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions Represents the latest available observations of a DatadogMonitor's current state.",
+							Description: "Conditions represents the latest available observations of the state of a DatadogDowntime.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorCondition"),
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"id": {
+					"downtimes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the monitor ID generated in Datadog",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Downtimes are the downtimes created in Datadog: one per selected DatadogMonitor when the MonitorSelector uses a LabelSelector, a single one otherwise.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogDowntimeInstance"),
+									},
+								},
+							},
 						},
 					},
-					"creator": {
+					"active": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Creator is the identify of the monitor creator",
+							Description: "Active is true when at least one of the downtimes is currently active.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"syncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStatus shows the health of syncing the downtime state to Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"created": {
+					"lastForceSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Created is the time the monitor was created",
+							Description: "LastForceSyncTime is the last time the API downtimes were last force synced with the DatadogDowntime resource.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"monitorState": {
+					"stateLastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorState is the overall state of monitor",
+							Description: "StateLastUpdateTime is the last time the downtime state was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogDowntimeSpec and selected monitors to know if the downtimes need an update.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"monitorLastForceSyncTime": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogDowntimeInstance", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogFeatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogFeatures are Features running on the Agent and Cluster Agent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"orchestratorExplorer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorLastForceSyncTime is the last time the API monitor was last force synced with the DatadogMonitor resource",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "OrchestratorExplorer configuration.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.OrchestratorExplorerConfig"),
 						},
 					},
-					"monitorStateLastUpdateTime": {
+					"kubeStateMetricsCore": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorStateLastUpdateTime is the last time the monitor state updated",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "KubeStateMetricsCore configuration.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.KubeStateMetricsCore"),
 						},
 					},
-					"monitorStateLastTransitionTime": {
+					"prometheusScrape": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorStateLastTransitionTime is the last time the monitor state changed",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "PrometheusScrape configuration.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.PrometheusScrapeConfig"),
 						},
 					},
-					"syncStatus": {
+					"networkMonitoring": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorStateSyncStatus shows the health of syncing the monitor state to Datadog",
+							Description: "NetworkMonitoring configuration.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.NetworkMonitoringConfig"),
+						},
+					},
+					"logCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogCollection configuration.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.LogCollectionConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.KubeStateMetricsCore", "./apis/datadoghq/v1alpha1.LogCollectionConfig", "./apis/datadoghq/v1alpha1.NetworkMonitoringConfig", "./apis/datadoghq/v1alpha1.OrchestratorExplorerConfig", "./apis/datadoghq/v1alpha1.PrometheusScrapeConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMetric allows autoscaling on arbitrary Datadog query",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"triggeredState": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"monitorGroup",
-								},
-								"x-kubernetes-list-type": "map",
-							},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TriggeredState only includes details for monitor groups that are triggering",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorTriggeredState"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"downtimeStatus": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DowntimeStatus defines whether the monitor is downtimed",
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMetricSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMetricStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMetricSpec", "./apis/datadoghq/v1alpha1.DatadogMetricStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMetricCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMetricCondition describes the state of a DatadogMetric at a certain point.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of DatadogMetric condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus"),
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"primary": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Primary defines whether the monitor is managed by the Kubernetes custom resource (true) or outside Kubernetes (false)",
-							Type:        []string{"boolean"},
+							Description: "Last time the condition was updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"currentHash": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentHash tracks the hash of the current DatadogMonitorSpec to know if the Spec has changed and needs an update",
+							Description: "A human readable message indicating details about the transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.DatadogMonitorCondition", "./apis/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus", "./apis/datadoghq/v1alpha1.DatadogMonitorTriggeredState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogMonitorTriggeredState represents the details of a triggering DatadogMonitor The DatadogMonitor is triggering if one of its groups is in Alert, Warn, or No Data",
+				Description: "DatadogMonitor allows to define and manage Monitors from your Kubernetes Cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"monitorGroup": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorGroup is the name of the triggering group",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"state": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastTransitionTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-				},
-				Required: []string{"monitorGroup"},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorSpec", "./apis/datadoghq/v1alpha1.DatadogMonitorStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorCondition describes the current state of a DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of DatadogMonitor condition",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition was updated.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorControllerOptions defines options in the DatadogMonitor controller",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disableRequiredTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableRequiredTags disables the automatic addition of required tags to monitors.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"adoptMonitorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdoptMonitorID is the ID of an existing monitor in Datadog to adopt instead of creating a new one. The adopted monitor is overwritten with the DatadogMonitor spec and deleted with the DatadogMonitor.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"adoptByNameAndTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdoptByNameAndTags makes the controller adopt the existing monitor that has the same name and all the tags of the DatadogMonitor instead of creating a new one. Adoption fails if several monitors match.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines how the controller handles the changes made to the monitor outside of the DatadogMonitor. `enforce` (default) periodically overwrites them with the spec. `detect` reports them in the Drifted condition instead, while still pushing the changes of the spec. `observe` only reports the differences with the spec and never creates, updates, or deletes the monitor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxTriggeredStateGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxTriggeredStateGroups is the maximum number of triggered groups listed in Status.TriggeredState, between 0 and 100. The most severe groups are listed first. The counts of groups by state are always reported in Status.GroupStates. Defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorDowntimeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorDowntimeStatus represents the downtime status of a DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"isDowntimed": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"downtimeId": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorFormulaAndFunctionEventQueryDefinition is a formula and functions events query, used as a variable in the query of formula and functions monitors",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the query for use in formulas.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dataSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data source for event platform-based queries: rum, ci_pipelines, ci_tests, audit, events, logs, or spans.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compute options of the query.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute"),
+						},
+					},
+					"search": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Search options of the query.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch"),
+						},
+					},
+					"indexes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "An array of index names to query in the stream. Omit to query all indexes at once.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"groupBy": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Group by options of the query.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "dataSource", "compute"},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute", "./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch", "./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBy"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute is a struct of the compute options of an events query",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"aggregation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aggregation methods for event platform queries, for instance count, cardinality, or avg.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A time interval in milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"metric": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Measurable attribute to compute.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"aggregation"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch is a struct of the search options of an events query",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events search string.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"query"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryGroupBy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorFormulaAndFunctionEventQueryGroupBy is a struct of the group by options of an events query",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"facet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Event facet.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"limit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of groups to return.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options for sorting the group by results.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBySort"),
+						},
+					},
+				},
+				Required: []string{"facet"},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBySort"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorFormulaAndFunctionEventQueryGroupBySort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorFormulaAndFunctionEventQueryGroupBySort is a struct of the sort options of an events query group by",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"aggregation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Aggregation methods for event platform queries, for instance count, cardinality, or avg.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metric": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metric to sort by.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"order": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Direction of the sort: asc or desc.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"aggregation"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorGroupStates(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorGroupStates counts the groups of a DatadogMonitor by state",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalCount is the number of groups of the monitor",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"okCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OKCount is the number of groups in OK",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"alertCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertCount is the number of groups in Alert",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"warnCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarnCount is the number of groups in Warn",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"noDataCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoDataCount is the number of groups in No Data",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"triggeredCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredCount is the number of groups in Alert, Warn, or No Data",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"truncated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Truncated is true when TriggeredState doesn't list all the triggered groups",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"totalCount"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptions define the optional parameters of a monitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enableLogsSample": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether to send a log sample when the log monitor triggers.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enableSamples": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether to send a list of samples when the monitor triggers. This is only used by CI Test and Pipeline monitors.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"escalationMessage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A message to include with a re-notification.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"evaluationDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time (in seconds) to delay evaluation, as a non-negative integer. For example, if the value is set to 300 (5min), the timeframe is set to last_5m and the time is 7:00, the monitor evaluates data from 6:50 to 6:55. This is useful for AWS CloudWatch and other backfilled metrics to ensure the monitor always has data during evaluation.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"groupRetentionDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time span after which groups with missing data are dropped from the monitor state. The minimum value is one hour, and the maximum value is 72 hours. Example values are: \"60m\", \"1h\", and \"2d\". This option is only available for APM Trace Analytics, Audit Trail, CI, Error Tracking, Event, Logs, and RUM monitors.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groupbySimpleMonitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether the log alert monitor triggers a single alert or multiple alerts when any group breaches a threshold.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"includeTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether notifications from this monitor automatically inserts its triggering tags into the title.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"locked": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether or not the monitor is locked (only editable by creator and admins).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minFailureDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long the test should be in failure before alerting, in seconds (maximum 7200).",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minLocationFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The minimum number of locations in failure at the same time during at least one moment in the MinFailureDuration period. This is only used by Synthetic monitors.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"newGroupDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time (in seconds) to allow a host to boot and applications to fully start before starting the evaluation of monitor results. Should be a non negative integer.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"newHostDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time (in seconds) to allow a host to boot and applications to fully start before starting the evaluation of monitor results. Should be a non negative integer. NewGroupDelay should be preferred.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"noDataTimeframe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of minutes before a monitor notifies after data stops reporting. Datadog recommends at least 2x the monitor timeframe for metric alerts or 2 minutes for service checks. If omitted, 2x the evaluation timeframe is used for metric alerts, and 24 hours is used for service checks.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"notificationPresetName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Toggles the display of additional content sent in the monitor notification: show_all, hide_query, hide_handles, or hide_all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notifyAudit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether tagged users are notified on changes to this monitor.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"notifyBy": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Controls what granularity a monitor alerts on. Only available for monitors with groupings. For instance, a monitor grouped by cluster, namespace, and pod can be configured to only notify on each new cluster violating the alert conditions by setting NotifyBy to [\"cluster\"]. Tags mentioned in NotifyBy must be a subset of the grouping tags in the query. Use [\"*\"] to configure the monitor to notify as a simple-alert.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notifyNoData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether this monitor notifies when data stops reporting.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"onMissingData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controls how groups or monitors are treated if an evaluation does not return any data points: default, show_no_data, show_and_notify_no_data, or resolve. This option is only available for APM Trace Analytics, Audit Trail, CI, Error Tracking, Event, Logs, and RUM monitors, and cannot be used with NotifyNoData and NoDataTimeframe.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"renotifyInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of minutes after the last notification before a monitor re-notifies on the current status. It only re-notifies if it’s not resolved.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"renotifyOccurrences": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of times re-notification messages should be sent on the current status at the provided re-notification interval.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"renotifyStatuses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The types of monitor statuses for which re-notification messages are sent: alert, warn, and no data.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"requireFullWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A Boolean indicating whether this monitor needs a full window of data before it’s evaluated. We highly recommend you set this to false for sparse metrics, otherwise some evaluations are skipped. Default is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"schedulingOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration options for scheduling: the cumulative evaluation window and the custom schedule of the monitor.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsSchedulingOptions"),
+						},
+					},
+					"timeoutH": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of hours of the monitor not reporting data before it automatically resolves from a triggered state.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"thresholds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A struct of the different monitor threshold values.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"),
+						},
+					},
+					"thresholdWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A struct of the alerting time window options.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows"),
+						},
+					},
+					"variables": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of requests that can be used in the monitor query of formula and functions monitors.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinition", "./apis/datadoghq/v1alpha1.DatadogMonitorOptionsSchedulingOptions", "./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholdWindows", "./apis/datadoghq/v1alpha1.DatadogMonitorOptionsThresholds"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsCustomSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsCustomSchedule is a struct of the custom schedule options",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recurrences": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Recurrences is the list of recurrences of the custom schedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsCustomScheduleRecurrence"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"recurrences"},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsCustomScheduleRecurrence"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsCustomScheduleRecurrence(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsCustomScheduleRecurrence is a recurrence of a custom schedule",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rrule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RRule is the recurrence rule (RRULE) of the schedule, for instance `FREQ=DAILY;INTERVAL=1`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is the start date and time of the schedule, in the yyyy-MM-ddThh:mm:ss format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the timezone the schedule runs on, for instance `America/New_York`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"rrule", "timezone"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsSchedulingOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsSchedulingOptions is a struct of the scheduling options of a monitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"evaluationWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration options for the cumulative evaluation window. If HourStarts is set, no other fields may be set. Otherwise, DayStarts and MonthStarts must be set together.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsSchedulingOptionsEvaluationWindow"),
+						},
+					},
+					"customSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configuration options for the custom schedule of the monitor evaluations.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptionsCustomSchedule"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorOptionsCustomSchedule", "./apis/datadoghq/v1alpha1.DatadogMonitorOptionsSchedulingOptionsEvaluationWindow"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsSchedulingOptionsEvaluationWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsSchedulingOptionsEvaluationWindow is a struct of the cumulative evaluation window options",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dayStarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time of the day at which a one day cumulative evaluation window starts, in the HH:mm format (UTC).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hourStarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The minute of the hour at which a one hour cumulative evaluation window starts.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"monthStarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The day of the month at which a one month cumulative evaluation window starts.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholdWindows(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsThresholdWindows is a struct of the alerting time window options",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"recoveryWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how long an anomalous metric must be normal before the alert recovers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggerWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Describes how long a metric must be anomalous before an alert triggers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorOptionsThresholds(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorOptionsThresholds is a struct of the different monitor threshold values",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"critical": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor CRITICAL threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"criticalRecovery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor CRITICAL recovery threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ok": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor OK threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"unknown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor UNKNOWN threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"warning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor WARNING threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"warningRecovery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The monitor WARNING recovery threshold.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorSpec defines the desired state of DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the monitor name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a message to include with notifications for this monitor",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority is an integer from 1 (high) to 5 (low) indicating alert severity",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the Datadog monitor query",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restrictedRoles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "RestrictedRoles is a list of unique role identifiers to define which roles are allowed to edit the monitor. `restricted_roles` is the successor of `locked`. For more information about `locked` and `restricted_roles`, see the [monitor options docs](https://docs.datadoghq.com/monitors/guide/monitor_api_options/#permissions-options).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is the monitor tags associated with your monitor",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the monitor type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options are the optional parameters associated with your monitor",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorOptions"),
+						},
+					},
+					"controllerOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControllerOptions are the optional parameters in the DatadogMonitor controller",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorControllerOptions"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorControllerOptions", "./apis/datadoghq/v1alpha1.DatadogMonitorOptions"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorStatus defines the observed state of DatadogMonitor",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions Represents the latest available observations of a DatadogMonitor's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorCondition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the monitor ID generated in Datadog",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"creator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Creator is the identify of the monitor creator",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is the time the monitor was created",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorState is the overall state of monitor",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"monitorLastForceSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorLastForceSyncTime is the last time the API monitor was last force synced with the DatadogMonitor resource",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorStateLastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorStateLastUpdateTime is the last time the monitor state updated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"monitorStateLastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorStateLastTransitionTime is the last time the monitor state changed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"syncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorStateSyncStatus shows the health of syncing the monitor state to Datadog",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"triggeredState": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"monitorGroup",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "TriggeredState only includes details for monitor groups that are triggering",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogMonitorTriggeredState"),
+									},
+								},
+							},
+						},
+					},
+					"groupStates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupStates summarizes the states of all the monitor groups, including the ones not listed in TriggeredState",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorGroupStates"),
+						},
+					},
+					"downtimeStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DowntimeStatus defines whether the monitor is downtimed",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus"),
+						},
+					},
+					"primary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Primary defines whether the monitor is managed by the Kubernetes custom resource (true) or outside Kubernetes (false)",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogMonitorSpec to know if the Spec has changed and needs an update",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogMonitorCondition", "./apis/datadoghq/v1alpha1.DatadogMonitorDowntimeStatus", "./apis/datadoghq/v1alpha1.DatadogMonitorGroupStates", "./apis/datadoghq/v1alpha1.DatadogMonitorTriggeredState", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogMonitorTriggeredState(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogMonitorTriggeredState represents the details of a triggering DatadogMonitor The DatadogMonitor is triggering if one of its groups is in Alert, Warn, or No Data",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"monitorGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorGroup is the name of the triggering group",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"monitorGroup"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSLO(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLO allows a user to define and manage datadog SLOs from Kubernetes cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSLOSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSLOStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogSLOSpec", "./apis/datadoghq/v1alpha1.DatadogSLOStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOControllerOptions defines options in the DatadogSLO controller.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disableRequiredTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableRequiredTags disables the automatic addition of required tags to SLOs.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"adoptSLOID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdoptSLOID is the ID of an existing SLO in Datadog to adopt instead of creating a new one. The adopted SLO is overwritten with the DatadogSLO spec and deleted with the DatadogSLO, unless the drift policy is `observe`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines how the controller handles the changes made to the SLO outside of the DatadogSLO. `enforce` (default) periodically overwrites them with the spec. `detect` reports them in the Drifted condition instead, while still pushing the changes of the spec. `observe` only reports the differences with the spec and never creates, updates, or deletes the SLO.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSLOQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numerator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Numerator is a Datadog metric query for good events.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"denominator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Denominator is a Datadog metric query for total (valid) events.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"numerator", "denominator"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSLOSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the service level objective.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a user-defined description of the service level objective. Always included in service level objective responses (but may be null). Optional in create/update requests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups is a list of (up to 100) monitor groups that narrow the scope of a monitor service level objective. Included in service level objective responses if it is not empty. Optional in create/update requests for monitor service level objectives, but may only be used when the length of the monitor_ids field is one.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"monitorIDs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorIDs is a list of monitor IDs that defines the scope of a monitor service level objective. Required if type is monitor.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int64",
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Tags is a list of tags to associate with your service level objective. This can help you categorize and filter service level objectives in the service level objectives page of the UI. Note: it's not currently possible to filter by these tags when querying via the API.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is the query for a metric-based SLO. Required if type is metric. Note that only the `sum by` aggregator is allowed, which sums all request counts. `Average`, `max`, nor `min` request aggregators are not supported.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSLOQuery"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the service level objective.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeSlice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeSlice is the SLI specification of a time-slice SLO. Required if type is time_slice.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSLOTimeSlice"),
+						},
+					},
+					"timeframe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The SLO time window options. When Thresholds is set, Timeframe is optional and selects the primary threshold, which defaults to the first one.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetThreshold is the target threshold such that when the service level indicator is above this threshold over the given timeframe, the objective is being met. Required unless Thresholds is set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"warningThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WarningThreshold is a optional warning threshold such that when the service level indicator is below this value for the given threshold, but above the target threshold, the objective appears in a \"warning\" state. This value must be greater than the target threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"thresholds": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"timeframe",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Thresholds is a list of thresholds, one per timeframe. It replaces TargetThreshold and WarningThreshold to define targets over several timeframes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSLOThreshold"),
+									},
+								},
+							},
+						},
+					},
+					"controllerOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControllerOptions are the optional parameters in the DatadogSLO controller",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSLOControllerOptions"),
+						},
+					},
+				},
+				Required: []string{"name", "type"},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogSLOControllerOptions", "./apis/datadoghq/v1alpha1.DatadogSLOQuery", "./apis/datadoghq/v1alpha1.DatadogSLOThreshold", "./apis/datadoghq/v1alpha1.DatadogSLOTimeSlice", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOStatus defines the observed state of a DatadogSLO.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of the state of a DatadogSLO.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the SLO ID generated in Datadog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Creator is the identity of the SLO creator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"created": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Created is the time the SLO was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"syncStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SyncStatus shows the health of syncing the SLO state to Datadog.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastForceSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastForceSyncTime is the last time the API SLO was last force synced with the DatadogSLO resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentHash": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentHash tracks the hash of the current DatadogSLOSpec to know if the Spec has changed and needs an update.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sliValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SLIValue is the current value of the service level indicator over the SLO timeframe, as a percentage.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"errorBudgetRemaining": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ErrorBudgetRemaining is the percentage of the error budget remaining over the SLO timeframe. It is negative when the error budget is exhausted.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"stateLastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StateLastUpdateTime is the last time the SLI value and the error budget were updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSLOThreshold(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOThreshold defines the target and warning thresholds of an SLO over a timeframe.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeframe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeframe is the SLO time window of the threshold.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the target threshold such that when the service level indicator is above this threshold over the timeframe, the objective is being met.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"warning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Warning is an optional warning threshold such that when the service level indicator is below this value for the timeframe, but above the target threshold, the objective appears in a \"warning\" state. This value must be greater than the target threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"timeframe", "target"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSLOTimeSlice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSLOTimeSlice defines the SLI specification of a time-slice SLO: the SLI query is evaluated on each time slice, and a slice is good when the comparison of its value with the threshold is true.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"query": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Query is a Datadog metric query evaluated on each time slice.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comparator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comparator is the comparator used to compare the SLI value to the threshold: >, >=, <, or <=.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold is the value the SLI value of each time slice is compared to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"queryIntervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryIntervalSeconds is the interval used when querying data, in seconds. Valid values are 60 and 300. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"query", "comparator", "threshold"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTest allows to define and manage synthetic API tests from your Kubernetes Cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogSyntheticTestSpec", "./apis/datadoghq/v1alpha1.DatadogSyntheticTestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestAssertion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTestAssertion defines an assertion of a synthetic test",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of assertion, for instance statusCode, responseTime, header, body, certificate, recordEvery, recordSome, or grpcHealthcheckStatus.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operator is the assertion operator, for instance is, lessThan, contains, or validatesJSONPath.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"property": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Property is the property the assertion applies to, for instance the name of a header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target is the value the property is compared to. Numbers are sent as numbers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jsonPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPath is the JSON path of the value to compare to Target, when Operator is validatesJSONPath.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jsonPathOperator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "JSONPathOperator is the operator used to compare the value at JSONPath to Target. Defaults to is.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "operator"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestExtractedValue(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTestExtractedValue defines a value extracted from the response of a step",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the variable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the part of the response the value is extracted from: http_body or http_header.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"field": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Field is the name of the header to extract the value from, when Type is http_header.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parserType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParserType is the parser used to extract the value: raw, json_path, regex, or x_path.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parserValue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParserValue is the expression used by the parser, for instance a JSON path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "type", "parserType"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTestOptions defines the optional parameters of a synthetic test",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tickEvery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TickEvery is the frequency of the test, in seconds, between 30 and 604800.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minFailureDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinFailureDuration is how long the test should be in failure before alerting, in seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"minLocationFailed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinLocationFailed is the minimum number of locations in failure before alerting.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is the number of times a failed test is retried before being marked as failed.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryInterval is the time between two retries, in milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"renotifyInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenotifyInterval is the number of minutes after the last notification before the test monitor re-notifies.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"monitorPriority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorPriority is the priority of the test monitor, from 1 (high) to 5 (low).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"followRedirects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FollowRedirects makes HTTP requests follow redirects.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"acceptSelfSigned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AcceptSelfSigned makes the test accept self-signed certificates.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"allowInsecure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowInsecure allows insecure connections, for instance invalid certificates, in HTTP requests.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogSLO(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogSLO allows a user to define and manage datadog SLOs from Kubernetes cluster.",
+				Description: "DatadogSyntheticTestRequest defines the request performed by a synthetic test",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Method is the HTTP method of HTTP requests, for instance GET.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "URL is the URL of HTTP requests.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"host": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Host is the host name of SSL, DNS, TCP, and gRPC requests.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSLOSpec"),
+							Description: "Port is the port of SSL, TCP, and gRPC requests.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"status": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSLOStatus"),
+							Description: "Headers are the headers of HTTP requests.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.DatadogSLOSpec", "./apis/datadoghq/v1alpha1.DatadogSLOStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema__apis_datadoghq_v1alpha1_DatadogSLOControllerOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DatadogSLOControllerOptions defines options in the DatadogSLO controller.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"disableRequiredTags": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DisableRequiredTags disables the automatic addition of required tags to SLOs.",
-							Type:        []string{"boolean"},
+							Description: "Body is the body of HTTP requests.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema__apis_datadoghq_v1alpha1_DatadogSLOQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"numerator": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Numerator is a Datadog metric query for good events.",
-							Default:     "",
+							Description: "Timeout is the timeout of the request, in seconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"dnsServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSServer is the DNS server used by DNS requests.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"denominator": {
+					"dnsServerPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Denominator is a Datadog metric query for total (valid) events.",
-							Default:     "",
+							Description: "DNSServerPort is the port of the DNS server used by DNS requests.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service is the gRPC service of gRPC health checks.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"numerator", "denominator"},
 			},
 		},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogSLOSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DatadogSyntheticTestSpec defines the desired state of a DatadogSyntheticTest",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the service level objective.",
+							Description: "Name is the name of the test.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is a user-defined description of the service level objective. Always included in service level objective responses (but may be null). Optional in create/update requests.",
+							Description: "Message is the notification message of the test monitor.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"groups": {
+					"subtype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subtype is the type of API test: http, ssl, dns, tcp, grpc, or multi.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Request is the request performed by the test. Required unless Subtype is multi.",
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestRequest"),
+						},
+					},
+					"assertions": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Groups is a list of (up to 100) monitor groups that narrow the scope of a monitor service level objective. Included in service level objective responses if it is not empty. Optional in create/update requests for monitor service level objectives, but may only be used when the length of the monitor_ids field is one.",
+							Description: "Assertions are the assertions the response must satisfy for the test to pass. Required unless Subtype is multi.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestAssertion"),
 									},
 								},
 							},
 						},
 					},
-					"monitorIDs": {
+					"steps": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "MonitorIDs is a list of monitor IDs that defines the scope of a monitor service level objective. Required if type is monitor.",
+							Description: "Steps are the steps of a multistep test. Required if Subtype is multi.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: 0,
-										Type:    []string{"integer"},
-										Format:  "int64",
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestStep"),
 									},
 								},
 							},
 						},
 					},
-					"tags": {
+					"locations": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Tags is a list of tags to associate with your service level objective. This can help you categorize and filter service level objectives in the service level objectives page of the UI. Note: it's not currently possible to filter by these tags when querying via the API.",
+							Description: "Locations is the list of locations the test runs from, for instance `aws:eu-west-1`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2731,61 +4860,54 @@ func schema__apis_datadoghq_v1alpha1_DatadogSLOSpec(ref common.ReferenceCallback
 							},
 						},
 					},
-					"query": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Query is the query for a metric-based SLO. Required if type is metric. Note that only the `sum by` aggregator is allowed, which sums all request counts. `Average`, `max`, nor `min` request aggregators are not supported.",
-							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSLOQuery"),
-						},
-					},
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the service level objective.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"timeframe": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The SLO time window options.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"targetThreshold": {
+					"options": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetThreshold is the target threshold such that when the service level indicator is above this threshold over the given timeframe, the objective is being met.",
+							Description: "Options are the optional parameters of the test.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestOptions"),
 						},
 					},
-					"warningThreshold": {
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "WarningThreshold is a optional warning threshold such that when the service level indicator is below this value for the given threshold, but above the target threshold, the objective appears in a \"warning\" state. This value must be greater than the target threshold.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Tags is the list of tags to associate with the test.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"controllerOptions": {
+					"paused": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ControllerOptions are the optional parameters in the DatadogSLO controller",
-							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSLOControllerOptions"),
+							Description: "Paused pauses the test: it doesn't run until Paused is set back to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "type", "timeframe", "targetThreshold"},
+				Required: []string{"name", "subtype", "locations"},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v1alpha1.DatadogSLOControllerOptions", "./apis/datadoghq/v1alpha1.DatadogSLOQuery", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"./apis/datadoghq/v1alpha1.DatadogSyntheticTestAssertion", "./apis/datadoghq/v1alpha1.DatadogSyntheticTestOptions", "./apis/datadoghq/v1alpha1.DatadogSyntheticTestRequest", "./apis/datadoghq/v1alpha1.DatadogSyntheticTestStep"},
 	}
 }
 
-func schema__apis_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogSLOStatus defines the observed state of a DatadogSLO.",
+				Description: "DatadogSyntheticTestStatus defines the observed state of a DatadogSyntheticTest",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"conditions": {
@@ -2798,7 +4920,7 @@ func schema__apis_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallba
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of the state of a DatadogSLO.",
+							Description: "Conditions represents the latest available observations of the state of a DatadogSyntheticTest.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2810,42 +4932,68 @@ func schema__apis_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallba
 							},
 						},
 					},
-					"id": {
+					"publicID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the SLO ID generated in Datadog.",
+							Description: "PublicID is the public ID of the test generated in Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"monitorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MonitorID is the ID of the monitor of the test generated in Datadog.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"creator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Creator is the identity of the SLO creator.",
+							Description: "Creator is the identity of the test creator.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"created": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Created is the time the SLO was created.",
+							Description: "Created is the time the test was created.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastResultState": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastResultState is the state of the last result of the test: passed or failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastResultTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastResultTime is the time of the last result of the test.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"syncStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SyncStatus shows the health of syncing the SLO state to Datadog.",
+							Description: "SyncStatus shows the health of syncing the test state to Datadog.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"lastForceSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastForceSyncTime is the last time the API SLO was last force synced with the DatadogSLO resource.",
+							Description: "LastForceSyncTime is the last time the API test was last force synced with the DatadogSyntheticTest resource.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"stateLastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StateLastUpdateTime is the last time the result state was updated.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 					"currentHash": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CurrentHash tracks the hash of the current DatadogSLOSpec to know if the Spec has changed and needs an update.",
+							Description: "CurrentHash tracks the hash of the current DatadogSyntheticTestSpec to know if the Spec has changed and needs an update.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2858,6 +5006,89 @@ func schema__apis_datadoghq_v1alpha1_DatadogSLOStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema__apis_datadoghq_v1alpha1_DatadogSyntheticTestStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogSyntheticTestStep defines a step of a multistep synthetic test",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the step.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Request is the HTTP request performed by the step.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestRequest"),
+						},
+					},
+					"assertions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Assertions are the assertions the response of the step must satisfy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestAssertion"),
+									},
+								},
+							},
+						},
+					},
+					"extractedValues": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtractedValues are the values extracted from the response, usable as variables in the next steps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v1alpha1.DatadogSyntheticTestExtractedValue"),
+									},
+								},
+							},
+						},
+					},
+					"allowFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowFailure lets the test continue when the step fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"isCritical": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IsCritical makes the test fail when the step fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "request"},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v1alpha1.DatadogSyntheticTestAssertion", "./apis/datadoghq/v1alpha1.DatadogSyntheticTestExtractedValue", "./apis/datadoghq/v1alpha1.DatadogSyntheticTestRequest"},
+	}
+}
+
 func schema__apis_datadoghq_v1alpha1_DogstatsdConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -71,6 +71,17 @@ func SetDatadogAgentStatusCondition(status *DatadogAgentStatus, condition *metav
 	}
 }
 
+// UpdateFeatureStatusConditions used to update a specific condition of a feature status
+func UpdateFeatureStatusConditions(status *FeatureStatus, now metav1.Time, t string, conditionStatus metav1.ConditionStatus, reason, message string) {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == t {
+			UpdateDatadogAgentStatusCondition(&status.Conditions[i], now, t, conditionStatus, reason, message)
+			return
+		}
+	}
+	status.Conditions = append(status.Conditions, NewDatadogAgentStatusCondition(t, conditionStatus, now, reason, message))
+}
+
 // NewDatadogAgentStatusCondition returns new metav1.Condition instance
 func NewDatadogAgentStatusCondition(conditionType string, conditionStatus metav1.ConditionStatus, now metav1.Time, reason, message string) metav1.Condition {
 	return metav1.Condition{
@@ -12,6 +12,10 @@ const (
 	OverrideReconcileConflictConditionType = "OverrideReconcileConflict"
 	// DatadogAgentReconcileErrorConditionType ReconcileConditionType for DatadogAgent reconcile error
 	DatadogAgentReconcileErrorConditionType = "DatadogAgentReconcileError"
+	// ValidConditionType ConditionType reporting whether the DatadogAgent spec is valid
+	ValidConditionType = "Valid"
+	// FeatureHealthyConditionType ConditionType reporting whether the resources of a feature are reconciled without error
+	FeatureHealthyConditionType = "Healthy"
 
 	// ExtraConfdConfigMapName is the name of the ConfigMap storing Custom Confd data
 	ExtraConfdConfigMapName = "%s-extra-confd"
@@ -86,6 +86,8 @@ const (
 	defaultPrometheusScrapeEnableServiceEndpoints bool = false
 	defaultPrometheusScrapeVersion                int  = 2
 
+	defaultControlPlaneMonitoringComponentEnabled bool = true
+
 	// defaultKubeletAgentCAPath            = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
 	// defaultKubeletAgentCAPathHostPathSet = "/var/run/host-kubelet-ca.crt"
 )
@@ -308,4 +310,28 @@ func defaultFeaturesConfig(ddaSpec *DatadogAgentSpec) {
 		apiutils.DefaultBooleanIfUnset(&ddaSpec.Features.PrometheusScrape.EnableServiceEndpoints, defaultPrometheusScrapeEnableServiceEndpoints)
 		apiutils.DefaultIntIfUnset(&ddaSpec.Features.PrometheusScrape.Version, defaultPrometheusScrapeVersion)
 	}
+
+	// ControlPlaneMonitoring Feature
+	if ddaSpec.Features.ControlPlaneMonitoring != nil && apiutils.BoolValue(ddaSpec.Features.ControlPlaneMonitoring.Enabled) {
+		controlPlane := ddaSpec.Features.ControlPlaneMonitoring
+		if controlPlane.APIServer == nil {
+			controlPlane.APIServer = &ControlPlaneComponentConfig{}
+		}
+		apiutils.DefaultBooleanIfUnset(&controlPlane.APIServer.Enabled, defaultControlPlaneMonitoringComponentEnabled)
+
+		if controlPlane.Etcd == nil {
+			controlPlane.Etcd = &EtcdMonitoringConfig{}
+		}
+		apiutils.DefaultBooleanIfUnset(&controlPlane.Etcd.Enabled, defaultControlPlaneMonitoringComponentEnabled)
+
+		if controlPlane.Scheduler == nil {
+			controlPlane.Scheduler = &ControlPlaneComponentConfig{}
+		}
+		apiutils.DefaultBooleanIfUnset(&controlPlane.Scheduler.Enabled, defaultControlPlaneMonitoringComponentEnabled)
+
+		if controlPlane.ControllerManager == nil {
+			controlPlane.ControllerManager = &ControlPlaneComponentConfig{}
+		}
+		apiutils.DefaultBooleanIfUnset(&controlPlane.ControllerManager.Enabled, defaultControlPlaneMonitoringComponentEnabled)
+	}
 }
@@ -6,9 +6,13 @@
 package v2alpha1
 
 import (
+	edsv1alpha1 "github.com/DataDog/extendeddaemonset/api/v1alpha1"
 	securityv1 "github.com/openshift/api/security/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
 )
@@ -38,6 +42,36 @@ type DatadogAgentSpec struct {
 	// Override the default configurations of the agents
 	// +optional
 	Override map[ComponentName]*DatadogAgentComponentOverride `json:"override,omitempty"`
+
+	// Profiles run a dedicated Agent DaemonSet on the nodes they select, configured with their own override.
+	// The default Agent DaemonSet does not run on the nodes selected by a profile, and a node cannot be selected by several profiles.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Profiles []DatadogAgentProfile `json:"profiles,omitempty"`
+}
+
+// DatadogAgentProfile defines a set of nodes running a dedicated Agent DaemonSet.
+// +k8s:openapi-gen=true
+type DatadogAgentProfile struct {
+	// Name of the profile. The Agent DaemonSet of the profile is named `<DatadogAgent name>-agent-<profile name>`.
+	Name string `json:"name"`
+
+	// NodeSelector selects the nodes of the profile by their labels.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// NodeSelectorRequirements selects the nodes of the profile by their labels.
+	// Supported operators are `In`, `NotIn`, `Exists` and `DoesNotExist`.
+	// A node belongs to the profile when it matches the NodeSelector and all the requirements.
+	// +optional
+	// +listType=atomic
+	NodeSelectorRequirements []corev1.NodeSelectorRequirement `json:"nodeSelectorRequirements,omitempty"`
+
+	// Override the configuration of the Agent DaemonSet of the profile.
+	// It is applied on top of the `nodeAgent` override.
+	// +optional
+	Override *DatadogAgentComponentOverride `json:"override,omitempty"`
 }
 
 // DatadogFeatures are features running on the Agent and Cluster Agent.
@@ -94,6 +128,8 @@ type DatadogFeatures struct {
 	ClusterChecks *ClusterChecksFeatureConfig `json:"clusterChecks,omitempty"`
 	// PrometheusScrape configuration.
 	PrometheusScrape *PrometheusScrapeFeatureConfig `json:"prometheusScrape,omitempty"`
+	// ControlPlaneMonitoring configuration.
+	ControlPlaneMonitoring *ControlPlaneMonitoringFeatureConfig `json:"controlPlaneMonitoring,omitempty"`
 }
 
 // Configuration structs for each feature in DatadogFeatures. All parameters are optional and have default values when necessary.
@@ -119,6 +155,43 @@ type APMFeatureConfig struct {
 	// Path Default: `/var/run/datadog/apm.socket`
 	// +optional
 	UnixDomainSocketConfig *UnixDomainSocketConfig `json:"unixDomainSocketConfig,omitempty"`
+
+	// Instrumentation configures the injection of the APM libraries in the application pods by the Admission Controller
+	// (single-step instrumentation). It requires the Admission Controller to be enabled.
+	// +optional
+	Instrumentation *APMInstrumentationConfig `json:"instrumentation,omitempty"`
+}
+
+// APMInstrumentationConfig contains the configuration of the APM libraries injection.
+// +k8s:openapi-gen=true
+type APMInstrumentationConfig struct {
+	// Enabled enables the injection of the APM libraries in the pods of all the namespaces, except the disabled ones.
+	// Default: false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// EnabledNamespaces restricts the injection to the pods of the listed namespaces.
+	// It cannot be set with `disabledNamespaces`.
+	// +optional
+	// +listType=set
+	EnabledNamespaces []string `json:"enabledNamespaces,omitempty"`
+
+	// DisabledNamespaces lists the namespaces whose pods are never instrumented.
+	// It cannot be set with `enabledNamespaces`.
+	// +optional
+	// +listType=set
+	DisabledNamespaces []string `json:"disabledNamespaces,omitempty"`
+
+	// LibVersions sets the versions of the APM libraries to inject, by language.
+	// <LANGUAGE>: <VERSION>, where the language is one of java, js, python, dotnet and ruby.
+	// All the libraries are injected in their latest version when not set.
+	// +optional
+	LibVersions map[string]string `json:"libVersions,omitempty"`
+
+	// Registry is the image registry the APM libraries are pulled from.
+	// Default: the Cluster Agent default registry (gcr.io/datadoghq)
+	// +optional
+	Registry *string `json:"registry,omitempty"`
 }
 
 // LogCollectionFeatureConfig contains Logs configuration.
@@ -412,6 +485,96 @@ type DogstatsdFeatureConfig struct {
 type OTLPFeatureConfig struct {
 	// Receiver contains configuration for the OTLP ingest receiver.
 	Receiver OTLPReceiverConfig `json:"receiver,omitempty"`
+
+	// Logs contains configuration for the OTLP logs ingestion.
+	// +optional
+	Logs *OTLPLogsConfig `json:"logs,omitempty"`
+
+	// Metrics contains configuration for the OTLP metrics ingestion.
+	// +optional
+	Metrics *OTLPMetricsConfig `json:"metrics,omitempty"`
+
+	// Traces contains configuration for the OTLP traces ingestion.
+	// +optional
+	Traces *OTLPTracesConfig `json:"traces,omitempty"`
+}
+
+// OTLPLogsConfig contains configuration for the OTLP logs ingestion.
+// +k8s:openapi-gen=true
+type OTLPLogsConfig struct {
+	// Enable the ingestion of OTLP logs.
+	// The logs collection must be enabled too: `features.logCollection.enabled`.
+	// Default: false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// OTLPMetricsConfig contains configuration for the OTLP metrics ingestion.
+// +k8s:openapi-gen=true
+type OTLPMetricsConfig struct {
+	// Enable the ingestion of OTLP metrics.
+	// Default: true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// ResourceAttributesAsTags sets all the resource attributes as tags of the metrics.
+	// Default: false
+	// +optional
+	ResourceAttributesAsTags *bool `json:"resourceAttributesAsTags,omitempty"`
+
+	// InstrumentationScopeMetadataAsTags sets the name and version of the instrumentation scope as tags of the metrics.
+	// Default: false
+	// +optional
+	InstrumentationScopeMetadataAsTags *bool `json:"instrumentationScopeMetadataAsTags,omitempty"`
+
+	// TagCardinality is the cardinality of the tags added by the origin detection: `low`, `orchestrator` or `high`.
+	// Default: `low`
+	// +optional
+	// +kubebuilder:validation:Enum=low;orchestrator;high
+	TagCardinality *string `json:"tagCardinality,omitempty"`
+
+	// Histograms contains configuration for the translation of the OTLP histograms.
+	// +optional
+	Histograms *OTLPHistogramsConfig `json:"histograms,omitempty"`
+
+	// Summaries contains configuration for the translation of the OTLP summaries.
+	// +optional
+	Summaries *OTLPSummariesConfig `json:"summaries,omitempty"`
+}
+
+// OTLPHistogramsConfig contains configuration for the translation of the OTLP histograms.
+// +k8s:openapi-gen=true
+type OTLPHistogramsConfig struct {
+	// Mode of the histograms translation: `distributions`, `counters` or `nobuckets`.
+	// Default: `distributions`
+	// +optional
+	// +kubebuilder:validation:Enum=distributions;counters;nobuckets
+	Mode *string `json:"mode,omitempty"`
+
+	// SendAggregationMetrics sends the `.sum`, `.count`, `.min` and `.max` metrics of the histograms.
+	// Default: false
+	// +optional
+	SendAggregationMetrics *bool `json:"sendAggregationMetrics,omitempty"`
+}
+
+// OTLPSummariesConfig contains configuration for the translation of the OTLP summaries.
+// +k8s:openapi-gen=true
+type OTLPSummariesConfig struct {
+	// Mode of the summaries translation: `gauges` sends a gauge per quantile, `noquantiles` only sends the `.sum` and `.count` metrics.
+	// Default: `gauges`
+	// +optional
+	// +kubebuilder:validation:Enum=gauges;noquantiles
+	Mode *string `json:"mode,omitempty"`
+}
+
+// OTLPTracesConfig contains configuration for the OTLP traces ingestion.
+// +k8s:openapi-gen=true
+type OTLPTracesConfig struct {
+	// Enable the ingestion of OTLP traces.
+	// The traces are only sent to Datadog when APM is enabled: `features.apm.enabled`.
+	// Default: true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
 }
 
 // OTLPReceiverConfig contains configuration for the OTLP ingest receiver.
@@ -518,8 +681,139 @@ type KubeStateMetricsCoreFeatureConfig struct {
 
 	// Conf overrides the configuration for the default Kubernetes State Metrics Core check.
 	// This must point to a ConfigMap containing a valid cluster check configuration.
+	// The other fields of this section are ignored when it is set.
 	// +optional
 	Conf *CustomConfig `json:"conf,omitempty"`
+
+	// Collectors configures the Kubernetes resources collected by the check.
+	// +optional
+	Collectors *KubeStateMetricsCoreCollectorsConfig `json:"collectors,omitempty"`
+
+	// LabelsAsTags maps the labels of the Kubernetes resources to Datadog tags, per resource kind.
+	// <RESOURCE_KIND>: {<KUBERNETES_LABEL>: <DATADOG_TAG_KEY>}, for instance `pod: {app: app}`.
+	// +optional
+	LabelsAsTags map[string]map[string]string `json:"labelsAsTags,omitempty"`
+
+	// AnnotationsAsTags maps the annotations of the Kubernetes resources to Datadog tags, per resource kind.
+	// <RESOURCE_KIND>: {<KUBERNETES_ANNOTATION>: <DATADOG_TAG_KEY>}
+	// +optional
+	AnnotationsAsTags map[string]map[string]string `json:"annotationsAsTags,omitempty"`
+
+	// Namespaces lists the namespaces of the collected resources.
+	// Default: all the namespaces
+	// +optional
+	// +listType=set
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespacesExcluded lists the namespaces of the resources that are not collected.
+	// +optional
+	// +listType=set
+	NamespacesExcluded []string `json:"namespacesExcluded,omitempty"`
+
+	// CustomResources defines the metrics collected from custom resources.
+	// The Cluster Agent or the Cluster Checks Runners are granted the permissions to list and watch them,
+	// the operator must have these permissions too: cluster admins must grant them to the operator ServiceAccount.
+	// The resources of the core and `rbac.authorization.k8s.io` API groups are not supported.
+	// +optional
+	// +listType=atomic
+	CustomResources []KubeStateMetricsCoreCustomResource `json:"customResources,omitempty"`
+}
+
+// KubeStateMetricsCoreCollectorsConfig configures the Kubernetes resources collected by the Kubernetes State Metrics Core check.
+// +k8s:openapi-gen=true
+type KubeStateMetricsCoreCollectorsConfig struct {
+	// Enabled lists the collectors to add to the default ones, for instance `poddisruptionbudgets`.
+	// +optional
+	// +listType=set
+	Enabled []string `json:"enabled,omitempty"`
+
+	// Disabled lists the default collectors to remove, for instance `secrets`.
+	// +optional
+	// +listType=set
+	Disabled []string `json:"disabled,omitempty"`
+}
+
+// KubeStateMetricsCoreCustomResource defines the metrics collected from a custom resource.
+// +k8s:openapi-gen=true
+type KubeStateMetricsCoreCustomResource struct {
+	// Group is the API group of the custom resource.
+	Group string `json:"group"`
+
+	// Version is the API version of the custom resource.
+	Version string `json:"version"`
+
+	// Kind is the kind of the custom resource.
+	Kind string `json:"kind"`
+
+	// Resource is the plural name of the custom resource.
+	// Default: the lowercase kind followed by `s`
+	// +optional
+	Resource *string `json:"resource,omitempty"`
+
+	// MetricNamePrefix is the prefix of the metric names.
+	// +optional
+	MetricNamePrefix *string `json:"metricNamePrefix,omitempty"`
+
+	// LabelsFromPath adds labels to all the metrics of the custom resource.
+	// The values are the paths of the label values in the object, for instance `name: [metadata, name]`.
+	// +optional
+	LabelsFromPath map[string][]string `json:"labelsFromPath,omitempty"`
+
+	// Metrics defines the metrics collected from the custom resource.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=name
+	Metrics []KubeStateMetricsCoreCustomResourceMetric `json:"metrics"`
+}
+
+// KubeStateMetricsCoreCustomResourceMetricType is the type of a custom resource metric.
+type KubeStateMetricsCoreCustomResourceMetricType string
+
+const (
+	// KubeStateMetricsCoreCustomResourceMetricTypeGauge reports a numeric field of the resource.
+	KubeStateMetricsCoreCustomResourceMetricTypeGauge KubeStateMetricsCoreCustomResourceMetricType = "Gauge"
+	// KubeStateMetricsCoreCustomResourceMetricTypeStateSet reports a field of the resource with a finite list of values.
+	KubeStateMetricsCoreCustomResourceMetricTypeStateSet KubeStateMetricsCoreCustomResourceMetricType = "StateSet"
+	// KubeStateMetricsCoreCustomResourceMetricTypeInfo reports fields of the resource as labels.
+	KubeStateMetricsCoreCustomResourceMetricTypeInfo KubeStateMetricsCoreCustomResourceMetricType = "Info"
+)
+
+// KubeStateMetricsCoreCustomResourceMetric defines a metric collected from a custom resource.
+// +k8s:openapi-gen=true
+type KubeStateMetricsCoreCustomResourceMetric struct {
+	// Name of the metric.
+	Name string `json:"name"`
+
+	// Help is the description of the metric.
+	// +optional
+	Help *string `json:"help,omitempty"`
+
+	// Type of the metric: Gauge, StateSet, or Info.
+	// +kubebuilder:validation:Enum=Gauge;StateSet;Info
+	Type KubeStateMetricsCoreCustomResourceMetricType `json:"type"`
+
+	// Path is the path of the object the metric is generated from, for instance `[status]`.
+	// +optional
+	// +listType=atomic
+	Path []string `json:"path,omitempty"`
+
+	// ValueFrom is the path of the value, relative to Path. Only used by Gauge and StateSet metrics.
+	// +optional
+	// +listType=atomic
+	ValueFrom []string `json:"valueFrom,omitempty"`
+
+	// LabelsFromPath adds labels to the metric, the values are paths relative to Path.
+	// +optional
+	LabelsFromPath map[string][]string `json:"labelsFromPath,omitempty"`
+
+	// LabelName is the name of the label holding the state of a StateSet metric.
+	// +optional
+	LabelName *string `json:"labelName,omitempty"`
+
+	// List lists the possible states of a StateSet metric.
+	// +optional
+	// +listType=atomic
+	List []string `json:"list,omitempty"`
 }
 
 // AdmissionControllerFeatureConfig contains the Admission Controller feature configuration.
@@ -629,6 +923,82 @@ type PrometheusScrapeFeatureConfig struct {
 	Version *int `json:"version,omitempty"`
 }
 
+// ControlPlaneMonitoringFeatureConfig contains the Control Plane Monitoring configuration.
+// The checks of the control plane components are configured for the platform detected by the operator:
+// OpenShift, static pods on the control plane nodes (kubeadm-based distributions), or a managed control plane
+// where only the API server is monitored.
+// The node Agent must run on the control plane nodes to monitor etcd, the scheduler and the controller manager.
+// +k8s:openapi-gen=true
+type ControlPlaneMonitoringFeatureConfig struct {
+	// Enabled enables the monitoring of the control plane components.
+	// Default: false
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Provider overrides the kind of control plane detected by the operator: `default` for a control plane running as static pods
+	// on the control plane nodes, `openshift`, or `managed` for a control plane managed by a cloud provider.
+	// EKS, GKE, IKS and ACK control planes are detected as `managed`, AKS and other managed control planes must be configured.
+	// +kubebuilder:validation:Enum=default;openshift;managed
+	// +optional
+	Provider *ControlPlaneProvider `json:"provider,omitempty"`
+
+	// APIServer contains the configuration of the monitoring of the API server.
+	// It requires the cluster checks: `features.clusterChecks.enabled`.
+	// +optional
+	APIServer *ControlPlaneComponentConfig `json:"apiServer,omitempty"`
+
+	// Etcd contains the configuration of the monitoring of etcd.
+	// +optional
+	Etcd *EtcdMonitoringConfig `json:"etcd,omitempty"`
+
+	// Scheduler contains the configuration of the monitoring of the scheduler.
+	// +optional
+	Scheduler *ControlPlaneComponentConfig `json:"scheduler,omitempty"`
+
+	// ControllerManager contains the configuration of the monitoring of the controller manager.
+	// +optional
+	ControllerManager *ControlPlaneComponentConfig `json:"controllerManager,omitempty"`
+}
+
+// ControlPlaneProvider is the kind of control plane of a cluster.
+type ControlPlaneProvider string
+
+const (
+	// ControlPlaneProviderDefault is a control plane running as static pods on the control plane nodes, like kubeadm-based distributions.
+	ControlPlaneProviderDefault ControlPlaneProvider = "default"
+	// ControlPlaneProviderOpenShift is the control plane of an OpenShift cluster.
+	ControlPlaneProviderOpenShift ControlPlaneProvider = "openshift"
+	// ControlPlaneProviderManaged is a control plane managed by a cloud provider, only the API server is exposed.
+	ControlPlaneProviderManaged ControlPlaneProvider = "managed"
+)
+
+// ControlPlaneComponentConfig contains the configuration of the monitoring of a control plane component.
+// +k8s:openapi-gen=true
+type ControlPlaneComponentConfig struct {
+	// Enabled enables the monitoring of the component.
+	// It is ignored for the components that are not exposed by a managed control plane.
+	// Default: true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// EtcdMonitoringConfig contains the configuration of the monitoring of etcd.
+// +k8s:openapi-gen=true
+type EtcdMonitoringConfig struct {
+	// Enabled enables the monitoring of etcd.
+	// It is ignored for the managed control planes, which do not expose etcd.
+	// Default: true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CertificatesSecretName is the name of the Secret containing the etcd client certificate `tls.crt` and key `tls.key`, on OpenShift.
+	// The Secret must be in the namespace of the DatadogAgent: copy the `etcd-metric-client` Secret of the `openshift-etcd-operator` namespace.
+	// The Agent pods do not start until the Secret exists.
+	// Default: `etcd-metric-client`
+	// +optional
+	CertificatesSecretName *string `json:"certificatesSecretName,omitempty"`
+}
+
 // Generic support structs
 
 // HostPortConfig contains host port configuration.
@@ -666,6 +1036,35 @@ type Endpoint struct {
 	Credentials *DatadogCredentials `json:"credentials,omitempty"`
 }
 
+// AdditionalEndpointType is the type of data sent to an additional endpoint.
+type AdditionalEndpointType string
+
+const (
+	// AdditionalEndpointTypeMetrics sends the metrics to the endpoint.
+	AdditionalEndpointTypeMetrics AdditionalEndpointType = "metrics"
+	// AdditionalEndpointTypeLogs sends the logs to the endpoint.
+	AdditionalEndpointTypeLogs AdditionalEndpointType = "logs"
+	// AdditionalEndpointTypeAPM sends the traces to the endpoint.
+	AdditionalEndpointTypeAPM AdditionalEndpointType = "apm"
+	// AdditionalEndpointTypeProcesses sends the processes and containers to the endpoint.
+	AdditionalEndpointTypeProcesses AdditionalEndpointType = "processes"
+)
+
+// AdditionalEndpoint defines an additional Datadog intake the Agent data are sent to.
+// +k8s:openapi-gen=true
+type AdditionalEndpoint struct {
+	// Type is the type of data sent to the endpoint: `metrics`, `logs`, `apm` or `processes`.
+	// +kubebuilder:validation:Enum=metrics;logs;apm;processes
+	Type AdditionalEndpointType `json:"type"`
+
+	// URL defines the endpoint URL, for example `https://app.datadoghq.eu`.
+	// For logs, it is the host and optional port of the logs intake, for example `agent-http-intake.logs.datadoghq.eu:443`.
+	URL string `json:"url"`
+
+	// APISecret references the secret containing the API key used to send data to the endpoint.
+	APISecret commonv1.SecretConfig `json:"apiSecret"`
+}
+
 // CustomConfig provides a place for custom configuration of the Agent or Cluster Agent, corresponding to datadog.yaml,
 // system-probe.yaml, security-agent.yaml or datadog-cluster.yaml.
 // The configuration can be provided in the ConfigData field as raw data, or referenced in a ConfigMap.
@@ -719,6 +1118,11 @@ type GlobalConfig struct {
 	// +optional
 	Endpoint *Endpoint `json:"endpoint,omitempty"`
 
+	// AdditionalEndpoints lists additional Datadog intakes the Agent data are also sent to (dual shipping).
+	// +optional
+	// +listType=atomic
+	AdditionalEndpoints []AdditionalEndpoint `json:"additionalEndpoints,omitempty"`
+
 	// Registry is the image registry to use for all Agent images.
 	// Use 'public.ecr.aws/datadog' for AWS ECR.
 	// Use 'docker.io/datadog' for DockerHub.
@@ -776,6 +1180,14 @@ type GlobalConfig struct {
 	// Path to the container runtime socket (if different from Docker).
 	// +optional
 	CriSocketPath *string `json:"criSocketPath,omitempty"`
+
+	// SecretBackend configures the secret backend used by all the Agents to resolve the `ENC[]` handles of their configuration.
+	// +optional
+	SecretBackend *SecretBackendConfig `json:"secretBackend,omitempty"`
+
+	// ContainerFilters includes or excludes containers from the data collection of all the Agents.
+	// +optional
+	ContainerFilters *ContainerFiltersConfig `json:"containerFilters,omitempty"`
 }
 
 // DatadogCredentials is a generic structure that holds credentials to access Datadog.
@@ -803,12 +1215,98 @@ type DatadogCredentials struct {
 }
 
 // SecretBackendConfig provides configuration for the secret backend.
+// +k8s:openapi-gen=true
 type SecretBackendConfig struct {
 	// Command defines the secret backend command to use
 	Command *string `json:"command,omitempty"`
 
-	// Args defines the list of arguments to pass to the command
+	// Args defines the list of arguments to pass to the command, they can't contain whitespaces
+	// +optional
+	// +listType=atomic
 	Args []string `json:"args,omitempty"`
+
+	// Timeout defines the secret backend command timeout, in seconds.
+	// Default: 30 (Agent default)
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Timeout *int32 `json:"timeout,omitempty"`
+
+	// EnableGlobalPermissions grants the Agents read access to all the Secrets of the cluster.
+	// It is required by the `/readsecret_multiple_providers.sh` script when the Secrets to read aren't listed in `roles`.
+	// Default: false
+	// +optional
+	EnableGlobalPermissions *bool `json:"enableGlobalPermissions,omitempty"`
+
+	// Roles grants the Agents read access to the listed Secrets, namespace by namespace.
+	// +optional
+	// +listType=atomic
+	Roles []SecretBackendRolesConfig `json:"roles,omitempty"`
+}
+
+// SecretBackendRolesConfig provides the Secrets of a namespace the Agents can read through the secret backend.
+// +k8s:openapi-gen=true
+type SecretBackendRolesConfig struct {
+	// Namespace of the Secrets.
+	Namespace string `json:"namespace"`
+
+	// Secrets lists the names of the Secrets the Agents can read.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Secrets []string `json:"secrets"`
+}
+
+// ContainerFiltersConfig provides the containers to include in or exclude from the data collection, per type of data.
+// Inclusion takes precedence over exclusion: a container matching both an inclusion and an exclusion filter is collected.
+// +k8s:openapi-gen=true
+type ContainerFiltersConfig struct {
+	// All filters the containers for all the types of data (metrics, logs, Live Containers and Autodiscovery).
+	// +optional
+	All *ContainerFilterConfig `json:"all,omitempty"`
+
+	// Metrics filters the containers for the metrics only.
+	// +optional
+	Metrics *ContainerFilterConfig `json:"metrics,omitempty"`
+
+	// Logs filters the containers for the logs only.
+	// +optional
+	Logs *ContainerFilterConfig `json:"logs,omitempty"`
+}
+
+// ContainerFilterConfig lists the containers to include and to exclude.
+// +k8s:openapi-gen=true
+type ContainerFilterConfig struct {
+	// Include lists the containers to collect, even when they match an exclusion filter.
+	// +optional
+	// +listType=atomic
+	Include []ContainerFilter `json:"include,omitempty"`
+
+	// Exclude lists the containers not to collect.
+	// +optional
+	// +listType=atomic
+	Exclude []ContainerFilter `json:"exclude,omitempty"`
+}
+
+// ContainerFilterType is the container attribute a filter is matched against.
+type ContainerFilterType string
+
+const (
+	// ContainerFilterTypeName matches the name of the container.
+	ContainerFilterTypeName ContainerFilterType = "name"
+	// ContainerFilterTypeImage matches the image of the container.
+	ContainerFilterTypeImage ContainerFilterType = "image"
+	// ContainerFilterTypeKubeNamespace matches the namespace of the pod of the container.
+	ContainerFilterTypeKubeNamespace ContainerFilterType = "kube_namespace"
+)
+
+// ContainerFilter matches the containers whose attribute matches a regular expression.
+// +k8s:openapi-gen=true
+type ContainerFilter struct {
+	// Type is the container attribute matched by the filter: name, image or kube_namespace.
+	// +kubebuilder:validation:Enum=name;image;kube_namespace
+	Type ContainerFilterType `json:"type"`
+
+	// Value is the regular expression the attribute must match, for example `^datadog-agent$`.
+	Value string `json:"value"`
 }
 
 // NetworkPolicyFlavor specifies which flavor of Network Policy to use.
@@ -990,6 +1488,105 @@ type DatadogAgentComponentOverride struct {
 	// Disabled force disables a component.
 	// +optional
 	Disabled *bool `json:"disabled,omitempty"`
+
+	// Configure the PodDisruptionBudget of the component.
+	// Only applicable for the Cluster Agent and the Cluster Checks Runner.
+	// +optional
+	PodDisruptionBudget *PodDisruptionBudgetConfig `json:"podDisruptionBudget,omitempty"`
+
+	// Configure the horizontal autoscaling of the component.
+	// When set, the Datadog Operator creates a HorizontalPodAutoscaler and stops reconciling the number of replicas.
+	// Only applicable for the Cluster Agent and the Cluster Checks Runner.
+	// +optional
+	Autoscaling *AutoscalingConfig `json:"autoscaling,omitempty"`
+
+	// Configure the update strategy of the DaemonSet or ExtendedDaemonSet.
+	// Only applicable for the node Agent.
+	// +optional
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// UpdateStrategy provides the update strategy configuration of the node Agent DaemonSet or ExtendedDaemonSet.
+// +k8s:openapi-gen=true
+type UpdateStrategy struct {
+	// Type of the DaemonSet update strategy: `RollingUpdate` or `OnDelete`.
+	// Ignored when the node Agent is deployed with an ExtendedDaemonSet.
+	// Default: `RollingUpdate`
+	// +optional
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+	Type appsv1.DaemonSetUpdateStrategyType `json:"type,omitempty"`
+
+	// Configure the rolling update when Type is `RollingUpdate`.
+	// +optional
+	RollingUpdate *RollingUpdate `json:"rollingUpdate,omitempty"`
+
+	// Configure the canary deployment of the ExtendedDaemonSet.
+	// The fields that are set override the defaults provided by the Datadog Operator flags.
+	// Only applicable when the node Agent is deployed with an ExtendedDaemonSet.
+	// +optional
+	Canary *edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary `json:"canary,omitempty"`
+}
+
+// RollingUpdate provides the rolling update configuration of the node Agent DaemonSet or ExtendedDaemonSet.
+// +k8s:openapi-gen=true
+type RollingUpdate struct {
+	// MaxUnavailable is the number or the percentage of Agent pods that can be unavailable during the update.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// MaxSurge is the number or the percentage of nodes with an existing available Agent pod
+	// that can have an updated Agent pod during the update.
+	// Ignored when the node Agent is deployed with an ExtendedDaemonSet.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+}
+
+// PodDisruptionBudgetConfig provides PodDisruptionBudget configurations for the Deployment components.
+// By default, a PodDisruptionBudget with `minAvailable: 1` is created when the component runs more than one replica.
+// +k8s:openapi-gen=true
+type PodDisruptionBudgetConfig struct {
+	// Enabled enables the PodDisruptionBudget of the component.
+	// Set it to `false` to not create a PodDisruptionBudget.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// MinAvailable is the number or the percentage of pods that must remain available during an eviction.
+	// Cannot be set together with MaxUnavailable.
+	// +optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+
+	// MaxUnavailable is the number or the percentage of pods that can be unavailable after an eviction.
+	// Cannot be set together with MinAvailable.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// AutoscalingConfig provides HorizontalPodAutoscaler configurations for the Deployment components.
+// +k8s:openapi-gen=true
+type AutoscalingConfig struct {
+	// MinReplicas is the lower limit for the number of replicas.
+	// Default: 1
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit for the number of replicas.
+	// +kubebuilder:validation:Minimum=1
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// TargetCPUUtilizationPercentage is the target average CPU utilization of the pods,
+	// represented as a percentage of the requested CPU.
+	// Default: 80 when no custom metric is set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// Metrics contains additional metrics used to compute the desired number of replicas,
+	// for instance the number of cluster checks scheduled per runner.
+	// See https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/ for more details.
+	// +optional
+	// +listType=atomic
+	Metrics []autoscalingv2.MetricSpec `json:"metrics,omitempty"`
 }
 
 // SecurityContextConstraintsConfig provides SecurityContextConstraints configurations for the components.
@@ -1090,6 +1687,42 @@ type DatadogAgentStatus struct {
 	// The actual state of the Cluster Checks Runner as a deployment.
 	// +optional
 	ClusterChecksRunner *commonv1.DeploymentStatus `json:"clusterChecksRunner,omitempty"`
+	// The actual state of the Agent of each profile.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Profiles []DatadogAgentProfileStatus `json:"profiles,omitempty"`
+	// The state of each enabled feature.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	Features []FeatureStatus `json:"features,omitempty"`
+}
+
+// DatadogAgentProfileStatus defines the observed state of a profile.
+// +k8s:openapi-gen=true
+type DatadogAgentProfileStatus struct {
+	// Name of the profile.
+	Name string `json:"name"`
+	// The actual state of the Agent of the profile.
+	// +optional
+	Agent *commonv1.DaemonSetStatus `json:"agent,omitempty"`
+}
+
+// FeatureStatus defines the observed state of a feature.
+// +k8s:openapi-gen=true
+type FeatureStatus struct {
+	// ID of the feature.
+	ID string `json:"id"`
+	// RequiredComponents lists the components that the feature needs.
+	// +optional
+	// +listType=set
+	RequiredComponents []ComponentName `json:"requiredComponents,omitempty"`
+	// Conditions Represents the latest available observations of the feature's current state.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // DatadogAgent Deployment with the Datadog Operator.
@@ -0,0 +1,515 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v2alpha1
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
+	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+)
+
+var (
+	supportedAgentCommunicationModes = []string{
+		apicommon.AdmissionControllerHostIPCommunicationMode,
+		apicommon.AdmissionControllerServiceCommunicationMode,
+		apicommon.AdmissionControllerSocketCommunicationMode,
+	}
+
+	// supportedContainerNames lists the containers that can be overridden for each component
+	supportedContainerNames = map[ComponentName][]commonv1.AgentContainerName{
+		NodeAgentComponentName: {
+			commonv1.AllContainers,
+			commonv1.CoreAgentContainerName,
+			commonv1.TraceAgentContainerName,
+			commonv1.ProcessAgentContainerName,
+			commonv1.SecurityAgentContainerName,
+			commonv1.SystemProbeContainerName,
+			commonv1.InitVolumeContainerName,
+			commonv1.InitConfigContainerName,
+			commonv1.SeccompSetupContainerName,
+		},
+		ClusterAgentComponentName: {
+			commonv1.AllContainers,
+			commonv1.ClusterAgentContainerName,
+		},
+		ClusterChecksRunnerComponentName: {
+			commonv1.AllContainers,
+			commonv1.ClusterChecksRunnersContainerName,
+		},
+	}
+
+	// unsupportedCustomResourceGroups lists the API groups of the resources the KSM Core check can't collect as custom resources,
+	// the operator would grant access to Secrets or RBAC resources to the Cluster Agent
+	unsupportedCustomResourceGroups = []string{"core", "rbac.authorization.k8s.io"}
+
+	// supportedAPMInstrumentationLanguages lists the languages of the APM libraries the Admission Controller can inject
+	supportedAPMInstrumentationLanguages = []string{"java", "js", "python", "dotnet", "ruby"}
+
+	supportedContainerFilterTypes = []string{
+		string(ContainerFilterTypeName),
+		string(ContainerFilterTypeImage),
+		string(ContainerFilterTypeKubeNamespace),
+	}
+
+	supportedProfileOperators = []string{
+		string(corev1.NodeSelectorOpIn),
+		string(corev1.NodeSelectorOpNotIn),
+		string(corev1.NodeSelectorOpExists),
+		string(corev1.NodeSelectorOpDoesNotExist),
+	}
+)
+
+// Validate checks that the DatadogAgent is valid once its default values are set.
+// It is used by the validating webhook, and by the reconciler to report the Valid condition.
+func (dda *DatadogAgent) Validate() error {
+	return dda.validate().ToAggregate()
+}
+
+func (dda *DatadogAgent) validate() field.ErrorList {
+	defaulted := dda.DeepCopy()
+	DefaultDatadogAgent(defaulted)
+
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+	errs = append(errs, validateGlobalConfig(defaulted.Spec.Global, specPath.Child("global"))...)
+	errs = append(errs, validateFeatures(defaulted.Spec.Features, specPath.Child("features"))...)
+	errs = append(errs, validateOverrides(defaulted.Spec.Override, specPath.Child("override"))...)
+	errs = append(errs, validateProfiles(defaulted.Spec.Profiles, specPath.Child("profiles"))...)
+
+	return errs
+}
+
+func validateGlobalConfig(global *GlobalConfig, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if global.Credentials == nil {
+		errs = append(errs, field.Required(path.Child("credentials"), "the Datadog credentials must be configured"))
+	}
+
+	supportedEndpointTypes := []string{
+		string(AdditionalEndpointTypeMetrics),
+		string(AdditionalEndpointTypeLogs),
+		string(AdditionalEndpointTypeAPM),
+		string(AdditionalEndpointTypeProcesses),
+	}
+	for i, endpoint := range global.AdditionalEndpoints {
+		endpointPath := path.Child("additionalEndpoints").Index(i)
+		if !isSupportedValue(string(endpoint.Type), supportedEndpointTypes) {
+			errs = append(errs, field.NotSupported(endpointPath.Child("type"), endpoint.Type, supportedEndpointTypes))
+		}
+		if endpoint.URL == "" {
+			errs = append(errs, field.Required(endpointPath.Child("url"), "the endpoint URL must be set"))
+		}
+		if endpoint.APISecret.SecretName == "" {
+			errs = append(errs, field.Required(endpointPath.Child("apiSecret", "secretName"), "the secret containing the endpoint API key must be set"))
+		}
+	}
+
+	if global.SecretBackend != nil {
+		errs = append(errs, validateSecretBackend(global.SecretBackend, path.Child("secretBackend"))...)
+	}
+
+	if global.ContainerFilters != nil {
+		filtersPath := path.Child("containerFilters")
+		errs = append(errs, validateContainerFilterConfig(global.ContainerFilters.All, filtersPath.Child("all"))...)
+		errs = append(errs, validateContainerFilterConfig(global.ContainerFilters.Metrics, filtersPath.Child("metrics"))...)
+		errs = append(errs, validateContainerFilterConfig(global.ContainerFilters.Logs, filtersPath.Child("logs"))...)
+	}
+
+	return errs
+}
+
+func validateSecretBackend(secretBackend *SecretBackendConfig, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if secretBackend.Command == nil || *secretBackend.Command == "" {
+		if len(secretBackend.Args) > 0 || secretBackend.Timeout != nil {
+			errs = append(errs, field.Required(path.Child("command"), "the secret backend command must be set to use args or timeout"))
+		}
+	}
+	// The Agent splits the arguments on whitespaces
+	for i, arg := range secretBackend.Args {
+		if strings.IndexFunc(arg, unicode.IsSpace) >= 0 {
+			errs = append(errs, field.Invalid(path.Child("args").Index(i), arg, "must not contain whitespaces"))
+		}
+	}
+	if secretBackend.Timeout != nil && *secretBackend.Timeout < 1 {
+		errs = append(errs, field.Invalid(path.Child("timeout"), *secretBackend.Timeout, "must be greater than or equal to 1"))
+	}
+	for i, role := range secretBackend.Roles {
+		rolePath := path.Child("roles").Index(i)
+		if role.Namespace == "" {
+			errs = append(errs, field.Required(rolePath.Child("namespace"), "the namespace of the secrets must be set"))
+		}
+		if len(role.Secrets) == 0 {
+			errs = append(errs, field.Required(rolePath.Child("secrets"), "at least one secret must be listed"))
+		}
+	}
+
+	return errs
+}
+
+func validateContainerFilterConfig(config *ContainerFilterConfig, path *field.Path) field.ErrorList {
+	if config == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	errs = append(errs, validateContainerFilters(config.Include, path.Child("include"))...)
+	errs = append(errs, validateContainerFilters(config.Exclude, path.Child("exclude"))...)
+	return errs
+}
+
+func validateContainerFilters(filters []ContainerFilter, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, filter := range filters {
+		filterPath := path.Index(i)
+		if !isSupportedValue(string(filter.Type), supportedContainerFilterTypes) {
+			errs = append(errs, field.NotSupported(filterPath.Child("type"), filter.Type, supportedContainerFilterTypes))
+		}
+		// The Agent splits the filters on whitespaces
+		switch {
+		case filter.Value == "":
+			errs = append(errs, field.Required(filterPath.Child("value"), "the regular expression of the filter must be set"))
+		case strings.ContainsAny(filter.Value, " \t\n"):
+			errs = append(errs, field.Invalid(filterPath.Child("value"), filter.Value, "must not contain whitespaces"))
+		default:
+			if _, err := regexp.Compile(filter.Value); err != nil {
+				errs = append(errs, field.Invalid(filterPath.Child("value"), filter.Value, fmt.Sprintf("invalid regular expression: %v", err)))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateFeatures(features *DatadogFeatures, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	apmEnabled := features.APM != nil && apiutils.BoolValue(features.APM.Enabled)
+	apmPath := path.Child("apm")
+	dsdPath := path.Child("dogstatsd")
+
+	var apmHostPort, dsdHostPort *int32
+	if apmEnabled && isHostPortEnabled(features.APM.HostPortConfig) {
+		apmHostPort = features.APM.HostPortConfig.Port
+		errs = append(errs, validateHostPort(apmHostPort, apmPath.Child("hostPortConfig", "hostPort"))...)
+	}
+	if features.Dogstatsd != nil && isHostPortEnabled(features.Dogstatsd.HostPortConfig) {
+		dsdHostPort = features.Dogstatsd.HostPortConfig.Port
+		errs = append(errs, validateHostPort(dsdHostPort, dsdPath.Child("hostPortConfig", "hostPort"))...)
+	}
+	if apmHostPort != nil && dsdHostPort != nil && *apmHostPort == *dsdHostPort {
+		errs = append(errs, field.Invalid(apmPath.Child("hostPortConfig", "hostPort"), *apmHostPort, fmt.Sprintf("conflicts with %s", dsdPath.Child("hostPortConfig", "hostPort"))))
+	}
+
+	apmSocketEnabled := apmEnabled && isUnixDomainSocketEnabled(features.APM.UnixDomainSocketConfig)
+	dsdSocketEnabled := features.Dogstatsd != nil && isUnixDomainSocketEnabled(features.Dogstatsd.UnixDomainSocketConfig)
+	if apmSocketEnabled && dsdSocketEnabled {
+		apmSocketPath, dsdSocketPath := features.APM.UnixDomainSocketConfig.Path, features.Dogstatsd.UnixDomainSocketConfig.Path
+		if apmSocketPath != nil && dsdSocketPath != nil && *apmSocketPath == *dsdSocketPath {
+			errs = append(errs, field.Invalid(apmPath.Child("unixDomainSocketConfig", "path"), *apmSocketPath, fmt.Sprintf("conflicts with %s", dsdPath.Child("unixDomainSocketConfig", "path"))))
+		}
+	}
+
+	if features.AdmissionController != nil && apiutils.BoolValue(features.AdmissionController.Enabled) && features.AdmissionController.AgentCommunicationMode != nil {
+		modePath := path.Child("admissionController", "agentCommunicationMode")
+		mode := *features.AdmissionController.AgentCommunicationMode
+		switch {
+		case !isSupportedValue(mode, supportedAgentCommunicationModes):
+			errs = append(errs, field.NotSupported(modePath, mode, supportedAgentCommunicationModes))
+		case mode == apicommon.AdmissionControllerSocketCommunicationMode && !apmSocketEnabled && !dsdSocketEnabled:
+			errs = append(errs, field.Invalid(modePath, mode, fmt.Sprintf("requires %s or %s to be enabled", apmPath.Child("unixDomainSocketConfig"), dsdPath.Child("unixDomainSocketConfig"))))
+		}
+	}
+
+	if features.APM != nil && features.APM.Instrumentation != nil && apiutils.BoolValue(features.APM.Instrumentation.Enabled) {
+		admissionControllerEnabled := features.AdmissionController != nil && apiutils.BoolValue(features.AdmissionController.Enabled)
+		errs = append(errs, validateAPMInstrumentation(features.APM.Instrumentation, apmEnabled, admissionControllerEnabled, apmPath.Child("instrumentation"), path)...)
+	}
+
+	if features.KubeStateMetricsCore != nil && apiutils.BoolValue(features.KubeStateMetricsCore.Enabled) {
+		errs = append(errs, validateKubeStateMetricsCore(features.KubeStateMetricsCore, path.Child("kubeStateMetricsCore"))...)
+	}
+
+	// The API server check is dispatched by the Cluster Agent
+	controlPlane := features.ControlPlaneMonitoring
+	clusterChecksDisabled := features.ClusterChecks != nil && features.ClusterChecks.Enabled != nil && !*features.ClusterChecks.Enabled
+	if controlPlane != nil && apiutils.BoolValue(controlPlane.Enabled) && clusterChecksDisabled &&
+		(controlPlane.APIServer == nil || controlPlane.APIServer.Enabled == nil || *controlPlane.APIServer.Enabled) {
+		errs = append(errs, field.Invalid(path.Child("controlPlaneMonitoring", "apiServer", "enabled"), true, fmt.Sprintf("requires %s to be enabled", path.Child("clusterChecks", "enabled"))))
+	}
+
+	return errs
+}
+
+func validateAPMInstrumentation(instrumentation *APMInstrumentationConfig, apmEnabled, admissionControllerEnabled bool, path, featuresPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	enabledPath := path.Child("enabled")
+	if !apmEnabled {
+		errs = append(errs, field.Invalid(enabledPath, true, fmt.Sprintf("requires %s to be enabled", featuresPath.Child("apm", "enabled"))))
+	}
+	if !admissionControllerEnabled {
+		errs = append(errs, field.Invalid(enabledPath, true, fmt.Sprintf("requires %s to be enabled", featuresPath.Child("admissionController", "enabled"))))
+	}
+	if len(instrumentation.EnabledNamespaces) > 0 && len(instrumentation.DisabledNamespaces) > 0 {
+		errs = append(errs, field.Forbidden(path.Child("disabledNamespaces"), fmt.Sprintf("cannot be set with %s", path.Child("enabledNamespaces"))))
+	}
+
+	// Iterate in a stable order to report the errors in the same order at each validation
+	languages := make([]string, 0, len(instrumentation.LibVersions))
+	for language := range instrumentation.LibVersions {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	for _, language := range languages {
+		languagePath := path.Child("libVersions").Key(language)
+		if !isSupportedValue(language, supportedAPMInstrumentationLanguages) {
+			errs = append(errs, field.NotSupported(languagePath, language, supportedAPMInstrumentationLanguages))
+		} else if instrumentation.LibVersions[language] == "" {
+			errs = append(errs, field.Required(languagePath, "the version of the library must be set"))
+		}
+	}
+
+	return errs
+}
+
+func validateKubeStateMetricsCore(ksm *KubeStateMetricsCoreFeatureConfig, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if ksm.Collectors != nil {
+		for i, collector := range ksm.Collectors.Disabled {
+			if isSupportedValue(collector, ksm.Collectors.Enabled) {
+				errs = append(errs, field.Invalid(path.Child("collectors", "disabled").Index(i), collector, "the collector is also enabled"))
+			}
+		}
+	}
+
+	for i, cr := range ksm.CustomResources {
+		crPath := path.Child("customResources").Index(i)
+		if cr.Group == "" {
+			errs = append(errs, field.Required(crPath.Child("group"), "the API group of the custom resource must be set"))
+		} else if isSupportedValue(cr.Group, unsupportedCustomResourceGroups) {
+			errs = append(errs, field.Forbidden(crPath.Child("group"), fmt.Sprintf("the resources of the %s API group can't be collected", cr.Group)))
+		}
+		if cr.Version == "" {
+			errs = append(errs, field.Required(crPath.Child("version"), "the API version of the custom resource must be set"))
+		}
+		if cr.Kind == "" {
+			errs = append(errs, field.Required(crPath.Child("kind"), "the kind of the custom resource must be set"))
+		}
+		if len(cr.Metrics) == 0 {
+			errs = append(errs, field.Required(crPath.Child("metrics"), "at least one metric must be defined"))
+		}
+		for j, metric := range cr.Metrics {
+			metricPath := crPath.Child("metrics").Index(j)
+			if metric.Name == "" {
+				errs = append(errs, field.Required(metricPath.Child("name"), "the metric name must be set"))
+			}
+			if metric.Type == KubeStateMetricsCoreCustomResourceMetricTypeStateSet {
+				if metric.LabelName == nil || *metric.LabelName == "" {
+					errs = append(errs, field.Required(metricPath.Child("labelName"), "required by StateSet metrics"))
+				}
+				if len(metric.List) == 0 {
+					errs = append(errs, field.Required(metricPath.Child("list"), "required by StateSet metrics"))
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateOverrides(overrides map[ComponentName]*DatadogAgentComponentOverride, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	supportedComponents := []string{string(NodeAgentComponentName), string(ClusterAgentComponentName), string(ClusterChecksRunnerComponentName)}
+	// Iterate in a stable order to report the errors in the same order at each validation
+	components := make([]string, 0, len(overrides))
+	for component := range overrides {
+		components = append(components, string(component))
+	}
+	sort.Strings(components)
+
+	for _, name := range components {
+		component := ComponentName(name)
+		override := overrides[component]
+		overridePath := path.Key(string(component))
+		if _, ok := supportedContainerNames[component]; !ok {
+			errs = append(errs, field.NotSupported(overridePath, string(component), supportedComponents))
+			continue
+		}
+		if override == nil {
+			continue
+		}
+
+		errs = append(errs, validateOverride(component, override, overridePath)...)
+	}
+
+	return errs
+}
+
+func validateOverride(component ComponentName, override *DatadogAgentComponentOverride, overridePath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	supportedContainers := make([]string, 0, len(supportedContainerNames[component]))
+	for _, name := range supportedContainerNames[component] {
+		supportedContainers = append(supportedContainers, string(name))
+	}
+	containers := make([]string, 0, len(override.Containers))
+	for name := range override.Containers {
+		containers = append(containers, string(name))
+	}
+	sort.Strings(containers)
+	for _, name := range containers {
+		if !isSupportedValue(name, supportedContainers) {
+			errs = append(errs, field.NotSupported(overridePath.Child("containers").Key(name), name, supportedContainers))
+		}
+	}
+
+	fileNames := make([]string, 0, len(override.CustomConfigurations))
+	for fileName := range override.CustomConfigurations {
+		fileNames = append(fileNames, string(fileName))
+	}
+	sort.Strings(fileNames)
+	for _, fileName := range fileNames {
+		customConfig := override.CustomConfigurations[AgentConfigFileName(fileName)]
+		if customConfig.ConfigData != nil && customConfig.ConfigMap != nil {
+			errs = append(errs, field.Forbidden(overridePath.Child("customConfigurations").Key(fileName), "configData and configMap cannot be set together"))
+		}
+	}
+	if override.ExtraConfd != nil && override.ExtraConfd.ConfigDataMap != nil && override.ExtraConfd.ConfigMap != nil {
+		errs = append(errs, field.Forbidden(overridePath.Child("extraConfd"), "configDataMap and configMap cannot be set together"))
+	}
+	if override.ExtraChecksd != nil && override.ExtraChecksd.ConfigDataMap != nil && override.ExtraChecksd.ConfigMap != nil {
+		errs = append(errs, field.Forbidden(overridePath.Child("extraChecksd"), "configDataMap and configMap cannot be set together"))
+	}
+
+	if pdb := override.PodDisruptionBudget; pdb != nil {
+		pdbPath := overridePath.Child("podDisruptionBudget")
+		if component == NodeAgentComponentName {
+			errs = append(errs, field.Forbidden(pdbPath, "only supported by the clusterAgent and clusterChecksRunner components"))
+		} else if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+			errs = append(errs, field.Invalid(pdbPath.Child("maxUnavailable"), pdb.MaxUnavailable.String(), "minAvailable and maxUnavailable cannot be set together"))
+		}
+	}
+
+	if autoscaling := override.Autoscaling; autoscaling != nil {
+		autoscalingPath := overridePath.Child("autoscaling")
+		if component == NodeAgentComponentName {
+			errs = append(errs, field.Forbidden(autoscalingPath, "only supported by the clusterAgent and clusterChecksRunner components"))
+		} else {
+			if override.Replicas != nil {
+				errs = append(errs, field.Forbidden(overridePath.Child("replicas"), "cannot be set when autoscaling is configured"))
+			}
+			if autoscaling.MaxReplicas < 1 {
+				errs = append(errs, field.Invalid(autoscalingPath.Child("maxReplicas"), autoscaling.MaxReplicas, "must be greater than or equal to 1"))
+			} else if autoscaling.MinReplicas != nil && *autoscaling.MinReplicas > autoscaling.MaxReplicas {
+				errs = append(errs, field.Invalid(autoscalingPath.Child("minReplicas"), *autoscaling.MinReplicas, "must be less than or equal to maxReplicas"))
+			}
+		}
+	}
+
+	if updateStrategy := override.UpdateStrategy; updateStrategy != nil {
+		updateStrategyPath := overridePath.Child("updateStrategy")
+		if component != NodeAgentComponentName {
+			errs = append(errs, field.Forbidden(updateStrategyPath, "only supported by the nodeAgent component"))
+		} else {
+			supportedTypes := []string{string(appsv1.RollingUpdateDaemonSetStrategyType), string(appsv1.OnDeleteDaemonSetStrategyType)}
+			if updateStrategy.Type != "" && !isSupportedValue(string(updateStrategy.Type), supportedTypes) {
+				errs = append(errs, field.NotSupported(updateStrategyPath.Child("type"), updateStrategy.Type, supportedTypes))
+			}
+			if updateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType && updateStrategy.RollingUpdate != nil {
+				errs = append(errs, field.Forbidden(updateStrategyPath.Child("rollingUpdate"), "cannot be set when type is OnDelete"))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateProfiles(profiles []DatadogAgentProfile, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	names := map[string]bool{}
+	for i, profile := range profiles {
+		profilePath := path.Index(i)
+		namePath := profilePath.Child("name")
+		if profile.Name == "" {
+			errs = append(errs, field.Required(namePath, "the profile name must be set"))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(profile.Name) {
+				errs = append(errs, field.Invalid(namePath, profile.Name, msg))
+			}
+			if names[profile.Name] {
+				errs = append(errs, field.Duplicate(namePath, profile.Name))
+			}
+			names[profile.Name] = true
+		}
+
+		if len(profile.NodeSelector) == 0 && len(profile.NodeSelectorRequirements) == 0 {
+			errs = append(errs, field.Required(profilePath, "nodeSelector or nodeSelectorRequirements must be set"))
+		}
+		for j, requirement := range profile.NodeSelectorRequirements {
+			requirementPath := profilePath.Child("nodeSelectorRequirements").Index(j)
+			switch requirement.Operator {
+			case corev1.NodeSelectorOpIn, corev1.NodeSelectorOpNotIn:
+				if len(requirement.Values) == 0 {
+					errs = append(errs, field.Required(requirementPath.Child("values"), fmt.Sprintf("must be set with the %s operator", requirement.Operator)))
+				}
+			case corev1.NodeSelectorOpExists, corev1.NodeSelectorOpDoesNotExist:
+				if len(requirement.Values) > 0 {
+					errs = append(errs, field.Forbidden(requirementPath.Child("values"), fmt.Sprintf("must be empty with the %s operator", requirement.Operator)))
+				}
+			default:
+				errs = append(errs, field.NotSupported(requirementPath.Child("operator"), requirement.Operator, supportedProfileOperators))
+			}
+		}
+
+		if profile.Override != nil {
+			errs = append(errs, validateOverride(NodeAgentComponentName, profile.Override, profilePath.Child("override"))...)
+		}
+	}
+
+	return errs
+}
+
+func validateHostPort(port *int32, path *field.Path) field.ErrorList {
+	if port == nil {
+		return nil
+	}
+	if *port < 1 || *port > 65535 {
+		return field.ErrorList{field.Invalid(path, *port, "must be between 1 and 65535")}
+	}
+
+	return nil
+}
+
+func isHostPortEnabled(config *HostPortConfig) bool {
+	return config != nil && apiutils.BoolValue(config.Enabled)
+}
+
+func isUnixDomainSocketEnabled(config *UnixDomainSocketConfig) bool {
+	return config != nil && apiutils.BoolValue(config.Enabled)
+}
+
+func isSupportedValue(value string, supported []string) bool {
+	for _, s := range supported {
+		if value == s {
+			return true
+		}
+	}
+
+	return false
+}
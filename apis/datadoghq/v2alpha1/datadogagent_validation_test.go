@@ -0,0 +1,499 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+)
+
+func TestDatadogAgentValidate(t *testing.T) {
+	minAvailable := intstr.FromInt(1)
+	maxUnavailable := intstr.FromInt(1)
+
+	tests := []struct {
+		name       string
+		global     *GlobalConfig
+		features   *DatadogFeatures
+		override   map[ComponentName]*DatadogAgentComponentOverride
+		profiles   []DatadogAgentProfile
+		wantFields []string
+	}{
+		{
+			name: "defaulted spec",
+		},
+		{
+			name:       "missing credentials",
+			global:     &GlobalConfig{},
+			wantFields: []string{"spec.global.credentials"},
+		},
+		{
+			name: "additional endpoints",
+			global: &GlobalConfig{
+				Credentials: &DatadogCredentials{APIKey: apiutils.NewStringPointer("0000000000000000000000")},
+				AdditionalEndpoints: []AdditionalEndpoint{
+					{Type: AdditionalEndpointTypeMetrics, URL: "https://app.datadoghq.eu", APISecret: commonv1.SecretConfig{SecretName: "eu"}},
+					{Type: "traces", URL: "https://trace.agent.datadoghq.eu", APISecret: commonv1.SecretConfig{SecretName: "eu"}},
+					{Type: AdditionalEndpointTypeLogs},
+				},
+			},
+			wantFields: []string{
+				"spec.global.additionalEndpoints[1].type",
+				"spec.global.additionalEndpoints[2].url",
+				"spec.global.additionalEndpoints[2].apiSecret.secretName",
+			},
+		},
+		{
+			name: "secret backend",
+			global: &GlobalConfig{
+				Credentials: &DatadogCredentials{APIKey: apiutils.NewStringPointer("0000000000000000000000")},
+				SecretBackend: &SecretBackendConfig{
+					Args:    []string{"--debug", "--path /etc/secrets"},
+					Timeout: apiutils.NewInt32Pointer(0),
+					Roles: []SecretBackendRolesConfig{
+						{Namespace: "datadog", Secrets: []string{"api-key"}},
+						{Secrets: []string{"app-key"}},
+						{Namespace: "default"},
+					},
+				},
+			},
+			wantFields: []string{
+				"spec.global.secretBackend.command",
+				"spec.global.secretBackend.args[1]",
+				"spec.global.secretBackend.timeout",
+				"spec.global.secretBackend.roles[1].namespace",
+				"spec.global.secretBackend.roles[2].secrets",
+			},
+		},
+		{
+			name: "invalid kube state metrics core config",
+			features: &DatadogFeatures{
+				KubeStateMetricsCore: &KubeStateMetricsCoreFeatureConfig{
+					Enabled: apiutils.NewBoolPointer(true),
+					Collectors: &KubeStateMetricsCoreCollectorsConfig{
+						Enabled:  []string{"poddisruptionbudgets"},
+						Disabled: []string{"secrets", "poddisruptionbudgets"},
+					},
+					CustomResources: []KubeStateMetricsCoreCustomResource{
+						{
+							Group:   "example.com",
+							Version: "v1",
+							Kind:    "Foo",
+							Metrics: []KubeStateMetricsCoreCustomResourceMetric{
+								{Name: "replicas", Type: KubeStateMetricsCoreCustomResourceMetricTypeGauge, Path: []string{"status", "replicas"}},
+								{Name: "phase", Type: KubeStateMetricsCoreCustomResourceMetricTypeStateSet, List: []string{"Running"}},
+							},
+						},
+						{Group: "example.com", Version: "v1"},
+						{
+							Group:   "core",
+							Version: "v1",
+							Kind:    "Secret",
+							Metrics: []KubeStateMetricsCoreCustomResourceMetric{{Name: "info", Type: KubeStateMetricsCoreCustomResourceMetricTypeInfo}},
+						},
+						{
+							Group:   "rbac.authorization.k8s.io",
+							Version: "v1",
+							Kind:    "ClusterRole",
+							Metrics: []KubeStateMetricsCoreCustomResourceMetric{{Name: "info", Type: KubeStateMetricsCoreCustomResourceMetricTypeInfo}},
+						},
+					},
+				},
+			},
+			wantFields: []string{
+				"spec.features.kubeStateMetricsCore.collectors.disabled[1]",
+				"spec.features.kubeStateMetricsCore.customResources[0].metrics[1].labelName",
+				"spec.features.kubeStateMetricsCore.customResources[1].kind",
+				"spec.features.kubeStateMetricsCore.customResources[1].metrics",
+				"spec.features.kubeStateMetricsCore.customResources[2].group",
+				"spec.features.kubeStateMetricsCore.customResources[3].group",
+			},
+		},
+		{
+			name: "control plane monitoring without cluster checks",
+			features: &DatadogFeatures{
+				ControlPlaneMonitoring: &ControlPlaneMonitoringFeatureConfig{Enabled: apiutils.NewBoolPointer(true)},
+				ClusterChecks:          &ClusterChecksFeatureConfig{Enabled: apiutils.NewBoolPointer(false)},
+			},
+			wantFields: []string{"spec.features.controlPlaneMonitoring.apiServer.enabled"},
+		},
+		{
+			name: "control plane monitoring without the API server and cluster checks",
+			features: &DatadogFeatures{
+				ControlPlaneMonitoring: &ControlPlaneMonitoringFeatureConfig{
+					Enabled:   apiutils.NewBoolPointer(true),
+					APIServer: &ControlPlaneComponentConfig{Enabled: apiutils.NewBoolPointer(false)},
+				},
+				ClusterChecks: &ClusterChecksFeatureConfig{Enabled: apiutils.NewBoolPointer(false)},
+			},
+		},
+		{
+			name: "container filters",
+			global: &GlobalConfig{
+				Credentials: &DatadogCredentials{APIKey: apiutils.NewStringPointer("0000000000000000000000")},
+				ContainerFilters: &ContainerFiltersConfig{
+					All: &ContainerFilterConfig{
+						Exclude: []ContainerFilter{
+							{Type: ContainerFilterTypeKubeNamespace, Value: "^kube-system$"},
+							{Type: "pod", Value: "datadog"},
+						},
+					},
+					Metrics: &ContainerFilterConfig{
+						Include: []ContainerFilter{{Type: ContainerFilterTypeImage, Value: "agent("}},
+					},
+					Logs: &ContainerFilterConfig{
+						Exclude: []ContainerFilter{
+							{Type: ContainerFilterTypeName},
+							{Type: ContainerFilterTypeName, Value: "foo bar"},
+						},
+					},
+				},
+			},
+			wantFields: []string{
+				"spec.global.containerFilters.all.exclude[1].type",
+				"spec.global.containerFilters.metrics.include[0].value",
+				"spec.global.containerFilters.logs.exclude[0].value",
+				"spec.global.containerFilters.logs.exclude[1].value",
+			},
+		},
+		{
+			name: "APM instrumentation",
+			features: &DatadogFeatures{
+				APM: &APMFeatureConfig{
+					Enabled: apiutils.NewBoolPointer(true),
+					Instrumentation: &APMInstrumentationConfig{
+						Enabled:            apiutils.NewBoolPointer(true),
+						EnabledNamespaces:  []string{"payments"},
+						DisabledNamespaces: []string{"kube-system"},
+						LibVersions: map[string]string{
+							"java":   "v1",
+							"golang": "v1",
+							"python": "",
+						},
+					},
+				},
+			},
+			wantFields: []string{
+				"spec.features.apm.instrumentation.disabledNamespaces",
+				"spec.features.apm.instrumentation.libVersions[golang]",
+				"spec.features.apm.instrumentation.libVersions[python]",
+			},
+		},
+		{
+			name: "APM instrumentation without APM and the admission controller",
+			features: &DatadogFeatures{
+				APM: &APMFeatureConfig{
+					Instrumentation: &APMInstrumentationConfig{Enabled: apiutils.NewBoolPointer(true)},
+				},
+				AdmissionController: &AdmissionControllerFeatureConfig{Enabled: apiutils.NewBoolPointer(false)},
+			},
+			wantFields: []string{
+				"spec.features.apm.instrumentation.enabled",
+				"spec.features.apm.instrumentation.enabled",
+			},
+		},
+		{
+			name: "APM and DogStatsD host ports conflict",
+			features: &DatadogFeatures{
+				APM: &APMFeatureConfig{
+					Enabled:        apiutils.NewBoolPointer(true),
+					HostPortConfig: &HostPortConfig{Enabled: apiutils.NewBoolPointer(true), Port: apiutils.NewInt32Pointer(8125)},
+				},
+				Dogstatsd: &DogstatsdFeatureConfig{
+					HostPortConfig: &HostPortConfig{Enabled: apiutils.NewBoolPointer(true)},
+				},
+			},
+			wantFields: []string{"spec.features.apm.hostPortConfig.hostPort"},
+		},
+		{
+			name: "host port of a disabled feature is ignored",
+			features: &DatadogFeatures{
+				APM: &APMFeatureConfig{
+					Enabled:        apiutils.NewBoolPointer(false),
+					HostPortConfig: &HostPortConfig{Enabled: apiutils.NewBoolPointer(true), Port: apiutils.NewInt32Pointer(8125)},
+				},
+				Dogstatsd: &DogstatsdFeatureConfig{
+					HostPortConfig: &HostPortConfig{Enabled: apiutils.NewBoolPointer(true)},
+				},
+			},
+		},
+		{
+			name: "invalid host port",
+			features: &DatadogFeatures{
+				Dogstatsd: &DogstatsdFeatureConfig{
+					HostPortConfig: &HostPortConfig{Enabled: apiutils.NewBoolPointer(true), Port: apiutils.NewInt32Pointer(70000)},
+				},
+			},
+			wantFields: []string{"spec.features.dogstatsd.hostPortConfig.hostPort"},
+		},
+		{
+			name: "APM and DogStatsD sockets conflict",
+			features: &DatadogFeatures{
+				APM: &APMFeatureConfig{
+					Enabled:                apiutils.NewBoolPointer(true),
+					UnixDomainSocketConfig: &UnixDomainSocketConfig{Path: apiutils.NewStringPointer("/var/run/datadog/datadog.socket")},
+				},
+				Dogstatsd: &DogstatsdFeatureConfig{
+					UnixDomainSocketConfig: &UnixDomainSocketConfig{Path: apiutils.NewStringPointer("/var/run/datadog/datadog.socket")},
+				},
+			},
+			wantFields: []string{"spec.features.apm.unixDomainSocketConfig.path"},
+		},
+		{
+			name: "admission controller in socket mode without socket",
+			features: &DatadogFeatures{
+				Dogstatsd: &DogstatsdFeatureConfig{
+					UnixDomainSocketConfig: &UnixDomainSocketConfig{Enabled: apiutils.NewBoolPointer(false)},
+				},
+				AdmissionController: &AdmissionControllerFeatureConfig{
+					Enabled:                apiutils.NewBoolPointer(true),
+					AgentCommunicationMode: apiutils.NewStringPointer("socket"),
+				},
+			},
+			wantFields: []string{"spec.features.admissionController.agentCommunicationMode"},
+		},
+		{
+			name: "admission controller in socket mode with DogStatsD socket",
+			features: &DatadogFeatures{
+				AdmissionController: &AdmissionControllerFeatureConfig{
+					Enabled:                apiutils.NewBoolPointer(true),
+					AgentCommunicationMode: apiutils.NewStringPointer("socket"),
+				},
+			},
+		},
+		{
+			name: "unknown admission controller mode",
+			features: &DatadogFeatures{
+				AdmissionController: &AdmissionControllerFeatureConfig{
+					Enabled:                apiutils.NewBoolPointer(true),
+					AgentCommunicationMode: apiutils.NewStringPointer("udp"),
+				},
+			},
+			wantFields: []string{"spec.features.admissionController.agentCommunicationMode"},
+		},
+		{
+			name: "valid overrides",
+			override: map[ComponentName]*DatadogAgentComponentOverride{
+				NodeAgentComponentName: {
+					Containers: map[commonv1.AgentContainerName]*DatadogAgentGenericContainer{
+						commonv1.CoreAgentContainerName:  {},
+						commonv1.TraceAgentContainerName: {},
+					},
+				},
+				ClusterAgentComponentName: {
+					Containers: map[commonv1.AgentContainerName]*DatadogAgentGenericContainer{
+						commonv1.ClusterAgentContainerName: {},
+					},
+					PodDisruptionBudget: &PodDisruptionBudgetConfig{MinAvailable: &minAvailable},
+				},
+			},
+		},
+		{
+			name: "valid autoscaling",
+			override: map[ComponentName]*DatadogAgentComponentOverride{
+				ClusterChecksRunnerComponentName: {
+					Autoscaling: &AutoscalingConfig{
+						MinReplicas:                    apiutils.NewInt32Pointer(2),
+						MaxReplicas:                    10,
+						TargetCPUUtilizationPercentage: apiutils.NewInt32Pointer(70),
+					},
+				},
+			},
+		},
+		{
+			name: "invalid autoscaling",
+			override: map[ComponentName]*DatadogAgentComponentOverride{
+				NodeAgentComponentName: {
+					Autoscaling: &AutoscalingConfig{MaxReplicas: 2},
+				},
+				ClusterAgentComponentName: {
+					Replicas:    apiutils.NewInt32Pointer(2),
+					Autoscaling: &AutoscalingConfig{MinReplicas: apiutils.NewInt32Pointer(3), MaxReplicas: 2},
+				},
+				ClusterChecksRunnerComponentName: {
+					Autoscaling: &AutoscalingConfig{},
+				},
+			},
+			wantFields: []string{
+				"spec.override[clusterAgent].replicas",
+				"spec.override[clusterAgent].autoscaling.minReplicas",
+				"spec.override[clusterChecksRunner].autoscaling.maxReplicas",
+				"spec.override[nodeAgent].autoscaling",
+			},
+		},
+		{
+			name: "valid update strategy",
+			override: map[ComponentName]*DatadogAgentComponentOverride{
+				NodeAgentComponentName: {
+					UpdateStrategy: &UpdateStrategy{
+						Type:          appsv1.RollingUpdateDaemonSetStrategyType,
+						RollingUpdate: &RollingUpdate{MaxUnavailable: &maxUnavailable},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid update strategies",
+			override: map[ComponentName]*DatadogAgentComponentOverride{
+				NodeAgentComponentName: {
+					UpdateStrategy: &UpdateStrategy{
+						Type:          appsv1.OnDeleteDaemonSetStrategyType,
+						RollingUpdate: &RollingUpdate{MaxUnavailable: &maxUnavailable},
+					},
+				},
+				ClusterAgentComponentName: {
+					UpdateStrategy: &UpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType},
+				},
+			},
+			profiles: []DatadogAgentProfile{
+				{
+					Name:         "gpu",
+					NodeSelector: map[string]string{"pool": "gpu"},
+					Override: &DatadogAgentComponentOverride{
+						UpdateStrategy: &UpdateStrategy{Type: "Recreate"},
+					},
+				},
+			},
+			wantFields: []string{
+				"spec.override[clusterAgent].updateStrategy",
+				"spec.override[nodeAgent].updateStrategy.rollingUpdate",
+				"spec.profiles[0].override.updateStrategy.type",
+			},
+		},
+		{
+			name: "invalid overrides",
+			override: map[ComponentName]*DatadogAgentComponentOverride{
+				"clusterAgents": {},
+				NodeAgentComponentName: {
+					Containers: map[commonv1.AgentContainerName]*DatadogAgentGenericContainer{
+						commonv1.ClusterAgentContainerName: {},
+					},
+					CustomConfigurations: map[AgentConfigFileName]CustomConfig{
+						AgentGeneralConfigFile: {
+							ConfigData: apiutils.NewStringPointer("foo: bar"),
+							ConfigMap:  &commonv1.ConfigMapConfig{Name: "foo"},
+						},
+					},
+					PodDisruptionBudget: &PodDisruptionBudgetConfig{MinAvailable: &minAvailable},
+				},
+				ClusterChecksRunnerComponentName: {
+					ExtraConfd: &MultiCustomConfig{
+						ConfigDataMap: map[string]string{"foo.yaml": "foo: bar"},
+						ConfigMap:     &commonv1.ConfigMapConfig{Name: "foo"},
+					},
+					PodDisruptionBudget: &PodDisruptionBudgetConfig{MinAvailable: &minAvailable, MaxUnavailable: &maxUnavailable},
+				},
+			},
+			wantFields: []string{
+				"spec.override[clusterAgents]",
+				"spec.override[clusterChecksRunner].extraConfd",
+				"spec.override[clusterChecksRunner].podDisruptionBudget.maxUnavailable",
+				"spec.override[nodeAgent].containers[cluster-agent]",
+				"spec.override[nodeAgent].customConfigurations[datadog.yaml]",
+				"spec.override[nodeAgent].podDisruptionBudget",
+			},
+		},
+		{
+			name: "valid profiles",
+			profiles: []DatadogAgentProfile{
+				{
+					Name:         "gpu",
+					NodeSelector: map[string]string{"pool": "gpu"},
+					Override: &DatadogAgentComponentOverride{
+						Containers: map[commonv1.AgentContainerName]*DatadogAgentGenericContainer{
+							commonv1.CoreAgentContainerName: {},
+						},
+					},
+				},
+				{
+					Name: "arm",
+					NodeSelectorRequirements: []corev1.NodeSelectorRequirement{
+						{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+						{Key: "pool", Operator: corev1.NodeSelectorOpDoesNotExist},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid profiles",
+			profiles: []DatadogAgentProfile{
+				{
+					Name:         "GPU",
+					NodeSelector: map[string]string{"pool": "gpu"},
+				},
+				{
+					Name: "arm",
+					NodeSelectorRequirements: []corev1.NodeSelectorRequirement{
+						{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn},
+						{Key: "cpu", Operator: corev1.NodeSelectorOpGt, Values: []string{"8"}},
+					},
+				},
+				{
+					Name: "arm",
+					Override: &DatadogAgentComponentOverride{
+						Containers: map[commonv1.AgentContainerName]*DatadogAgentGenericContainer{
+							commonv1.ClusterAgentContainerName: {},
+						},
+					},
+				},
+			},
+			wantFields: []string{
+				"spec.profiles[0].name",
+				"spec.profiles[1].nodeSelectorRequirements[0].values",
+				"spec.profiles[1].nodeSelectorRequirements[1].operator",
+				"spec.profiles[2].name",
+				"spec.profiles[2]",
+				"spec.profiles[2].override.containers[cluster-agent]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dda := &DatadogAgent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "bar",
+				},
+				Spec: DatadogAgentSpec{
+					Global: &GlobalConfig{
+						Credentials: &DatadogCredentials{
+							APIKey: apiutils.NewStringPointer("0000000000000000000000"),
+							AppKey: apiutils.NewStringPointer("0000000000000000000000"),
+						},
+					},
+					Features: tt.features,
+					Override: tt.override,
+					Profiles: tt.profiles,
+				},
+			}
+			if tt.global != nil {
+				dda.Spec.Global = tt.global
+			}
+
+			errs := dda.validate()
+			fields := []string{}
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			if len(tt.wantFields) == 0 {
+				assert.Empty(t, fields)
+				assert.NoError(t, dda.Validate())
+			} else {
+				assert.Equal(t, tt.wantFields, fields)
+				assert.Error(t, dda.Validate())
+			}
+		})
+	}
+}
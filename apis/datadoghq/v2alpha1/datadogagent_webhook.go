@@ -6,12 +6,53 @@
 package v2alpha1
 
 import (
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 )
 
-// SetupWebhookWithManager starts the conversion webhook
+// SetupWebhookWithManager starts the conversion and validating webhooks
 func (r *DatadogAgent) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
 		Complete()
 }
+
+// +kubebuilder:webhook:path=/validate-datadoghq-com-v2alpha1-datadogagent,mutating=false,failurePolicy=fail,sideEffects=None,groups=datadoghq.com,resources=datadogagents,verbs=create;update,versions=v2alpha1,name=vdatadogagent.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &DatadogAgent{}
+
+// ValidateCreate implements webhook.Validator
+func (r *DatadogAgent) ValidateCreate() error {
+	return r.validateWebhook()
+}
+
+// ValidateUpdate implements webhook.Validator
+// Only the spec changes are validated, so that an existing DatadogAgent can always be finalized and deleted.
+func (r *DatadogAgent) ValidateUpdate(old runtime.Object) error {
+	if r.DeletionTimestamp != nil {
+		return nil
+	}
+	if oldDDA, ok := old.(*DatadogAgent); ok && apiequality.Semantic.DeepEqual(oldDDA.Spec, r.Spec) {
+		return nil
+	}
+
+	return r.validateWebhook()
+}
+
+// ValidateDelete implements webhook.Validator
+func (r *DatadogAgent) ValidateDelete() error {
+	return nil
+}
+
+func (r *DatadogAgent) validateWebhook() error {
+	errs := r.validate()
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "DatadogAgent"}, r.Name, errs)
+}
@@ -0,0 +1,76 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package v2alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+)
+
+func TestDatadogAgentValidateUpdate(t *testing.T) {
+	// Missing credentials
+	invalid := func() *DatadogAgent {
+		return &DatadogAgent{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"},
+			Spec:       DatadogAgentSpec{Global: &GlobalConfig{}},
+		}
+	}
+	now := metav1.Now()
+
+	tests := []struct {
+		name    string
+		old     *DatadogAgent
+		new     func() *DatadogAgent
+		wantErr bool
+	}{
+		{
+			name: "spec changed",
+			old:  invalid(),
+			new: func() *DatadogAgent {
+				dda := invalid()
+				dda.Spec.Global.ClusterName = apiutils.NewStringPointer("cluster")
+				return dda
+			},
+			wantErr: true,
+		},
+		{
+			name: "spec unchanged, finalizer removed",
+			old: func() *DatadogAgent {
+				dda := invalid()
+				dda.Finalizers = []string{"finalizer.agent.datadoghq.com"}
+				return dda
+			}(),
+			new:     invalid,
+			wantErr: false,
+		},
+		{
+			name: "spec changed while deleting",
+			old:  invalid(),
+			new: func() *DatadogAgent {
+				dda := invalid()
+				dda.DeletionTimestamp = &now
+				dda.Spec.Global.ClusterName = apiutils.NewStringPointer("cluster")
+				return dda
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.new().ValidateUpdate(tt.old)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
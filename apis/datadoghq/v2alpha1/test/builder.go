@@ -51,6 +51,11 @@ func (builder *DatadogAgentBuilder) WithName(name string) *DatadogAgentBuilder {
 	return builder
 }
 
+func (builder *DatadogAgentBuilder) WithAdditionalEndpoints(endpoints ...v2alpha1.AdditionalEndpoint) *DatadogAgentBuilder {
+	builder.datadogAgent.Spec.Global.AdditionalEndpoints = endpoints
+	return builder
+}
+
 // Dogstatsd
 func (builder *DatadogAgentBuilder) initDogstatsd() {
 	if builder.datadogAgent.Spec.Features.Dogstatsd == nil {
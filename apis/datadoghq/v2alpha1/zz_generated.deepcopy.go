@@ -12,10 +12,13 @@ package v2alpha1
 
 import (
 	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	edsv1alpha1 "github.com/DataDog/extendeddaemonset/api/v1alpha1"
 	securityv1 "github.com/openshift/api/security/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -36,6 +39,11 @@ func (in *APMFeatureConfig) DeepCopyInto(out *APMFeatureConfig) {
 		*out = new(UnixDomainSocketConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Instrumentation != nil {
+		in, out := &in.Instrumentation, &out.Instrumentation
+		*out = new(APMInstrumentationConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APMFeatureConfig.
@@ -48,6 +56,64 @@ func (in *APMFeatureConfig) DeepCopy() *APMFeatureConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APMInstrumentationConfig) DeepCopyInto(out *APMInstrumentationConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnabledNamespaces != nil {
+		in, out := &in.EnabledNamespaces, &out.EnabledNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisabledNamespaces != nil {
+		in, out := &in.DisabledNamespaces, &out.DisabledNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LibVersions != nil {
+		in, out := &in.LibVersions, &out.LibVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APMInstrumentationConfig.
+func (in *APMInstrumentationConfig) DeepCopy() *APMInstrumentationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APMInstrumentationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalEndpoint) DeepCopyInto(out *AdditionalEndpoint) {
+	*out = *in
+	out.APISecret = in.APISecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalEndpoint.
+func (in *AdditionalEndpoint) DeepCopy() *AdditionalEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdmissionControllerFeatureConfig) DeepCopyInto(out *AdmissionControllerFeatureConfig) {
 	*out = *in
@@ -93,6 +159,38 @@ func (in *AdmissionControllerFeatureConfig) DeepCopy() *AdmissionControllerFeatu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AutoscalingConfig) DeepCopyInto(out *AutoscalingConfig) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetCPUUtilizationPercentage != nil {
+		in, out := &in.TargetCPUUtilizationPercentage, &out.TargetCPUUtilizationPercentage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]autoscalingv2.MetricSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AutoscalingConfig.
+func (in *AutoscalingConfig) DeepCopy() *AutoscalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AutoscalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSPMFeatureConfig) DeepCopyInto(out *CSPMFeatureConfig) {
 	*out = *in
@@ -278,6 +376,141 @@ func (in *ClusterChecksFeatureConfig) DeepCopy() *ClusterChecksFeatureConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerFilter) DeepCopyInto(out *ContainerFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerFilter.
+func (in *ContainerFilter) DeepCopy() *ContainerFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerFilterConfig) DeepCopyInto(out *ContainerFilterConfig) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]ContainerFilter, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]ContainerFilter, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerFilterConfig.
+func (in *ContainerFilterConfig) DeepCopy() *ContainerFilterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerFilterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerFiltersConfig) DeepCopyInto(out *ContainerFiltersConfig) {
+	*out = *in
+	if in.All != nil {
+		in, out := &in.All, &out.All
+		*out = new(ContainerFilterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(ContainerFilterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = new(ContainerFilterConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerFiltersConfig.
+func (in *ContainerFiltersConfig) DeepCopy() *ContainerFiltersConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerFiltersConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneComponentConfig) DeepCopyInto(out *ControlPlaneComponentConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneComponentConfig.
+func (in *ControlPlaneComponentConfig) DeepCopy() *ControlPlaneComponentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneComponentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneMonitoringFeatureConfig) DeepCopyInto(out *ControlPlaneMonitoringFeatureConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(ControlPlaneProvider)
+		**out = **in
+	}
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(ControlPlaneComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(EtcdMonitoringConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(ControlPlaneComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControllerManager != nil {
+		in, out := &in.ControllerManager, &out.ControllerManager
+		*out = new(ControlPlaneComponentConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneMonitoringFeatureConfig.
+func (in *ControlPlaneMonitoringFeatureConfig) DeepCopy() *ControlPlaneMonitoringFeatureConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneMonitoringFeatureConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomConfig) DeepCopyInto(out *CustomConfig) {
 	*out = *in
@@ -467,6 +700,21 @@ func (in *DatadogAgentComponentOverride) DeepCopyInto(out *DatadogAgentComponent
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(PodDisruptionBudgetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(AutoscalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(UpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAgentComponentOverride.
@@ -595,6 +843,60 @@ func (in *DatadogAgentList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAgentProfile) DeepCopyInto(out *DatadogAgentProfile) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeSelectorRequirements != nil {
+		in, out := &in.NodeSelectorRequirements, &out.NodeSelectorRequirements
+		*out = make([]corev1.NodeSelectorRequirement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Override != nil {
+		in, out := &in.Override, &out.Override
+		*out = new(DatadogAgentComponentOverride)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAgentProfile.
+func (in *DatadogAgentProfile) DeepCopy() *DatadogAgentProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAgentProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAgentProfileStatus) DeepCopyInto(out *DatadogAgentProfileStatus) {
+	*out = *in
+	if in.Agent != nil {
+		in, out := &in.Agent, &out.Agent
+		*out = new(commonv1.DaemonSetStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAgentProfileStatus.
+func (in *DatadogAgentProfileStatus) DeepCopy() *DatadogAgentProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAgentProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatadogAgentSpec) DeepCopyInto(out *DatadogAgentSpec) {
 	*out = *in
@@ -623,6 +925,13 @@ func (in *DatadogAgentSpec) DeepCopyInto(out *DatadogAgentSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]DatadogAgentProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAgentSpec.
@@ -660,6 +969,20 @@ func (in *DatadogAgentStatus) DeepCopyInto(out *DatadogAgentStatus) {
 		*out = new(commonv1.DeploymentStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]DatadogAgentProfileStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]FeatureStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAgentStatus.
@@ -825,6 +1148,11 @@ func (in *DatadogFeatures) DeepCopyInto(out *DatadogFeatures) {
 		*out = new(PrometheusScrapeFeatureConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ControlPlaneMonitoring != nil {
+		in, out := &in.ControlPlaneMonitoring, &out.ControlPlaneMonitoring
+		*out = new(ControlPlaneMonitoringFeatureConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogFeatures.
@@ -922,6 +1250,31 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdMonitoringConfig) DeepCopyInto(out *EtcdMonitoringConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CertificatesSecretName != nil {
+		in, out := &in.CertificatesSecretName, &out.CertificatesSecretName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMonitoringConfig.
+func (in *EtcdMonitoringConfig) DeepCopy() *EtcdMonitoringConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdMonitoringConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventCollectionFeatureConfig) DeepCopyInto(out *EventCollectionFeatureConfig) {
 	*out = *in
@@ -987,6 +1340,33 @@ func (in *ExternalMetricsServerFeatureConfig) DeepCopy() *ExternalMetricsServerF
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureStatus) DeepCopyInto(out *FeatureStatus) {
+	*out = *in
+	if in.RequiredComponents != nil {
+		in, out := &in.RequiredComponents, &out.RequiredComponents
+		*out = make([]ComponentName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureStatus.
+func (in *FeatureStatus) DeepCopy() *FeatureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalConfig) DeepCopyInto(out *GlobalConfig) {
 	*out = *in
@@ -1020,6 +1400,11 @@ func (in *GlobalConfig) DeepCopyInto(out *GlobalConfig) {
 		*out = new(Endpoint)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalEndpoints != nil {
+		in, out := &in.AdditionalEndpoints, &out.AdditionalEndpoints
+		*out = make([]AdditionalEndpoint, len(*in))
+		copy(*out, *in)
+	}
 	if in.Registry != nil {
 		in, out := &in.Registry, &out.Registry
 		*out = new(string)
@@ -1088,6 +1473,16 @@ func (in *GlobalConfig) DeepCopyInto(out *GlobalConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SecretBackend != nil {
+		in, out := &in.SecretBackend, &out.SecretBackend
+		*out = new(SecretBackendConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerFilters != nil {
+		in, out := &in.ContainerFilters, &out.ContainerFilters
+		*out = new(ContainerFiltersConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalConfig.
@@ -1125,6 +1520,133 @@ func (in *HostPortConfig) DeepCopy() *HostPortConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeStateMetricsCoreCollectorsConfig) DeepCopyInto(out *KubeStateMetricsCoreCollectorsConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeStateMetricsCoreCollectorsConfig.
+func (in *KubeStateMetricsCoreCollectorsConfig) DeepCopy() *KubeStateMetricsCoreCollectorsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeStateMetricsCoreCollectorsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeStateMetricsCoreCustomResource) DeepCopyInto(out *KubeStateMetricsCoreCustomResource) {
+	*out = *in
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricNamePrefix != nil {
+		in, out := &in.MetricNamePrefix, &out.MetricNamePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.LabelsFromPath != nil {
+		in, out := &in.LabelsFromPath, &out.LabelsFromPath
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]KubeStateMetricsCoreCustomResourceMetric, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeStateMetricsCoreCustomResource.
+func (in *KubeStateMetricsCoreCustomResource) DeepCopy() *KubeStateMetricsCoreCustomResource {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeStateMetricsCoreCustomResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeStateMetricsCoreCustomResourceMetric) DeepCopyInto(out *KubeStateMetricsCoreCustomResourceMetric) {
+	*out = *in
+	if in.Help != nil {
+		in, out := &in.Help, &out.Help
+		*out = new(string)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ValueFrom != nil {
+		in, out := &in.ValueFrom, &out.ValueFrom
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.LabelsFromPath != nil {
+		in, out := &in.LabelsFromPath, &out.LabelsFromPath
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.LabelName != nil {
+		in, out := &in.LabelName, &out.LabelName
+		*out = new(string)
+		**out = **in
+	}
+	if in.List != nil {
+		in, out := &in.List, &out.List
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeStateMetricsCoreCustomResourceMetric.
+func (in *KubeStateMetricsCoreCustomResourceMetric) DeepCopy() *KubeStateMetricsCoreCustomResourceMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeStateMetricsCoreCustomResourceMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeStateMetricsCoreFeatureConfig) DeepCopyInto(out *KubeStateMetricsCoreFeatureConfig) {
 	*out = *in
@@ -1138,6 +1660,62 @@ func (in *KubeStateMetricsCoreFeatureConfig) DeepCopyInto(out *KubeStateMetricsC
 		*out = new(CustomConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Collectors != nil {
+		in, out := &in.Collectors, &out.Collectors
+		*out = new(KubeStateMetricsCoreCollectorsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LabelsAsTags != nil {
+		in, out := &in.LabelsAsTags, &out.LabelsAsTags
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.AnnotationsAsTags != nil {
+		in, out := &in.AnnotationsAsTags, &out.AnnotationsAsTags
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespacesExcluded != nil {
+		in, out := &in.NamespacesExcluded, &out.NamespacesExcluded
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomResources != nil {
+		in, out := &in.CustomResources, &out.CustomResources
+		*out = make([]KubeStateMetricsCoreCustomResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeStateMetricsCoreFeatureConfig.
@@ -1388,6 +1966,21 @@ func (in *OOMKillFeatureConfig) DeepCopy() *OOMKillFeatureConfig {
 func (in *OTLPFeatureConfig) DeepCopyInto(out *OTLPFeatureConfig) {
 	*out = *in
 	in.Receiver.DeepCopyInto(&out.Receiver)
+	if in.Logs != nil {
+		in, out := &in.Logs, &out.Logs
+		*out = new(OTLPLogsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(OTLPMetricsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Traces != nil {
+		in, out := &in.Traces, &out.Traces
+		*out = new(OTLPTracesConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPFeatureConfig.
@@ -1450,6 +2043,96 @@ func (in *OTLPHTTPConfig) DeepCopy() *OTLPHTTPConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPHistogramsConfig) DeepCopyInto(out *OTLPHistogramsConfig) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+	if in.SendAggregationMetrics != nil {
+		in, out := &in.SendAggregationMetrics, &out.SendAggregationMetrics
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPHistogramsConfig.
+func (in *OTLPHistogramsConfig) DeepCopy() *OTLPHistogramsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPHistogramsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPLogsConfig) DeepCopyInto(out *OTLPLogsConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPLogsConfig.
+func (in *OTLPLogsConfig) DeepCopy() *OTLPLogsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPLogsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPMetricsConfig) DeepCopyInto(out *OTLPMetricsConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ResourceAttributesAsTags != nil {
+		in, out := &in.ResourceAttributesAsTags, &out.ResourceAttributesAsTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstrumentationScopeMetadataAsTags != nil {
+		in, out := &in.InstrumentationScopeMetadataAsTags, &out.InstrumentationScopeMetadataAsTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TagCardinality != nil {
+		in, out := &in.TagCardinality, &out.TagCardinality
+		*out = new(string)
+		**out = **in
+	}
+	if in.Histograms != nil {
+		in, out := &in.Histograms, &out.Histograms
+		*out = new(OTLPHistogramsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Summaries != nil {
+		in, out := &in.Summaries, &out.Summaries
+		*out = new(OTLPSummariesConfig)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPMetricsConfig.
+func (in *OTLPMetricsConfig) DeepCopy() *OTLPMetricsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPMetricsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTLPProtocolsConfig) DeepCopyInto(out *OTLPProtocolsConfig) {
 	*out = *in
@@ -1491,6 +2174,46 @@ func (in *OTLPReceiverConfig) DeepCopy() *OTLPReceiverConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPSummariesConfig) DeepCopyInto(out *OTLPSummariesConfig) {
+	*out = *in
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPSummariesConfig.
+func (in *OTLPSummariesConfig) DeepCopy() *OTLPSummariesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPSummariesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OTLPTracesConfig) DeepCopyInto(out *OTLPTracesConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OTLPTracesConfig.
+func (in *OTLPTracesConfig) DeepCopy() *OTLPTracesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OTLPTracesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OrchestratorExplorerFeatureConfig) DeepCopyInto(out *OrchestratorExplorerFeatureConfig) {
 	*out = *in
@@ -1536,6 +2259,36 @@ func (in *OrchestratorExplorerFeatureConfig) DeepCopy() *OrchestratorExplorerFea
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodDisruptionBudgetConfig) DeepCopyInto(out *PodDisruptionBudgetConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodDisruptionBudgetConfig.
+func (in *PodDisruptionBudgetConfig) DeepCopy() *PodDisruptionBudgetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PodDisruptionBudgetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProcessDiscoveryFeatureConfig) DeepCopyInto(out *ProcessDiscoveryFeatureConfig) {
 	*out = *in
@@ -1611,6 +2364,31 @@ func (in *RemoteConfigurationFeatureConfig) DeepCopy() *RemoteConfigurationFeatu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollingUpdate) DeepCopyInto(out *RollingUpdate) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdate.
+func (in *RollingUpdate) DeepCopy() *RollingUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(RollingUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SBOMFeatureConfig) DeepCopyInto(out *SBOMFeatureConfig) {
 	*out = *in
@@ -1704,6 +2482,23 @@ func (in *SecretBackendConfig) DeepCopyInto(out *SecretBackendConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EnableGlobalPermissions != nil {
+		in, out := &in.EnableGlobalPermissions, &out.EnableGlobalPermissions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]SecretBackendRolesConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBackendConfig.
@@ -1716,6 +2511,26 @@ func (in *SecretBackendConfig) DeepCopy() *SecretBackendConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretBackendRolesConfig) DeepCopyInto(out *SecretBackendRolesConfig) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBackendRolesConfig.
+func (in *SecretBackendRolesConfig) DeepCopy() *SecretBackendRolesConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretBackendRolesConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityContextConstraintsConfig) DeepCopyInto(out *SecurityContextConstraintsConfig) {
 	*out = *in
@@ -1805,3 +2620,28 @@ func (in *UnixDomainSocketConfig) DeepCopy() *UnixDomainSocketConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateStrategy) DeepCopyInto(out *UpdateStrategy) {
+	*out = *in
+	if in.RollingUpdate != nil {
+		in, out := &in.RollingUpdate, &out.RollingUpdate
+		*out = new(RollingUpdate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateStrategy.
+func (in *UpdateStrategy) DeepCopy() *UpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
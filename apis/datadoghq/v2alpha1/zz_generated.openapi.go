@@ -19,596 +19,1506 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"./apis/datadoghq/v2alpha1.CSPMHostBenchmarksConfig":          schema__apis_datadoghq_v2alpha1_CSPMHostBenchmarksConfig(ref),
-		"./apis/datadoghq/v2alpha1.CustomConfig":                      schema__apis_datadoghq_v2alpha1_CustomConfig(ref),
-		"./apis/datadoghq/v2alpha1.DatadogAgent":                      schema__apis_datadoghq_v2alpha1_DatadogAgent(ref),
-		"./apis/datadoghq/v2alpha1.DatadogAgentGenericContainer":      schema__apis_datadoghq_v2alpha1_DatadogAgentGenericContainer(ref),
-		"./apis/datadoghq/v2alpha1.DatadogAgentStatus":                schema__apis_datadoghq_v2alpha1_DatadogAgentStatus(ref),
-		"./apis/datadoghq/v2alpha1.DatadogCredentials":                schema__apis_datadoghq_v2alpha1_DatadogCredentials(ref),
-		"./apis/datadoghq/v2alpha1.DatadogFeatures":                   schema__apis_datadoghq_v2alpha1_DatadogFeatures(ref),
-		"./apis/datadoghq/v2alpha1.DogstatsdFeatureConfig":            schema__apis_datadoghq_v2alpha1_DogstatsdFeatureConfig(ref),
-		"./apis/datadoghq/v2alpha1.EventCollectionFeatureConfig":      schema__apis_datadoghq_v2alpha1_EventCollectionFeatureConfig(ref),
-		"./apis/datadoghq/v2alpha1.KubeStateMetricsCoreFeatureConfig": schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreFeatureConfig(ref),
-		"./apis/datadoghq/v2alpha1.LocalService":                      schema__apis_datadoghq_v2alpha1_LocalService(ref),
-		"./apis/datadoghq/v2alpha1.MultiCustomConfig":                 schema__apis_datadoghq_v2alpha1_MultiCustomConfig(ref),
-		"./apis/datadoghq/v2alpha1.NetworkPolicyConfig":               schema__apis_datadoghq_v2alpha1_NetworkPolicyConfig(ref),
-		"./apis/datadoghq/v2alpha1.OTLPFeatureConfig":                 schema__apis_datadoghq_v2alpha1_OTLPFeatureConfig(ref),
-		"./apis/datadoghq/v2alpha1.OTLPGRPCConfig":                    schema__apis_datadoghq_v2alpha1_OTLPGRPCConfig(ref),
-		"./apis/datadoghq/v2alpha1.OTLPHTTPConfig":                    schema__apis_datadoghq_v2alpha1_OTLPHTTPConfig(ref),
-		"./apis/datadoghq/v2alpha1.OTLPProtocolsConfig":               schema__apis_datadoghq_v2alpha1_OTLPProtocolsConfig(ref),
-		"./apis/datadoghq/v2alpha1.OTLPReceiverConfig":                schema__apis_datadoghq_v2alpha1_OTLPReceiverConfig(ref),
-		"./apis/datadoghq/v2alpha1.OrchestratorExplorerFeatureConfig": schema__apis_datadoghq_v2alpha1_OrchestratorExplorerFeatureConfig(ref),
-		"./apis/datadoghq/v2alpha1.PrometheusScrapeFeatureConfig":     schema__apis_datadoghq_v2alpha1_PrometheusScrapeFeatureConfig(ref),
-		"./apis/datadoghq/v2alpha1.SeccompConfig":                     schema__apis_datadoghq_v2alpha1_SeccompConfig(ref),
-		"./apis/datadoghq/v2alpha1.SecurityContextConstraintsConfig":  schema__apis_datadoghq_v2alpha1_SecurityContextConstraintsConfig(ref),
-		"./apis/datadoghq/v2alpha1.UnixDomainSocketConfig":            schema__apis_datadoghq_v2alpha1_UnixDomainSocketConfig(ref),
+		"./apis/datadoghq/v2alpha1.APMInstrumentationConfig":                 schema__apis_datadoghq_v2alpha1_APMInstrumentationConfig(ref),
+		"./apis/datadoghq/v2alpha1.AdditionalEndpoint":                       schema__apis_datadoghq_v2alpha1_AdditionalEndpoint(ref),
+		"./apis/datadoghq/v2alpha1.AutoscalingConfig":                        schema__apis_datadoghq_v2alpha1_AutoscalingConfig(ref),
+		"./apis/datadoghq/v2alpha1.CSPMHostBenchmarksConfig":                 schema__apis_datadoghq_v2alpha1_CSPMHostBenchmarksConfig(ref),
+		"./apis/datadoghq/v2alpha1.ContainerFilter":                          schema__apis_datadoghq_v2alpha1_ContainerFilter(ref),
+		"./apis/datadoghq/v2alpha1.ContainerFilterConfig":                    schema__apis_datadoghq_v2alpha1_ContainerFilterConfig(ref),
+		"./apis/datadoghq/v2alpha1.ContainerFiltersConfig":                   schema__apis_datadoghq_v2alpha1_ContainerFiltersConfig(ref),
+		"./apis/datadoghq/v2alpha1.ControlPlaneComponentConfig":              schema__apis_datadoghq_v2alpha1_ControlPlaneComponentConfig(ref),
+		"./apis/datadoghq/v2alpha1.ControlPlaneMonitoringFeatureConfig":      schema__apis_datadoghq_v2alpha1_ControlPlaneMonitoringFeatureConfig(ref),
+		"./apis/datadoghq/v2alpha1.CustomConfig":                             schema__apis_datadoghq_v2alpha1_CustomConfig(ref),
+		"./apis/datadoghq/v2alpha1.DatadogAgent":                             schema__apis_datadoghq_v2alpha1_DatadogAgent(ref),
+		"./apis/datadoghq/v2alpha1.DatadogAgentGenericContainer":             schema__apis_datadoghq_v2alpha1_DatadogAgentGenericContainer(ref),
+		"./apis/datadoghq/v2alpha1.DatadogAgentProfile":                      schema__apis_datadoghq_v2alpha1_DatadogAgentProfile(ref),
+		"./apis/datadoghq/v2alpha1.DatadogAgentProfileStatus":                schema__apis_datadoghq_v2alpha1_DatadogAgentProfileStatus(ref),
+		"./apis/datadoghq/v2alpha1.DatadogAgentStatus":                       schema__apis_datadoghq_v2alpha1_DatadogAgentStatus(ref),
+		"./apis/datadoghq/v2alpha1.DatadogCredentials":                       schema__apis_datadoghq_v2alpha1_DatadogCredentials(ref),
+		"./apis/datadoghq/v2alpha1.DatadogFeatures":                          schema__apis_datadoghq_v2alpha1_DatadogFeatures(ref),
+		"./apis/datadoghq/v2alpha1.DogstatsdFeatureConfig":                   schema__apis_datadoghq_v2alpha1_DogstatsdFeatureConfig(ref),
+		"./apis/datadoghq/v2alpha1.EtcdMonitoringConfig":                     schema__apis_datadoghq_v2alpha1_EtcdMonitoringConfig(ref),
+		"./apis/datadoghq/v2alpha1.EventCollectionFeatureConfig":             schema__apis_datadoghq_v2alpha1_EventCollectionFeatureConfig(ref),
+		"./apis/datadoghq/v2alpha1.FeatureStatus":                            schema__apis_datadoghq_v2alpha1_FeatureStatus(ref),
+		"./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCollectorsConfig":     schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreCollectorsConfig(ref),
+		"./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCustomResource":       schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreCustomResource(ref),
+		"./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCustomResourceMetric": schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreCustomResourceMetric(ref),
+		"./apis/datadoghq/v2alpha1.KubeStateMetricsCoreFeatureConfig":        schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreFeatureConfig(ref),
+		"./apis/datadoghq/v2alpha1.LocalService":                             schema__apis_datadoghq_v2alpha1_LocalService(ref),
+		"./apis/datadoghq/v2alpha1.MultiCustomConfig":                        schema__apis_datadoghq_v2alpha1_MultiCustomConfig(ref),
+		"./apis/datadoghq/v2alpha1.NetworkPolicyConfig":                      schema__apis_datadoghq_v2alpha1_NetworkPolicyConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPFeatureConfig":                        schema__apis_datadoghq_v2alpha1_OTLPFeatureConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPGRPCConfig":                           schema__apis_datadoghq_v2alpha1_OTLPGRPCConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPHTTPConfig":                           schema__apis_datadoghq_v2alpha1_OTLPHTTPConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPHistogramsConfig":                     schema__apis_datadoghq_v2alpha1_OTLPHistogramsConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPLogsConfig":                           schema__apis_datadoghq_v2alpha1_OTLPLogsConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPMetricsConfig":                        schema__apis_datadoghq_v2alpha1_OTLPMetricsConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPProtocolsConfig":                      schema__apis_datadoghq_v2alpha1_OTLPProtocolsConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPReceiverConfig":                       schema__apis_datadoghq_v2alpha1_OTLPReceiverConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPSummariesConfig":                      schema__apis_datadoghq_v2alpha1_OTLPSummariesConfig(ref),
+		"./apis/datadoghq/v2alpha1.OTLPTracesConfig":                         schema__apis_datadoghq_v2alpha1_OTLPTracesConfig(ref),
+		"./apis/datadoghq/v2alpha1.OrchestratorExplorerFeatureConfig":        schema__apis_datadoghq_v2alpha1_OrchestratorExplorerFeatureConfig(ref),
+		"./apis/datadoghq/v2alpha1.PodDisruptionBudgetConfig":                schema__apis_datadoghq_v2alpha1_PodDisruptionBudgetConfig(ref),
+		"./apis/datadoghq/v2alpha1.PrometheusScrapeFeatureConfig":            schema__apis_datadoghq_v2alpha1_PrometheusScrapeFeatureConfig(ref),
+		"./apis/datadoghq/v2alpha1.RollingUpdate":                            schema__apis_datadoghq_v2alpha1_RollingUpdate(ref),
+		"./apis/datadoghq/v2alpha1.SeccompConfig":                            schema__apis_datadoghq_v2alpha1_SeccompConfig(ref),
+		"./apis/datadoghq/v2alpha1.SecretBackendConfig":                      schema__apis_datadoghq_v2alpha1_SecretBackendConfig(ref),
+		"./apis/datadoghq/v2alpha1.SecretBackendRolesConfig":                 schema__apis_datadoghq_v2alpha1_SecretBackendRolesConfig(ref),
+		"./apis/datadoghq/v2alpha1.SecurityContextConstraintsConfig":         schema__apis_datadoghq_v2alpha1_SecurityContextConstraintsConfig(ref),
+		"./apis/datadoghq/v2alpha1.UnixDomainSocketConfig":                   schema__apis_datadoghq_v2alpha1_UnixDomainSocketConfig(ref),
+		"./apis/datadoghq/v2alpha1.UpdateStrategy":                           schema__apis_datadoghq_v2alpha1_UpdateStrategy(ref),
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_CSPMHostBenchmarksConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_APMInstrumentationConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSPMHostBenchmarksConfig contains configuration for host benchmarks.",
+				Description: "APMInstrumentationConfig contains the configuration of the APM libraries injection.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled enables host benchmarks. Default: false",
+							Description: "Enabled enables the injection of the APM libraries in the pods of all the namespaces, except the disabled ones. Default: false",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema__apis_datadoghq_v2alpha1_CustomConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CustomConfig provides a place for custom configuration of the Agent or Cluster Agent, corresponding to datadog.yaml, system-probe.yaml, security-agent.yaml or datadog-cluster.yaml. The configuration can be provided in the ConfigData field as raw data, or referenced in a ConfigMap. Note: `ConfigData` and `ConfigMap` cannot be set together.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"configData": {
+					"enabledNamespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigData corresponds to the configuration file content.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "EnabledNamespaces restricts the injection to the pods of the listed namespaces. It cannot be set with `disabledNamespaces`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"configMap": {
+					"disabledNamespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMap references an existing ConfigMap with the configuration file content.",
-							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.ConfigMapConfig"),
+							Description: "DisabledNamespaces lists the namespaces whose pods are never instrumented. It cannot be set with `enabledNamespaces`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"libVersions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LibVersions sets the versions of the APM libraries to inject, by language. <LANGUAGE>: <VERSION>, where the language is one of java, js, python, dotnet and ruby. All the libraries are injected in their latest version when not set.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"registry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Registry is the image registry the APM libraries are pulled from. Default: the Cluster Agent default registry (gcr.io/datadoghq)",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.ConfigMapConfig"},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_DatadogAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_AdditionalEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogAgent Deployment with the Datadog Operator.",
+				Description: "AdditionalEndpoint defines an additional Datadog intake the Agent data are sent to.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Type is the type of data sent to the endpoint: `metrics`, `logs`, `apm` or `processes`.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "URL defines the endpoint URL, for example `https://app.datadoghq.eu`. For logs, it is the host and optional port of the logs intake, for example `agent-http-intake.logs.datadoghq.eu:443`.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v2alpha1.DatadogAgentSpec"),
-						},
-					},
-					"status": {
+					"apiSecret": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("./apis/datadoghq/v2alpha1.DatadogAgentStatus"),
+							Description: "APISecret references the secret containing the API key used to send data to the endpoint.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"),
 						},
 					},
 				},
+				Required: []string{"type", "url", "apiSecret"},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v2alpha1.DatadogAgentSpec", "./apis/datadoghq/v2alpha1.DatadogAgentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_DatadogAgentGenericContainer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_AutoscalingConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogAgentGenericContainer is the generic structure describing any container's common configuration.",
+				Description: "AutoscalingConfig provides HorizontalPodAutoscaler configurations for the Deployment components.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"minReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the container that is overridden",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MinReplicas is the lower limit for the number of replicas. Default: 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"logLevel": {
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LogLevel sets logging verbosity (overrides global setting). Valid log levels are: trace, debug, info, warn, error, critical, and off. Default: 'info'",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxReplicas is the upper limit for the number of replicas.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"env": {
+					"targetCPUUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetCPUUtilizationPercentage is the target average CPU utilization of the pods, represented as a percentage of the requested CPU. Default: 80 when no custom metric is set.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"metrics": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
+								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Specify additional environment variables in the container. See also: https://docs.datadoghq.com/agent/kubernetes/?tab=helm#environment-variables",
+							Description: "Metrics contains additional metrics used to compute the desired number of replicas, for instance the number of cluster checks scheduled per runner. See https://kubernetes.io/docs/tasks/run-application/horizontal-pod-autoscale/ for more details.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+										Ref:     ref("k8s.io/api/autoscaling/v2.MetricSpec"),
 									},
 								},
 							},
 						},
 					},
-					"volumeMounts": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-									"mountPath",
-								},
-								"x-kubernetes-list-type": "map",
-							},
+				},
+				Required: []string{"maxReplicas"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/autoscaling/v2.MetricSpec"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_CSPMHostBenchmarksConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSPMHostBenchmarksConfig contains configuration for host benchmarks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled enables host benchmarks. Default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_ContainerFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerFilter matches the containers whose attribute matches a regular expression.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specify additional volume mounts in the container.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
-									},
-								},
-							},
+							Description: "Type is the container attribute matched by the filter: name, image or kube_namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specify the Request and Limits of the pods To get guaranteed QoS class, specify requests and limits equal. See also: http://kubernetes.io/docs/user-guide/compute-resources/",
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "Value is the regular expression the attribute must match, for example `^datadog-agent$`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"command": {
+				},
+				Required: []string{"type", "value"},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_ContainerFilterConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerFilterConfig lists the containers to include and to exclude.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"include": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Command allows the specification of a custom entrypoint for container",
+							Description: "Include lists the containers to collect, even when they match an exclusion filter.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v2alpha1.ContainerFilter"),
 									},
 								},
 							},
 						},
 					},
-					"args": {
+					"exclude": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Args allows the specification of extra args to the `Command` parameter",
+							Description: "Exclude lists the containers not to collect.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v2alpha1.ContainerFilter"),
 									},
 								},
 							},
 						},
 					},
-					"healthPort": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HealthPort of the container for the internal liveness probe. Must be the same as the Liveness/Readiness probes.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"readinessProbe": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configure the Readiness Probe of the container",
-							Ref:         ref("k8s.io/api/core/v1.Probe"),
-						},
-					},
-					"livenessProbe": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configure the Liveness Probe of the container",
-							Ref:         ref("k8s.io/api/core/v1.Probe"),
-						},
-					},
-					"securityContext": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.ContainerFilter"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_ContainerFiltersConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerFiltersConfig provides the containers to include in or exclude from the data collection, per type of data. Inclusion takes precedence over exclusion: a container matching both an inclusion and an exclusion filter is collected.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"all": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Container-level SecurityContext.",
-							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
+							Description: "All filters the containers for all the types of data (metrics, logs, Live Containers and Autodiscovery).",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ContainerFilterConfig"),
 						},
 					},
-					"seccompConfig": {
+					"metrics": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Seccomp configurations to override Operator actions. For all other Seccomp Profile manipulation, use SecurityContext.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.SeccompConfig"),
+							Description: "Metrics filters the containers for the metrics only.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ContainerFilterConfig"),
 						},
 					},
-					"appArmorProfileName": {
+					"logs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppArmorProfileName specifies an apparmor profile.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Logs filters the containers for the logs only.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ContainerFilterConfig"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v2alpha1.SeccompConfig", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.VolumeMount"},
+			"./apis/datadoghq/v2alpha1.ContainerFilterConfig"},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_DatadogAgentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_ControlPlaneComponentConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogAgentStatus defines the observed state of DatadogAgent.",
+				Description: "ControlPlaneComponentConfig contains the configuration of the monitoring of a control plane component.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"type",
-								},
-								"x-kubernetes-list-type": "map",
-							},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled enables the monitoring of the component. It is ignored for the components that are not exposed by a managed control plane. Default: true",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_ControlPlaneMonitoringFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneMonitoringFeatureConfig contains the Control Plane Monitoring configuration. The checks of the control plane components are configured for the platform detected by the operator: OpenShift, static pods on the control plane nodes (kubeadm-based distributions), or a managed control plane where only the API server is monitored. The node Agent must run on the control plane nodes to monitor etcd, the scheduler and the controller manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions Represents the latest available observations of a DatadogAgent's current state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
-									},
-								},
-							},
+							Description: "Enabled enables the monitoring of the control plane components. Default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"agent": {
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The actual state of the Agent as an extended daemonset.",
-							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DaemonSetStatus"),
+							Description: "Provider overrides the kind of control plane detected by the operator: `default` for a control plane running as static pods on the control plane nodes, `openshift`, or `managed` for a control plane managed by a cloud provider. EKS, GKE, IKS and ACK control planes are detected as `managed`, AKS and other managed control planes must be configured.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterAgent": {
+					"apiServer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The actual state of the Cluster Agent as a deployment.",
-							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DeploymentStatus"),
+							Description: "APIServer contains the configuration of the monitoring of the API server. It requires the cluster checks: `features.clusterChecks.enabled`.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ControlPlaneComponentConfig"),
 						},
 					},
-					"clusterChecksRunner": {
+					"etcd": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The actual state of the Cluster Checks Runner as a deployment.",
-							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DeploymentStatus"),
+							Description: "Etcd contains the configuration of the monitoring of etcd.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.EtcdMonitoringConfig"),
+						},
+					},
+					"scheduler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scheduler contains the configuration of the monitoring of the scheduler.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ControlPlaneComponentConfig"),
+						},
+					},
+					"controllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControllerManager contains the configuration of the monitoring of the controller manager.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ControlPlaneComponentConfig"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DaemonSetStatus", "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DeploymentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+			"./apis/datadoghq/v2alpha1.ControlPlaneComponentConfig", "./apis/datadoghq/v2alpha1.EtcdMonitoringConfig"},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_DatadogCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_CustomConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogCredentials is a generic structure that holds credentials to access Datadog.",
+				Description: "CustomConfig provides a place for custom configuration of the Agent or Cluster Agent, corresponding to datadog.yaml, system-probe.yaml, security-agent.yaml or datadog-cluster.yaml. The configuration can be provided in the ConfigData field as raw data, or referenced in a ConfigMap. Note: `ConfigData` and `ConfigMap` cannot be set together.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiKey": {
+					"configData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIKey configures your Datadog API key. See also: https://app.datadoghq.com/account/settings#agent/kubernetes",
+							Description: "ConfigData corresponds to the configuration file content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiSecret": {
+					"configMap": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APISecret references an existing Secret which stores the API key instead of creating a new one. If set, this parameter takes precedence over \"APIKey\".",
-							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"),
+							Description: "ConfigMap references an existing ConfigMap with the configuration file content.",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.ConfigMapConfig"),
 						},
 					},
-					"appKey": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.ConfigMapConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_DatadogAgent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogAgent Deployment with the Datadog Operator.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppKey configures your Datadog application key. If you are using features.externalMetricsServer.enabled = true, you must set a Datadog application key for read access to your metrics.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"appSecret": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppSecret references an existing Secret which stores the application key instead of creating a new one. If set, this parameter takes precedence over \"AppKey\".",
-							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v2alpha1.DatadogAgentSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("./apis/datadoghq/v2alpha1.DatadogAgentStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"},
+			"./apis/datadoghq/v2alpha1.DatadogAgentSpec", "./apis/datadoghq/v2alpha1.DatadogAgentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_DatadogFeatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_DatadogAgentGenericContainer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatadogFeatures are features running on the Agent and Cluster Agent.",
+				Description: "DatadogAgentGenericContainer is the generic structure describing any container's common configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"logCollection": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LogCollection configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.LogCollectionFeatureConfig"),
+							Description: "Name of the container that is overridden",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"liveProcessCollection": {
+					"logLevel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LiveProcessCollection configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.LiveProcessCollectionFeatureConfig"),
+							Description: "LogLevel sets logging verbosity (overrides global setting). Valid log levels are: trace, debug, info, warn, error, critical, and off. Default: 'info'",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"liveContainerCollection": {
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "LiveContainerCollection configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.LiveContainerCollectionFeatureConfig"),
+							Description: "Specify additional environment variables in the container. See also: https://docs.datadoghq.com/agent/kubernetes/?tab=helm#environment-variables",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
 						},
 					},
-					"processDiscovery": {
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+									"mountPath",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "ProcessDiscovery configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.ProcessDiscoveryFeatureConfig"),
+							Description: "Specify additional volume mounts in the container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
 						},
 					},
-					"oomKill": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OOMKill configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.OOMKillFeatureConfig"),
+							Description: "Specify the Request and Limits of the pods To get guaranteed QoS class, specify requests and limits equal. See also: http://kubernetes.io/docs/user-guide/compute-resources/",
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
-					"tcpQueueLength": {
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "TCPQueueLength configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.TCPQueueLengthFeatureConfig"),
+							Description: "Command allows the specification of a custom entrypoint for container",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"ebpfCheck": {
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "EBPFCheck configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.EBPFCheckFeatureConfig"),
+							Description: "Args allows the specification of extra args to the `Command` parameter",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"apm": {
+					"healthPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APM (Application Performance Monitoring) configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.APMFeatureConfig"),
+							Description: "HealthPort of the container for the internal liveness probe. Must be the same as the Liveness/Readiness probes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"cspm": {
+					"readinessProbe": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CSPM (Cloud Security Posture Management) configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.CSPMFeatureConfig"),
+							Description: "Configure the Readiness Probe of the container",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
 						},
 					},
-					"cws": {
+					"livenessProbe": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CWS (Cloud Workload Security) configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.CWSFeatureConfig"),
+							Description: "Configure the Liveness Probe of the container",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
 						},
 					},
-					"npm": {
+					"securityContext": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NPM (Network Performance Monitoring) configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.NPMFeatureConfig"),
+							Description: "Container-level SecurityContext.",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
 						},
 					},
-					"usm": {
+					"seccompConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "USM (Universal Service Monitoring) configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.USMFeatureConfig"),
+							Description: "Seccomp configurations to override Operator actions. For all other Seccomp Profile manipulation, use SecurityContext.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.SeccompConfig"),
 						},
 					},
-					"dogstatsd": {
+					"appArmorProfileName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dogstatsd configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.DogstatsdFeatureConfig"),
+							Description: "AppArmorProfileName specifies an apparmor profile.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.SeccompConfig", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_DatadogAgentProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogAgentProfile defines a set of nodes running a dedicated Agent DaemonSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the profile. The Agent DaemonSet of the profile is named `<DatadogAgent name>-agent-<profile name>`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes of the profile by their labels.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelectorRequirements": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelectorRequirements selects the nodes of the profile by their labels. Supported operators are `In`, `NotIn`, `Exists` and `DoesNotExist`. A node belongs to the profile when it matches the NodeSelector and all the requirements.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.NodeSelectorRequirement"),
+									},
+								},
+							},
+						},
+					},
+					"override": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Override the configuration of the Agent DaemonSet of the profile. It is applied on top of the `nodeAgent` override.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.DatadogAgentComponentOverride"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.DatadogAgentComponentOverride", "k8s.io/api/core/v1.NodeSelectorRequirement"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_DatadogAgentProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogAgentProfileStatus defines the observed state of a profile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the profile.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"agent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The actual state of the Agent of the profile.",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DaemonSetStatus"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DaemonSetStatus"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_DatadogAgentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogAgentStatus defines the observed state of DatadogAgent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions Represents the latest available observations of a DatadogAgent's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"agent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The actual state of the Agent as an extended daemonset.",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DaemonSetStatus"),
+						},
+					},
+					"clusterAgent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The actual state of the Cluster Agent as a deployment.",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DeploymentStatus"),
+						},
+					},
+					"clusterChecksRunner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The actual state of the Cluster Checks Runner as a deployment.",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DeploymentStatus"),
+						},
+					},
+					"profiles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The actual state of the Agent of each profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v2alpha1.DatadogAgentProfileStatus"),
+									},
+								},
+							},
+						},
+					},
+					"features": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "The state of each enabled feature.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v2alpha1.FeatureStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.DatadogAgentProfileStatus", "./apis/datadoghq/v2alpha1.FeatureStatus", "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DaemonSetStatus", "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.DeploymentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_DatadogCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogCredentials is a generic structure that holds credentials to access Datadog.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey configures your Datadog API key. See also: https://app.datadoghq.com/account/settings#agent/kubernetes",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APISecret references an existing Secret which stores the API key instead of creating a new one. If set, this parameter takes precedence over \"APIKey\".",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"),
+						},
+					},
+					"appKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppKey configures your Datadog application key. If you are using features.externalMetricsServer.enabled = true, you must set a Datadog application key for read access to your metrics.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppSecret references an existing Secret which stores the application key instead of creating a new one. If set, this parameter takes precedence over \"AppKey\".",
+							Ref:         ref("github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1.SecretConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_DatadogFeatures(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatadogFeatures are features running on the Agent and Cluster Agent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"logCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LogCollection configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.LogCollectionFeatureConfig"),
+						},
+					},
+					"liveProcessCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LiveProcessCollection configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.LiveProcessCollectionFeatureConfig"),
+						},
+					},
+					"liveContainerCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LiveContainerCollection configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.LiveContainerCollectionFeatureConfig"),
+						},
+					},
+					"processDiscovery": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProcessDiscovery configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ProcessDiscoveryFeatureConfig"),
+						},
+					},
+					"oomKill": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OOMKill configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OOMKillFeatureConfig"),
+						},
+					},
+					"tcpQueueLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TCPQueueLength configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.TCPQueueLengthFeatureConfig"),
+						},
+					},
+					"ebpfCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EBPFCheck configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.EBPFCheckFeatureConfig"),
+						},
+					},
+					"apm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APM (Application Performance Monitoring) configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.APMFeatureConfig"),
+						},
+					},
+					"cspm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CSPM (Cloud Security Posture Management) configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.CSPMFeatureConfig"),
+						},
+					},
+					"cws": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CWS (Cloud Workload Security) configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.CWSFeatureConfig"),
+						},
+					},
+					"npm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NPM (Network Performance Monitoring) configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.NPMFeatureConfig"),
+						},
+					},
+					"usm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "USM (Universal Service Monitoring) configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.USMFeatureConfig"),
+						},
+					},
+					"dogstatsd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dogstatsd configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.DogstatsdFeatureConfig"),
 						},
 					},
 					"otlp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OTLP ingest configuration",
-							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPFeatureConfig"),
+							Description: "OTLP ingest configuration",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPFeatureConfig"),
+						},
+					},
+					"remoteConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Remote Configuration configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.RemoteConfigurationFeatureConfig"),
+						},
+					},
+					"sbom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SBOM collection configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.SBOMFeatureConfig"),
+						},
+					},
+					"eventCollection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EventCollection configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.EventCollectionFeatureConfig"),
+						},
+					},
+					"orchestratorExplorer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OrchestratorExplorer check configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OrchestratorExplorerFeatureConfig"),
+						},
+					},
+					"kubeStateMetricsCore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeStateMetricsCore check configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.KubeStateMetricsCoreFeatureConfig"),
+						},
+					},
+					"admissionController": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AdmissionController configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.AdmissionControllerFeatureConfig"),
+						},
+					},
+					"externalMetricsServer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalMetricsServer configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ExternalMetricsServerFeatureConfig"),
+						},
+					},
+					"clusterChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterChecks configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ClusterChecksFeatureConfig"),
+						},
+					},
+					"prometheusScrape": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PrometheusScrape configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.PrometheusScrapeFeatureConfig"),
+						},
+					},
+					"controlPlaneMonitoring": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneMonitoring configuration.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.ControlPlaneMonitoringFeatureConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.APMFeatureConfig", "./apis/datadoghq/v2alpha1.AdmissionControllerFeatureConfig", "./apis/datadoghq/v2alpha1.CSPMFeatureConfig", "./apis/datadoghq/v2alpha1.CWSFeatureConfig", "./apis/datadoghq/v2alpha1.ClusterChecksFeatureConfig", "./apis/datadoghq/v2alpha1.ControlPlaneMonitoringFeatureConfig", "./apis/datadoghq/v2alpha1.DogstatsdFeatureConfig", "./apis/datadoghq/v2alpha1.EBPFCheckFeatureConfig", "./apis/datadoghq/v2alpha1.EventCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.ExternalMetricsServerFeatureConfig", "./apis/datadoghq/v2alpha1.KubeStateMetricsCoreFeatureConfig", "./apis/datadoghq/v2alpha1.LiveContainerCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.LiveProcessCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.LogCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.NPMFeatureConfig", "./apis/datadoghq/v2alpha1.OOMKillFeatureConfig", "./apis/datadoghq/v2alpha1.OTLPFeatureConfig", "./apis/datadoghq/v2alpha1.OrchestratorExplorerFeatureConfig", "./apis/datadoghq/v2alpha1.ProcessDiscoveryFeatureConfig", "./apis/datadoghq/v2alpha1.PrometheusScrapeFeatureConfig", "./apis/datadoghq/v2alpha1.RemoteConfigurationFeatureConfig", "./apis/datadoghq/v2alpha1.SBOMFeatureConfig", "./apis/datadoghq/v2alpha1.TCPQueueLengthFeatureConfig", "./apis/datadoghq/v2alpha1.USMFeatureConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_DogstatsdFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DogstatsdFeatureConfig contains the Dogstatsd configuration parameters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"originDetectionEnabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OriginDetectionEnabled enables origin detection for container tagging. See also: https://docs.datadoghq.com/developers/dogstatsd/unix_socket/#using-origin-detection-for-container-tagging",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"remoteConfiguration": {
+					"tagCardinality": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Remote Configuration configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.RemoteConfigurationFeatureConfig"),
+							Description: "TagCardinality configures tag cardinality for the metrics collected using origin detection (`low`, `orchestrator` or `high`). See also: https://docs.datadoghq.com/getting_started/tagging/assigning_tags/?tab=containerizedenvironments#environment-variables Cardinality default: low",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sbom": {
+					"hostPortConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SBOM collection configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.SBOMFeatureConfig"),
+							Description: "HostPortConfig contains host port configuration. Enabled Default: false Port Default: 8125",
+							Ref:         ref("./apis/datadoghq/v2alpha1.HostPortConfig"),
 						},
 					},
-					"eventCollection": {
+					"unixDomainSocketConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EventCollection configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.EventCollectionFeatureConfig"),
+							Description: "UnixDomainSocketConfig contains socket configuration. See also: https://docs.datadoghq.com/agent/kubernetes/apm/?tab=helm#agent-environment-variables Enabled Default: true Path Default: `/var/run/datadog/dsd.socket`",
+							Ref:         ref("./apis/datadoghq/v2alpha1.UnixDomainSocketConfig"),
 						},
 					},
-					"orchestratorExplorer": {
+					"mapperProfiles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OrchestratorExplorer check configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.OrchestratorExplorerFeatureConfig"),
+							Description: "Configure the Dogstasd Mapper Profiles. Can be passed as raw data or via a json encoded string in a config map. See also: https://docs.datadoghq.com/developers/dogstatsd/dogstatsd_mapper/",
+							Ref:         ref("./apis/datadoghq/v2alpha1.CustomConfig"),
 						},
 					},
-					"kubeStateMetricsCore": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.CustomConfig", "./apis/datadoghq/v2alpha1.HostPortConfig", "./apis/datadoghq/v2alpha1.UnixDomainSocketConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_EtcdMonitoringConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EtcdMonitoringConfig contains the configuration of the monitoring of etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeStateMetricsCore check configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.KubeStateMetricsCoreFeatureConfig"),
+							Description: "Enabled enables the monitoring of etcd. It is ignored for the managed control planes, which do not expose etcd. Default: true",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"admissionController": {
+					"certificatesSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AdmissionController configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.AdmissionControllerFeatureConfig"),
+							Description: "CertificatesSecretName is the name of the Secret containing the etcd client certificate `tls.crt` and key `tls.key`, on OpenShift. The Secret must be in the namespace of the DatadogAgent: copy the `etcd-metric-client` Secret of the `openshift-etcd-operator` namespace. The Agent pods do not start until the Secret exists. Default: `etcd-metric-client`",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"externalMetricsServer": {
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_EventCollectionFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventCollectionFeatureConfig contains the Event Collection configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"collectKubernetesEvents": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExternalMetricsServer configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.ExternalMetricsServerFeatureConfig"),
+							Description: "CollectKubernetesEvents enables Kubernetes event collection. Default: true",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"clusterChecks": {
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_FeatureStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FeatureStatus defines the observed state of a feature.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterChecks configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.ClusterChecksFeatureConfig"),
+							Description: "ID of the feature.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"prometheusScrape": {
+					"requiredComponents": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "PrometheusScrape configuration.",
-							Ref:         ref("./apis/datadoghq/v2alpha1.PrometheusScrapeFeatureConfig"),
+							Description: "RequiredComponents lists the components that the feature needs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions Represents the latest available observations of the feature's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreCollectorsConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeStateMetricsCoreCollectorsConfig configures the Kubernetes resources collected by the Kubernetes State Metrics Core check.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled lists the collectors to add to the default ones, for instance `poddisruptionbudgets`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"disabled": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled lists the default collectors to remove, for instance `secrets`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreCustomResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeStateMetricsCoreCustomResource defines the metrics collected from a custom resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Group is the API group of the custom resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the API version of the custom resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the custom resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resource is the plural name of the custom resource. Default: the lowercase kind followed by `s`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metricNamePrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricNamePrefix is the prefix of the metric names.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labelsFromPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelsFromPath adds labels to all the metrics of the custom resource. The values are the paths of the label values in the object, for instance `name: [metadata, name]`.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"metrics": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics defines the metrics collected from the custom resource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCustomResourceMetric"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"group", "version", "kind", "metrics"},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v2alpha1.APMFeatureConfig", "./apis/datadoghq/v2alpha1.AdmissionControllerFeatureConfig", "./apis/datadoghq/v2alpha1.CSPMFeatureConfig", "./apis/datadoghq/v2alpha1.CWSFeatureConfig", "./apis/datadoghq/v2alpha1.ClusterChecksFeatureConfig", "./apis/datadoghq/v2alpha1.DogstatsdFeatureConfig", "./apis/datadoghq/v2alpha1.EBPFCheckFeatureConfig", "./apis/datadoghq/v2alpha1.EventCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.ExternalMetricsServerFeatureConfig", "./apis/datadoghq/v2alpha1.KubeStateMetricsCoreFeatureConfig", "./apis/datadoghq/v2alpha1.LiveContainerCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.LiveProcessCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.LogCollectionFeatureConfig", "./apis/datadoghq/v2alpha1.NPMFeatureConfig", "./apis/datadoghq/v2alpha1.OOMKillFeatureConfig", "./apis/datadoghq/v2alpha1.OTLPFeatureConfig", "./apis/datadoghq/v2alpha1.OrchestratorExplorerFeatureConfig", "./apis/datadoghq/v2alpha1.ProcessDiscoveryFeatureConfig", "./apis/datadoghq/v2alpha1.PrometheusScrapeFeatureConfig", "./apis/datadoghq/v2alpha1.RemoteConfigurationFeatureConfig", "./apis/datadoghq/v2alpha1.SBOMFeatureConfig", "./apis/datadoghq/v2alpha1.TCPQueueLengthFeatureConfig", "./apis/datadoghq/v2alpha1.USMFeatureConfig"},
+			"./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCustomResourceMetric"},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_DogstatsdFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreCustomResourceMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DogstatsdFeatureConfig contains the Dogstatsd configuration parameters.",
+				Description: "KubeStateMetricsCoreCustomResourceMetric defines a metric collected from a custom resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"originDetectionEnabled": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OriginDetectionEnabled enables origin detection for container tagging. See also: https://docs.datadoghq.com/developers/dogstatsd/unix_socket/#using-origin-detection-for-container-tagging",
-							Type:        []string{"boolean"},
+							Description: "Name of the metric.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tagCardinality": {
+					"help": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TagCardinality configures tag cardinality for the metrics collected using origin detection (`low`, `orchestrator` or `high`). See also: https://docs.datadoghq.com/getting_started/tagging/assigning_tags/?tab=containerizedenvironments#environment-variables Cardinality default: low",
+							Description: "Help is the description of the metric.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hostPortConfig": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HostPortConfig contains host port configuration. Enabled Default: false Port Default: 8125",
-							Ref:         ref("./apis/datadoghq/v2alpha1.HostPortConfig"),
+							Description: "Type of the metric: Gauge, StateSet, or Info.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"unixDomainSocketConfig": {
+					"path": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "UnixDomainSocketConfig contains socket configuration. See also: https://docs.datadoghq.com/agent/kubernetes/apm/?tab=helm#agent-environment-variables Enabled Default: true Path Default: `/var/run/datadog/dsd.socket`",
-							Ref:         ref("./apis/datadoghq/v2alpha1.UnixDomainSocketConfig"),
+							Description: "Path is the path of the object the metric is generated from, for instance `[status]`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"mapperProfiles": {
+					"valueFrom": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Configure the Dogstasd Mapper Profiles. Can be passed as raw data or via a json encoded string in a config map. See also: https://docs.datadoghq.com/developers/dogstatsd/dogstatsd_mapper/",
-							Ref:         ref("./apis/datadoghq/v2alpha1.CustomConfig"),
+							Description: "ValueFrom is the path of the value, relative to Path. Only used by Gauge and StateSet metrics.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"./apis/datadoghq/v2alpha1.CustomConfig", "./apis/datadoghq/v2alpha1.HostPortConfig", "./apis/datadoghq/v2alpha1.UnixDomainSocketConfig"},
-	}
-}
-
-func schema__apis_datadoghq_v2alpha1_EventCollectionFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "EventCollectionFeatureConfig contains the Event Collection configuration.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"collectKubernetesEvents": {
+					"labelsFromPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CollectKubernetesEvents enables Kubernetes event collection. Default: true",
-							Type:        []string{"boolean"},
+							Description: "LabelsFromPath adds labels to the metric, the values are paths relative to Path.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"array"},
+										Items: &spec.SchemaOrArray{
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"labelName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelName is the name of the label holding the state of a StateSet metric.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"list": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List lists the possible states of a StateSet metric.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"name", "type"},
 			},
 		},
 	}
@@ -630,15 +1540,128 @@ func schema__apis_datadoghq_v2alpha1_KubeStateMetricsCoreFeatureConfig(ref commo
 					},
 					"conf": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conf overrides the configuration for the default Kubernetes State Metrics Core check. This must point to a ConfigMap containing a valid cluster check configuration.",
+							Description: "Conf overrides the configuration for the default Kubernetes State Metrics Core check. This must point to a ConfigMap containing a valid cluster check configuration. The other fields of this section are ignored when it is set.",
 							Ref:         ref("./apis/datadoghq/v2alpha1.CustomConfig"),
 						},
 					},
+					"collectors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Collectors configures the Kubernetes resources collected by the check.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCollectorsConfig"),
+						},
+					},
+					"labelsAsTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LabelsAsTags maps the labels of the Kubernetes resources to Datadog tags, per resource kind. <RESOURCE_KIND>: {<KUBERNETES_LABEL>: <DATADOG_TAG_KEY>}, for instance `pod: {app: app}`.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"object"},
+										AdditionalProperties: &spec.SchemaOrBool{
+											Allows: true,
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"annotationsAsTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AnnotationsAsTags maps the annotations of the Kubernetes resources to Datadog tags, per resource kind. <RESOURCE_KIND>: {<KUBERNETES_ANNOTATION>: <DATADOG_TAG_KEY>}",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type: []string{"object"},
+										AdditionalProperties: &spec.SchemaOrBool{
+											Allows: true,
+											Schema: &spec.Schema{
+												SchemaProps: spec.SchemaProps{
+													Default: "",
+													Type:    []string{"string"},
+													Format:  "",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					"namespaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces lists the namespaces of the collected resources. Default: all the namespaces",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"namespacesExcluded": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespacesExcluded lists the namespaces of the resources that are not collected.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"customResources": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomResources defines the metrics collected from custom resources. The Cluster Agent or the Cluster Checks Runners are granted the permissions to list and watch them, the operator must have these permissions too: cluster admins must grant them to the operator ServiceAccount. The resources of the core and `rbac.authorization.k8s.io` API groups are not supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCustomResource"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"./apis/datadoghq/v2alpha1.CustomConfig"},
+			"./apis/datadoghq/v2alpha1.CustomConfig", "./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCollectorsConfig", "./apis/datadoghq/v2alpha1.KubeStateMetricsCoreCustomResource"},
 	}
 }
 
@@ -749,84 +1772,204 @@ func schema__apis_datadoghq_v2alpha1_NetworkPolicyConfig(ref common.ReferenceCal
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_OTLPFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OTLPFeatureConfig contains configuration for OTLP ingest.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"receiver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Receiver contains configuration for the OTLP ingest receiver.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPReceiverConfig"),
+						},
+					},
+					"logs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logs contains configuration for the OTLP logs ingestion.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPLogsConfig"),
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metrics contains configuration for the OTLP metrics ingestion.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPMetricsConfig"),
+						},
+					},
+					"traces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Traces contains configuration for the OTLP traces ingestion.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPTracesConfig"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.OTLPLogsConfig", "./apis/datadoghq/v2alpha1.OTLPMetricsConfig", "./apis/datadoghq/v2alpha1.OTLPReceiverConfig", "./apis/datadoghq/v2alpha1.OTLPTracesConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_OTLPGRPCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OTLPGRPCConfig contains configuration for the OTLP ingest OTLP/gRPC receiver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enable the OTLP/gRPC endpoint.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint for OTLP/gRPC. gRPC supports several naming schemes: https://github.com/grpc/grpc/blob/master/doc/naming.md The Datadog Operator supports only 'host:port' (usually `0.0.0.0:port`). Default: `0.0.0.0:4317`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_OTLPHTTPConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OTLPHTTPConfig contains configuration for the OTLP ingest OTLP/HTTP receiver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enable the OTLP/HTTP endpoint.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint for OTLP/HTTP. Default: '0.0.0.0:4318'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_OTLPFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_OTLPHistogramsConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OTLPFeatureConfig contains configuration for OTLP ingest.",
+				Description: "OTLPHistogramsConfig contains configuration for the translation of the OTLP histograms.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"receiver": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Receiver contains configuration for the OTLP ingest receiver.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPReceiverConfig"),
+							Description: "Mode of the histograms translation: `distributions`, `counters` or `nobuckets`. Default: `distributions`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sendAggregationMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SendAggregationMetrics sends the `.sum`, `.count`, `.min` and `.max` metrics of the histograms. Default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"./apis/datadoghq/v2alpha1.OTLPReceiverConfig"},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_OTLPGRPCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_OTLPLogsConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OTLPGRPCConfig contains configuration for the OTLP ingest OTLP/gRPC receiver.",
+				Description: "OTLPLogsConfig contains configuration for the OTLP logs ingestion.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enable the OTLP/gRPC endpoint.",
+							Description: "Enable the ingestion of OTLP logs. The logs collection must be enabled too: `features.logCollection.enabled`. Default: false",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"endpoint": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Endpoint for OTLP/gRPC. gRPC supports several naming schemes: https://github.com/grpc/grpc/blob/master/doc/naming.md The Datadog Operator supports only 'host:port' (usually `0.0.0.0:port`). Default: `0.0.0.0:4317`.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
 			},
 		},
 	}
 }
 
-func schema__apis_datadoghq_v2alpha1_OTLPHTTPConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema__apis_datadoghq_v2alpha1_OTLPMetricsConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OTLPHTTPConfig contains configuration for the OTLP ingest OTLP/HTTP receiver.",
+				Description: "OTLPMetricsConfig contains configuration for the OTLP metrics ingestion.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enable the OTLP/HTTP endpoint.",
+							Description: "Enable the ingestion of OTLP metrics. Default: true",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"endpoint": {
+					"resourceAttributesAsTags": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Endpoint for OTLP/HTTP. Default: '0.0.0.0:4318'.",
+							Description: "ResourceAttributesAsTags sets all the resource attributes as tags of the metrics. Default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"instrumentationScopeMetadataAsTags": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InstrumentationScopeMetadataAsTags sets the name and version of the instrumentation scope as tags of the metrics. Default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tagCardinality": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TagCardinality is the cardinality of the tags added by the origin detection: `low`, `orchestrator` or `high`. Default: `low`",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"histograms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Histograms contains configuration for the translation of the OTLP histograms.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPHistogramsConfig"),
+						},
+					},
+					"summaries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summaries contains configuration for the translation of the OTLP summaries.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.OTLPSummariesConfig"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.OTLPHistogramsConfig", "./apis/datadoghq/v2alpha1.OTLPSummariesConfig"},
 	}
 }
 
@@ -879,6 +2022,46 @@ func schema__apis_datadoghq_v2alpha1_OTLPReceiverConfig(ref common.ReferenceCall
 	}
 }
 
+func schema__apis_datadoghq_v2alpha1_OTLPSummariesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OTLPSummariesConfig contains configuration for the translation of the OTLP summaries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode of the summaries translation: `gauges` sends a gauge per quantile, `noquantiles` only sends the `.sum` and `.count` metrics. Default: `gauges`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_OTLPTracesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OTLPTracesConfig contains configuration for the OTLP traces ingestion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enable the ingestion of OTLP traces. The traces are only sent to Datadog when APM is enabled: `features.apm.enabled`. Default: true",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema__apis_datadoghq_v2alpha1_OrchestratorExplorerFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -961,6 +2144,40 @@ func schema__apis_datadoghq_v2alpha1_OrchestratorExplorerFeatureConfig(ref commo
 	}
 }
 
+func schema__apis_datadoghq_v2alpha1_PodDisruptionBudgetConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodDisruptionBudgetConfig provides PodDisruptionBudget configurations for the Deployment components. By default, a PodDisruptionBudget with `minAvailable: 1` is created when the component runs more than one replica.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled enables the PodDisruptionBudget of the component. Set it to `false` to not create a PodDisruptionBudget.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"minAvailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinAvailable is the number or the percentage of pods that must remain available during an eviction. Cannot be set together with MaxUnavailable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the number or the percentage of pods that can be unavailable after an eviction. Cannot be set together with MinAvailable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema__apis_datadoghq_v2alpha1_PrometheusScrapeFeatureConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1002,6 +2219,33 @@ func schema__apis_datadoghq_v2alpha1_PrometheusScrapeFeatureConfig(ref common.Re
 	}
 }
 
+func schema__apis_datadoghq_v2alpha1_RollingUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RollingUpdate provides the rolling update configuration of the node Agent DaemonSet or ExtendedDaemonSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the number or the percentage of Agent pods that can be unavailable during the update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is the number or the percentage of nodes with an existing available Agent pod that can have an updated Agent pod during the update. Ignored when the node Agent is deployed with an ExtendedDaemonSet.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema__apis_datadoghq_v2alpha1_SeccompConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1030,6 +2274,123 @@ func schema__apis_datadoghq_v2alpha1_SeccompConfig(ref common.ReferenceCallback)
 	}
 }
 
+func schema__apis_datadoghq_v2alpha1_SecretBackendConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBackendConfig provides configuration for the secret backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Command defines the secret backend command to use",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args defines the list of arguments to pass to the command, they can't contain whitespaces",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timeout defines the secret backend command timeout, in seconds. Default: 30 (Agent default)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"enableGlobalPermissions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnableGlobalPermissions grants the Agents read access to all the Secrets of the cluster. It is required by the `/readsecret_multiple_providers.sh` script when the Secrets to read aren't listed in `roles`. Default: false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"roles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Roles grants the Agents read access to the listed Secrets, namespace by namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("./apis/datadoghq/v2alpha1.SecretBackendRolesConfig"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.SecretBackendRolesConfig"},
+	}
+}
+
+func schema__apis_datadoghq_v2alpha1_SecretBackendRolesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretBackendRolesConfig provides the Secrets of a namespace the Agents can read through the secret backend.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the Secrets.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secrets": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Secrets lists the names of the Secrets the Agents can read.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"namespace", "secrets"},
+			},
+		},
+	}
+}
+
 func schema__apis_datadoghq_v2alpha1_SecurityContextConstraintsConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1084,3 +2445,37 @@ func schema__apis_datadoghq_v2alpha1_UnixDomainSocketConfig(ref common.Reference
 		},
 	}
 }
+
+func schema__apis_datadoghq_v2alpha1_UpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpdateStrategy provides the update strategy configuration of the node Agent DaemonSet or ExtendedDaemonSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the DaemonSet update strategy: `RollingUpdate` or `OnDelete`. Ignored when the node Agent is deployed with an ExtendedDaemonSet. Default: `RollingUpdate`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rollingUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configure the rolling update when Type is `RollingUpdate`.",
+							Ref:         ref("./apis/datadoghq/v2alpha1.RollingUpdate"),
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configure the canary deployment of the ExtendedDaemonSet. The fields that are set override the defaults provided by the Datadog Operator flags. Only applicable when the node Agent is deployed with an ExtendedDaemonSet.",
+							Ref:         ref("github.com/DataDog/extendeddaemonset/api/v1alpha1.ExtendedDaemonSetSpecStrategyCanary"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"./apis/datadoghq/v2alpha1.RollingUpdate", "github.com/DataDog/extendeddaemonset/api/v1alpha1.ExtendedDaemonSetSpecStrategyCanary"},
+	}
+}
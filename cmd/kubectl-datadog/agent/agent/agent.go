@@ -8,6 +8,7 @@ package agent
 import (
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/agent/check"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/agent/find"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/agent/render"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/agent/upgrade"
 
 	"github.com/spf13/cobra"
@@ -37,6 +38,7 @@ func New(streams genericclioptions.IOStreams) *cobra.Command {
 	cmd.AddCommand(upgrade.New(streams))
 	cmd.AddCommand(check.New(streams))
 	cmd.AddCommand(find.New(streams))
+	cmd.AddCommand(render.New(streams))
 
 	o := newOptions(streams)
 	o.configFlags.AddFlags(cmd.Flags())
@@ -0,0 +1,369 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent"
+	componentagent "github.com/DataDog/datadog-operator/controllers/datadogagent/component/agent"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+	edsv1alpha1 "github.com/DataDog/extendeddaemonset/api/v1alpha1"
+)
+
+const (
+	defaultKubeVersion = "1.25.0"
+	defaultNamespace   = "default"
+
+	// maxReconciles bounds the number of reconcile loops, the first ones only add the finalizer to the DatadogAgent
+	maxReconciles = 5
+)
+
+var renderExample = `
+  # print the resources created by the operator for the DatadogAgent of datadog-agent.yaml
+  %[1]s render datadog-agent.yaml
+
+  # print the resources for a Kubernetes 1.22 cluster with the ExtendedDaemonSet controller
+  %[1]s render datadog-agent.yaml --kube-version 1.22 --supports-eds
+`
+
+// options provides information required by the agent render command
+type options struct {
+	genericclioptions.IOStreams
+	configFlags *genericclioptions.ConfigFlags
+	args        []string
+	file        string
+	namespace   string
+	kubeVersion string
+	supportsEDS bool
+}
+
+// newOptions provides an instance of options with default values
+func newOptions(streams genericclioptions.IOStreams) *options {
+	return &options{
+		configFlags: genericclioptions.NewConfigFlags(false),
+		IOStreams:   streams,
+	}
+}
+
+// New provides a cobra command wrapping options for "render" sub command
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "render [file] [flags]",
+		Short:        "Print the resources created by the operator for a DatadogAgent, without a cluster",
+		Example:      fmt.Sprintf(renderExample, "kubectl datadog agent"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.kubeVersion, "kube-version", defaultKubeVersion, "Kubernetes version of the target cluster, used to select the API versions of the resources")
+	cmd.Flags().BoolVar(&o.supportsEDS, "supports-eds", false, "Render the node Agent as an ExtendedDaemonSet, as the operator does when the ExtendedDaemonSet support is enabled")
+	o.configFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		o.file = args[0]
+	}
+
+	nsFlag, err := cmd.Flags().GetString("namespace")
+	if err != nil {
+		return err
+	}
+	o.namespace = nsFlag
+
+	return nil
+}
+
+// validate ensures that all required arguments and flag values are provided
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the DatadogAgent file is required")
+	}
+	if _, err := utilversion.ParseGeneric(o.kubeVersion); err != nil {
+		return fmt.Errorf("invalid Kubernetes version %q: %w", o.kubeVersion, err)
+	}
+
+	return nil
+}
+
+// run runs the render command
+func (o *options) run() error {
+	data, err := os.ReadFile(o.file)
+	if err != nil {
+		return fmt.Errorf("unable to read %s: %w", o.file, err)
+	}
+
+	dda, err := loadDatadogAgent(data)
+	if err != nil {
+		return err
+	}
+	if o.namespace != "" {
+		dda.Namespace = o.namespace
+	} else if dda.Namespace == "" {
+		dda.Namespace = defaultNamespace
+	}
+
+	out, err := render(dda, o.kubeVersion, o.supportsEDS)
+	if err != nil {
+		return err
+	}
+	_, err = o.Out.Write(out)
+
+	return err
+}
+
+// loadDatadogAgent decodes a v1alpha1 or v2alpha1 DatadogAgent, v1alpha1 ones are converted to v2alpha1
+func loadDatadogAgent(data []byte) (*v2alpha1.DatadogAgent, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return nil, fmt.Errorf("unable to decode the DatadogAgent: %w", err)
+	}
+	if typeMeta.Kind != "DatadogAgent" {
+		return nil, fmt.Errorf("unsupported kind %q, expected DatadogAgent", typeMeta.Kind)
+	}
+
+	dda := &v2alpha1.DatadogAgent{}
+	switch typeMeta.APIVersion {
+	case v1alpha1.GroupVersion.String():
+		ddaV1 := &v1alpha1.DatadogAgent{}
+		if err := yaml.UnmarshalStrict(data, ddaV1); err != nil {
+			return nil, fmt.Errorf("unable to decode the v1alpha1 DatadogAgent: %w", err)
+		}
+		if err := v1alpha1.ConvertTo(ddaV1, dda); err != nil {
+			return nil, fmt.Errorf("unable to convert the DatadogAgent to v2alpha1: %w", err)
+		}
+	case v2alpha1.GroupVersion.String():
+		if err := yaml.UnmarshalStrict(data, dda); err != nil {
+			return nil, fmt.Errorf("unable to decode the v2alpha1 DatadogAgent: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported apiVersion %q, expected %s or %s", typeMeta.APIVersion, v1alpha1.GroupVersion, v2alpha1.GroupVersion)
+	}
+	dda.TypeMeta = metav1.TypeMeta{
+		Kind:       "DatadogAgent",
+		APIVersion: v2alpha1.GroupVersion.String(),
+	}
+
+	return dda, nil
+}
+
+// render reconciles the DatadogAgent against an in-memory client and returns the created resources as a YAML stream
+func render(dda *v2alpha1.DatadogAgent, kubeVersion string, supportsEDS bool) ([]byte, error) {
+	if err := dda.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid DatadogAgent: %w", err)
+	}
+
+	versionInfo, platformInfo, err := newPlatformInfo(kubeVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	s := newScheme()
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(dda).Build()
+
+	options := datadogagent.ReconcilerOptions{
+		V2Enabled: true,
+		// Same defaults as the operator flags
+		ExtendedDaemonsetOptions: componentagent.ExtendedDaemonsetOptions{
+			Enabled:                supportsEDS,
+			CanaryDuration:         10 * time.Minute,
+			CanaryAutoPauseEnabled: true,
+			CanaryAutoFailEnabled:  true,
+		},
+	}
+	// A FakeRecorder without channel drops the events
+	r, err := datadogagent.NewReconciler(options, c, versionInfo, platformInfo, s, logr.Discard(), &record.FakeRecorder{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: dda.Namespace, Name: dda.Name}}
+	for i := 0; i < maxReconciles; i++ {
+		result, err := r.Reconcile(context.TODO(), request)
+		if err != nil {
+			return nil, fmt.Errorf("unable to reconcile the DatadogAgent: %w", err)
+		}
+		if !result.Requeue {
+			break
+		}
+	}
+
+	objects, err := listObjects(c, s, platformInfo, supportsEDS)
+	if err != nil {
+		return nil, err
+	}
+
+	return toYAMLStream(objects)
+}
+
+// newPlatformInfo returns the version and the API versions of a Kubernetes cluster of the given version
+func newPlatformInfo(kubeVersion string) (*version.Info, kubernetes.PlatformInfo, error) {
+	v, err := utilversion.ParseGeneric(kubeVersion)
+	if err != nil {
+		return nil, kubernetes.PlatformInfo{}, fmt.Errorf("invalid Kubernetes version %q: %w", kubeVersion, err)
+	}
+
+	versionInfo := &version.Info{
+		Major:      fmt.Sprint(v.Major()),
+		Minor:      fmt.Sprint(v.Minor()),
+		GitVersion: fmt.Sprintf("v%d.%d.%d", v.Major(), v.Minor(), v.Patch()),
+	}
+
+	preferred := map[string]string{}
+	other := map[string]string{}
+
+	// policy/v1 PodDisruptionBudgets are served from Kubernetes 1.21
+	if v.AtLeast(utilversion.MustParseGeneric("1.21")) {
+		preferred["PodDisruptionBudget"] = "policy/v1"
+		other["PodDisruptionBudget"] = "policy/v1beta1"
+	} else {
+		preferred["PodDisruptionBudget"] = "policy/v1beta1"
+	}
+
+	// autoscaling/v2 HorizontalPodAutoscalers are served from Kubernetes 1.23
+	if v.AtLeast(utilversion.MustParseGeneric("1.23")) {
+		preferred["HorizontalPodAutoscaler"] = "autoscaling/v2"
+		other["HorizontalPodAutoscaler"] = "autoscaling/v2beta2"
+	} else {
+		preferred["HorizontalPodAutoscaler"] = "autoscaling/v1"
+		other["HorizontalPodAutoscaler"] = "autoscaling/v2beta2"
+	}
+
+	// PodSecurityPolicies are removed from Kubernetes 1.25
+	if !v.AtLeast(utilversion.MustParseGeneric("1.25")) {
+		other["PodSecurityPolicy"] = "policy/v1beta1"
+	}
+
+	return versionInfo, kubernetes.NewPlatformInfoFromVersionMaps(versionInfo, preferred, other), nil
+}
+
+// newScheme returns a scheme with the types created by the DatadogAgent controller
+func newScheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(s))
+	utilruntime.Must(apiregistrationv1.AddToScheme(s))
+	utilruntime.Must(edsv1alpha1.AddToScheme(s))
+	utilruntime.Must(v1alpha1.AddToScheme(s))
+	utilruntime.Must(v2alpha1.AddToScheme(s))
+
+	return s
+}
+
+// listObjects lists the dependencies then the workloads created by the controller, sorted by namespace and name for each kind
+func listObjects(c client.Client, s *runtime.Scheme, platformInfo kubernetes.PlatformInfo, supportsEDS bool) ([]client.Object, error) {
+	var lists []client.ObjectList
+	for _, kind := range platformInfo.GetAgentResourcesKind(false) {
+		lists = append(lists, kubernetes.ObjectListFromKind(kind, platformInfo))
+	}
+	lists = append(lists, &appsv1.DeploymentList{}, &appsv1.DaemonSetList{})
+	if supportsEDS {
+		lists = append(lists, &edsv1alpha1.ExtendedDaemonSetList{})
+	}
+
+	var objects []client.Object
+	for _, list := range lists {
+		if err := c.List(context.TODO(), list); err != nil {
+			return nil, fmt.Errorf("unable to list %T: %w", list, err)
+		}
+		items, err := apimeta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+
+		kindObjects := make([]client.Object, 0, len(items))
+		for _, item := range items {
+			obj, ok := item.(client.Object)
+			if !ok {
+				continue
+			}
+			gvk, err := apiutil.GVKForObject(obj, s)
+			if err != nil {
+				return nil, err
+			}
+			obj.GetObjectKind().SetGroupVersionKind(gvk)
+			kindObjects = append(kindObjects, obj)
+		}
+		sort.SliceStable(kindObjects, func(i, j int) bool {
+			if kindObjects[i].GetNamespace() != kindObjects[j].GetNamespace() {
+				return kindObjects[i].GetNamespace() < kindObjects[j].GetNamespace()
+			}
+			return kindObjects[i].GetName() < kindObjects[j].GetName()
+		})
+		objects = append(objects, kindObjects...)
+	}
+
+	return objects, nil
+}
+
+// toYAMLStream marshals the objects without their status and the fields set by the API server
+func toYAMLStream(objects []client.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, obj := range objects {
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		delete(content, "status")
+		unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+		unstructured.RemoveNestedField(content, "metadata", "resourceVersion")
+		unstructured.RemoveNestedField(content, "metadata", "managedFields")
+		// The owner references point to the in-memory DatadogAgent
+		unstructured.RemoveNestedField(content, "metadata", "ownerReferences")
+
+		data, err := yaml.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("---\n")
+		buf.Write(data)
+	}
+
+	return buf.Bytes(), nil
+}
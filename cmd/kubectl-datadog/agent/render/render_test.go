@@ -0,0 +1,155 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+const v2alpha1DatadogAgent = `
+apiVersion: datadoghq.com/v2alpha1
+kind: DatadogAgent
+metadata:
+  name: datadog
+  namespace: datadog
+spec:
+  global:
+    credentials:
+      apiKey: "0000000000000000000000"
+`
+
+const v1alpha1DatadogAgent = `
+apiVersion: datadoghq.com/v1alpha1
+kind: DatadogAgent
+metadata:
+  name: datadog
+spec:
+  credentials:
+    apiKey: "0000000000000000000000"
+`
+
+func Test_loadDatadogAgent(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          string
+		wantErr       bool
+		wantNamespace string
+	}{
+		{
+			name:          "v2alpha1",
+			data:          v2alpha1DatadogAgent,
+			wantNamespace: "datadog",
+		},
+		{
+			name: "v1alpha1",
+			data: v1alpha1DatadogAgent,
+		},
+		{
+			name:    "unsupported kind",
+			data:    "apiVersion: v1\nkind: ConfigMap\n",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported apiVersion",
+			data:    "apiVersion: datadoghq.com/v3\nkind: DatadogAgent\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dda, err := loadDatadogAgent([]byte(tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "datadog", dda.Name)
+			assert.Equal(t, tt.wantNamespace, dda.Namespace)
+			assert.Equal(t, "datadoghq.com/v2alpha1", dda.APIVersion)
+			require.NotNil(t, dda.Spec.Global)
+			require.NotNil(t, dda.Spec.Global.Credentials)
+			assert.Equal(t, "0000000000000000000000", *dda.Spec.Global.Credentials.APIKey)
+		})
+	}
+}
+
+func Test_newPlatformInfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		kubeVersion string
+		wantErr     bool
+		wantV1B1PDB bool
+		wantV2B2HPA bool
+		wantPSP     bool
+	}{
+		{
+			name:        "1.20",
+			kubeVersion: "1.20",
+			wantV1B1PDB: true,
+			wantV2B2HPA: true,
+			wantPSP:     true,
+		},
+		{
+			name:        "1.22",
+			kubeVersion: "v1.22.4",
+			wantV2B2HPA: true,
+			wantPSP:     true,
+		},
+		{
+			name:        "1.25",
+			kubeVersion: "1.25.0",
+		},
+		{
+			name:        "invalid",
+			kubeVersion: "latest",
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			versionInfo, platformInfo, err := newPlatformInfo(tt.kubeVersion)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "1", versionInfo.Major)
+			assert.Equal(t, tt.wantV1B1PDB, platformInfo.UseV1Beta1PDB())
+			assert.Equal(t, tt.wantV2B2HPA, platformInfo.UseV2Beta2HPA())
+			assert.Equal(t, tt.wantPSP, containsKind(platformInfo.GetAgentResourcesKind(false), kubernetes.PodSecurityPoliciesKind))
+		})
+	}
+}
+
+func Test_render(t *testing.T) {
+	dda, err := loadDatadogAgent([]byte(v2alpha1DatadogAgent))
+	require.NoError(t, err)
+
+	out, err := render(dda, defaultKubeVersion, false)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(out), "kind: DaemonSet\n")
+	assert.Contains(t, string(out), "kind: Deployment\n")
+	assert.Contains(t, string(out), "kind: ClusterRole\n")
+	assert.NotContains(t, string(out), "kind: ExtendedDaemonSet\n")
+	assert.NotContains(t, string(out), "resourceVersion")
+}
+
+func containsKind(kinds []kubernetes.ObjectKind, kind kubernetes.ObjectKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/flare"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/get"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/metrics"
+	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/monitor"
 	"github.com/DataDog/datadog-operator/cmd/kubectl-datadog/validate/validate"
 
 	"github.com/spf13/cobra"
@@ -51,6 +52,9 @@ func NewCmd(streams genericclioptions.IOStreams) *cobra.Command {
 	// DatadogMetric commands
 	cmd.AddCommand(metrics.New(streams))
 
+	// DatadogMonitor commands
+	cmd.AddCommand(monitor.New(streams))
+
 	o := newOptions(streams)
 	o.configFlags.AddFlags(cmd.Flags())
 
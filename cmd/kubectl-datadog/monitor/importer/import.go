@@ -0,0 +1,196 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"sigs.k8s.io/yaml"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogmonitor"
+	"github.com/DataDog/datadog-operator/pkg/config"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const maxNameLength = 63
+
+var (
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+	importExample    = `
+  # print the DatadogMonitor equivalent to the monitor 12345
+  # (credentials are read from the DD_API_KEY and DD_APP_KEY environment variables, and the site from DD_SITE)
+  %[1]s import 12345
+
+  # import the monitor 12345 into the DatadogMonitor foo of the namespace bar
+  %[1]s import 12345 --name foo -n bar | kubectl apply -f -
+`
+)
+
+// options provides information required by the monitor import command
+type options struct {
+	genericclioptions.IOStreams
+	configFlags *genericclioptions.ConfigFlags
+	args        []string
+	monitorID   int64
+	name        string
+	namespace   string
+	noAdopt     bool
+}
+
+// newOptions provides an instance of options with default values
+func newOptions(streams genericclioptions.IOStreams) *options {
+	return &options{
+		configFlags: genericclioptions.NewConfigFlags(false),
+		IOStreams:   streams,
+	}
+}
+
+// New provides a cobra command wrapping options for "import" sub command
+func New(streams genericclioptions.IOStreams) *cobra.Command {
+	o := newOptions(streams)
+	cmd := &cobra.Command{
+		Use:          "import [monitor ID] [flags]",
+		Short:        "Print the DatadogMonitor equivalent to an existing Datadog monitor",
+		Example:      fmt.Sprintf(importExample, "kubectl datadog monitor"),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.complete(c, args); err != nil {
+				return err
+			}
+			if err := o.validate(); err != nil {
+				return err
+			}
+
+			return o.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&o.name, "name", "", "Name of the DatadogMonitor (default: derived from the monitor name)")
+	cmd.Flags().BoolVar(&o.noAdopt, "no-adopt", false, "Don't set spec.controllerOptions.adoptMonitorID: applying the DatadogMonitor creates a new monitor")
+	o.configFlags.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+// complete sets all information required for processing the command
+func (o *options) complete(cmd *cobra.Command, args []string) error {
+	o.args = args
+	if len(args) > 0 {
+		id, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid monitor ID %q: %w", args[0], err)
+		}
+		o.monitorID = id
+	}
+
+	nsFlag, err := cmd.Flags().GetString("namespace")
+	if err != nil {
+		return err
+	}
+	if nsFlag != "" {
+		o.namespace = nsFlag
+	} else if ns, _, nsErr := o.configFlags.ToRawKubeConfigLoader().Namespace(); nsErr == nil {
+		o.namespace = ns
+	}
+
+	return nil
+}
+
+// validate ensures that all required arguments and flag values are provided
+func (o *options) validate() error {
+	if len(o.args) != 1 {
+		return errors.New("the monitor ID is required")
+	}
+	if o.monitorID <= 0 {
+		return errors.New("the monitor ID must be a positive integer")
+	}
+
+	return nil
+}
+
+// run runs the import command
+func (o *options) run() error {
+	ddClient, err := datadogclient.InitDatadogMonitorClient(logr.Discard(), config.NewCredentialManager())
+	if err != nil {
+		return fmt.Errorf("unable to create Datadog API Client: %w", err)
+	}
+
+	m, _, err := ddClient.Client.GetMonitor(ddClient.Auth, o.monitorID)
+	if err != nil {
+		return fmt.Errorf("unable to get monitor %d: %w", o.monitorID, err)
+	}
+
+	out, err := toYAML(buildDatadogMonitor(m, o.name, o.namespace, !o.noAdopt))
+	if err != nil {
+		return err
+	}
+	_, err = o.Out.Write(out)
+
+	return err
+}
+
+// buildDatadogMonitor returns the DatadogMonitor managing the monitor
+func buildDatadogMonitor(m datadogV1.Monitor, name, namespace string, adopt bool) *v1alpha1.DatadogMonitor {
+	if name == "" {
+		name = monitorResourceName(m)
+	}
+
+	dm := &v1alpha1.DatadogMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogMonitor",
+			APIVersion: v1alpha1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: datadogmonitor.BuildDatadogMonitorSpec(m),
+	}
+	if adopt {
+		dm.Spec.ControllerOptions.AdoptMonitorID = apiutils.NewInt64Pointer(m.GetId())
+	}
+
+	return dm
+}
+
+// monitorResourceName derives a valid resource name from the monitor name
+func monitorResourceName(m datadogV1.Monitor) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(m.GetName()), "-"), "-")
+	if len(name) > maxNameLength {
+		name = strings.Trim(name[:maxNameLength], "-")
+	}
+	if name == "" {
+		name = fmt.Sprintf("monitor-%d", m.GetId())
+	}
+
+	return name
+}
+
+// toYAML marshals the DatadogMonitor without its status and the fields set by the API server
+func toYAML(dm *v1alpha1.DatadogMonitor) ([]byte, error) {
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(dm)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert DatadogMonitor: %w", err)
+	}
+	delete(obj, "status")
+	unstructured.RemoveNestedField(obj, "metadata", "creationTimestamp")
+
+	return yaml.Marshal(obj)
+}
@@ -0,0 +1,74 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package importer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+)
+
+func Test_monitorResourceName(t *testing.T) {
+	tests := []struct {
+		name        string
+		monitorName string
+		want        string
+	}{
+		{
+			name:        "simple name",
+			monitorName: "High CPU usage on {{host.name}}",
+			want:        "high-cpu-usage-on-host-name",
+		},
+		{
+			name:        "long name",
+			monitorName: "This monitor has a very long name that does not fit in a Kubernetes resource name",
+			want:        "this-monitor-has-a-very-long-name-that-does-not-fit-in-a-kubern",
+		},
+		{
+			name:        "no valid character",
+			monitorName: "[!]",
+			want:        "monitor-12345",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := datadogV1.NewMonitor("avg(last_5m):avg:system.cpu.user{*} > 90", datadogV1.MONITORTYPE_METRIC_ALERT)
+			m.SetId(12345)
+			m.SetName(tt.monitorName)
+			assert.Equal(t, tt.want, monitorResourceName(*m))
+		})
+	}
+}
+
+func Test_toYAML(t *testing.T) {
+	m := datadogV1.NewMonitor("avg(last_5m):avg:system.cpu.user{*} > 90", datadogV1.MONITORTYPE_METRIC_ALERT)
+	m.SetId(12345)
+	m.SetName("High CPU")
+	m.SetMessage("CPU is high @team")
+	m.SetTags([]string{"env:prod"})
+
+	out, err := toYAML(buildDatadogMonitor(*m, "", "monitoring", true))
+	assert.NoError(t, err)
+	assert.Equal(t, `apiVersion: datadoghq.com/v1alpha1
+kind: DatadogMonitor
+metadata:
+  name: high-cpu
+  namespace: monitoring
+spec:
+  controllerOptions:
+    adoptMonitorID: 12345
+  message: CPU is high @team
+  name: High CPU
+  options: {}
+  query: avg(last_5m):avg:system.cpu.user{*} > 90
+  tags:
+  - env:prod
+  type: metric alert
+`, string(out))
+}
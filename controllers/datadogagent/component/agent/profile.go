@@ -0,0 +1,167 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package agent
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+)
+
+// ProfileNodeSelectorRequirements returns the requirements a node must match to belong to the profile
+func ProfileNodeSelectorRequirements(profile *v2alpha1.DatadogAgentProfile) []corev1.NodeSelectorRequirement {
+	keys := make([]string, 0, len(profile.NodeSelector))
+	for key := range profile.NodeSelector {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	requirements := make([]corev1.NodeSelectorRequirement, 0, len(keys)+len(profile.NodeSelectorRequirements))
+	for _, key := range keys {
+		requirements = append(requirements, corev1.NodeSelectorRequirement{
+			Key:      key,
+			Operator: corev1.NodeSelectorOpIn,
+			Values:   []string{profile.NodeSelector[key]},
+		})
+	}
+	requirements = append(requirements, profile.NodeSelectorRequirements...)
+
+	return requirements
+}
+
+// ProfileNodeSelectorTerms returns the node selector terms matching the nodes of the profile
+func ProfileNodeSelectorTerms(profile *v2alpha1.DatadogAgentProfile) []corev1.NodeSelectorTerm {
+	return []corev1.NodeSelectorTerm{
+		{MatchExpressions: ProfileNodeSelectorRequirements(profile)},
+	}
+}
+
+// DefaultNodeSelectorTerms returns the node selector terms matching the nodes that don't belong to any of the profiles.
+// It returns nil when there is no profile.
+func DefaultNodeSelectorTerms(profiles []v2alpha1.DatadogAgentProfile) []corev1.NodeSelectorTerm {
+	var terms []corev1.NodeSelectorTerm
+	for i := range profiles {
+		// A node is outside of a profile as soon as it doesn't match one of its requirements.
+		var profileTerms []corev1.NodeSelectorTerm
+		for _, requirement := range ProfileNodeSelectorRequirements(&profiles[i]) {
+			profileTerms = append(profileTerms, corev1.NodeSelectorTerm{
+				MatchExpressions: []corev1.NodeSelectorRequirement{negateNodeSelectorRequirement(requirement)},
+			})
+		}
+		terms = intersectNodeSelectorTerms(terms, profileTerms)
+	}
+
+	return terms
+}
+
+// AddNodeSelectorTerms restricts the nodes selected by the affinity to the ones matching the terms.
+// The terms are combined with the required node selector terms already defined in the affinity.
+func AddNodeSelectorTerms(affinity *corev1.Affinity, terms []corev1.NodeSelectorTerm) *corev1.Affinity {
+	if len(terms) == 0 {
+		return affinity
+	}
+	if affinity == nil {
+		affinity = &corev1.Affinity{}
+	}
+	if affinity.NodeAffinity == nil {
+		affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	if affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+
+	nodeSelector := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	nodeSelector.NodeSelectorTerms = intersectNodeSelectorTerms(nodeSelector.NodeSelectorTerms, terms)
+
+	return affinity
+}
+
+// ProfileNodeLabelSelector returns the label selector matching the nodes of the profile
+func ProfileNodeLabelSelector(profile *v2alpha1.DatadogAgentProfile) *metav1.LabelSelector {
+	selector := &metav1.LabelSelector{}
+	for _, requirement := range ProfileNodeSelectorRequirements(profile) {
+		selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      requirement.Key,
+			Operator: metav1.LabelSelectorOperator(requirement.Operator),
+			Values:   requirement.Values,
+		})
+	}
+
+	return selector
+}
+
+// ProfileMatchesNode returns true if the node belongs to the profile
+func ProfileMatchesNode(profile *v2alpha1.DatadogAgentProfile, nodeLabels map[string]string) (bool, error) {
+	selector := labels.NewSelector()
+	for _, requirement := range ProfileNodeSelectorRequirements(profile) {
+		var op selection.Operator
+		switch requirement.Operator {
+		case corev1.NodeSelectorOpIn:
+			op = selection.In
+		case corev1.NodeSelectorOpNotIn:
+			op = selection.NotIn
+		case corev1.NodeSelectorOpExists:
+			op = selection.Exists
+		case corev1.NodeSelectorOpDoesNotExist:
+			op = selection.DoesNotExist
+		default:
+			return false, fmt.Errorf("profile %s: unsupported node selector operator %s", profile.Name, requirement.Operator)
+		}
+		req, err := labels.NewRequirement(requirement.Key, op, requirement.Values)
+		if err != nil {
+			return false, fmt.Errorf("profile %s: %w", profile.Name, err)
+		}
+		selector = selector.Add(*req)
+	}
+
+	return selector.Matches(labels.Set(nodeLabels)), nil
+}
+
+// negateNodeSelectorRequirement returns the requirement matching the nodes that don't match the given one
+func negateNodeSelectorRequirement(requirement corev1.NodeSelectorRequirement) corev1.NodeSelectorRequirement {
+	negated := *requirement.DeepCopy()
+	switch requirement.Operator {
+	case corev1.NodeSelectorOpIn:
+		negated.Operator = corev1.NodeSelectorOpNotIn
+	case corev1.NodeSelectorOpNotIn:
+		negated.Operator = corev1.NodeSelectorOpIn
+	case corev1.NodeSelectorOpExists:
+		negated.Operator = corev1.NodeSelectorOpDoesNotExist
+	case corev1.NodeSelectorOpDoesNotExist:
+		negated.Operator = corev1.NodeSelectorOpExists
+	}
+
+	return negated
+}
+
+// intersectNodeSelectorTerms returns the terms matching the nodes matched by both lists of terms.
+// Terms are ORed, and the requirements of a term are ANDed, so each term of the first list is combined with each term of the second one.
+func intersectNodeSelectorTerms(a, b []corev1.NodeSelectorTerm) []corev1.NodeSelectorTerm {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+
+	terms := make([]corev1.NodeSelectorTerm, 0, len(a)*len(b))
+	for _, termA := range a {
+		for _, termB := range b {
+			term := termA.DeepCopy()
+			term.MatchExpressions = append(term.MatchExpressions, termB.DeepCopy().MatchExpressions...)
+			term.MatchFields = append(term.MatchFields, termB.DeepCopy().MatchFields...)
+			terms = append(terms, *term)
+		}
+	}
+
+	return terms
+}
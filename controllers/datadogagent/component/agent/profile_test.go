@@ -0,0 +1,127 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package agent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+)
+
+var (
+	gpuProfile = v2alpha1.DatadogAgentProfile{
+		Name:         "gpu",
+		NodeSelector: map[string]string{"pool": "gpu"},
+	}
+	armProfile = v2alpha1.DatadogAgentProfile{
+		Name: "arm",
+		NodeSelectorRequirements: []corev1.NodeSelectorRequirement{
+			{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpIn, Values: []string{"arm64"}},
+			{Key: "dedicated", Operator: corev1.NodeSelectorOpDoesNotExist},
+		},
+	}
+)
+
+func TestProfileMatchesNode(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    v2alpha1.DatadogAgentProfile
+		nodeLabels map[string]string
+		want       bool
+	}{
+		{
+			name:       "node selector matches",
+			profile:    gpuProfile,
+			nodeLabels: map[string]string{"pool": "gpu", "kubernetes.io/arch": "amd64"},
+			want:       true,
+		},
+		{
+			name:       "node selector doesn't match",
+			profile:    gpuProfile,
+			nodeLabels: map[string]string{"pool": "default"},
+			want:       false,
+		},
+		{
+			name:       "requirements match",
+			profile:    armProfile,
+			nodeLabels: map[string]string{"kubernetes.io/arch": "arm64"},
+			want:       true,
+		},
+		{
+			name:       "one requirement doesn't match",
+			profile:    armProfile,
+			nodeLabels: map[string]string{"kubernetes.io/arch": "arm64", "dedicated": "true"},
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProfileMatchesNode(&tt.profile, tt.nodeLabels)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDefaultNodeSelectorTerms(t *testing.T) {
+	assert.Nil(t, DefaultNodeSelectorTerms(nil))
+
+	want := []corev1.NodeSelectorTerm{
+		{MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"gpu"}},
+			{Key: "kubernetes.io/arch", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"arm64"}},
+		}},
+		{MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"gpu"}},
+			{Key: "dedicated", Operator: corev1.NodeSelectorOpExists},
+		}},
+	}
+	assert.Equal(t, want, DefaultNodeSelectorTerms([]v2alpha1.DatadogAgentProfile{gpuProfile, armProfile}))
+}
+
+func TestAddNodeSelectorTerms(t *testing.T) {
+	affinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{MatchExpressions: []corev1.NodeSelectorRequirement{
+						{Key: "kubernetes.io/os", Operator: corev1.NodeSelectorOpIn, Values: []string{"linux"}},
+					}},
+				},
+			},
+		},
+	}
+
+	got := AddNodeSelectorTerms(affinity, ProfileNodeSelectorTerms(&gpuProfile))
+	want := []corev1.NodeSelectorTerm{
+		{MatchExpressions: []corev1.NodeSelectorRequirement{
+			{Key: "kubernetes.io/os", Operator: corev1.NodeSelectorOpIn, Values: []string{"linux"}},
+			{Key: "pool", Operator: corev1.NodeSelectorOpIn, Values: []string{"gpu"}},
+		}},
+	}
+	assert.Equal(t, want, got.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+
+	got = AddNodeSelectorTerms(nil, ProfileNodeSelectorTerms(&gpuProfile))
+	assert.Equal(t, ProfileNodeSelectorTerms(&gpuProfile), got.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms)
+
+	assert.Nil(t, AddNodeSelectorTerms(nil, nil))
+}
+
+func TestProfileNodeLabelSelector(t *testing.T) {
+	want := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "kubernetes.io/arch", Operator: metav1.LabelSelectorOpIn, Values: []string{"arm64"}},
+			{Key: "dedicated", Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	}
+	assert.Equal(t, want, ProfileNodeLabelSelector(&armProfile))
+}
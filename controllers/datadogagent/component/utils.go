@@ -6,7 +6,9 @@
 package component
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 
@@ -412,6 +414,80 @@ func BuildEnvVarFromSecret(name, key string) *corev1.EnvVarSource {
 	}
 }
 
+// logsAdditionalEndpoint is the format of an additional logs endpoint expected by the Agent
+type logsAdditionalEndpoint struct {
+	APIKey     string `json:"api_key"`
+	Host       string `json:"Host"`
+	Port       int    `json:"Port,omitempty"`
+	IsReliable bool   `json:"is_reliable"`
+}
+
+// GetAdditionalEndpointsEnvVars returns the environment variables configuring the additional endpoints of the given type in the `envVarName` environment variable.
+// The API keys are read from their secrets in dedicated environment variables, which are referenced by the `envVarName` one,
+// hence the environment variables must be added to the container in the returned order.
+// It returns nil when there is no additional endpoint of the given type.
+func GetAdditionalEndpointsEnvVars(endpoints []v2alpha1.AdditionalEndpoint, endpointType v2alpha1.AdditionalEndpointType, envVarName string) ([]*corev1.EnvVar, error) {
+	var envVars []*corev1.EnvVar
+	// Metrics, APM and processes additional endpoints are configured as a map of URL to API keys
+	urlToAPIKeys := map[string][]string{}
+	// Logs additional endpoints are configured as a list of endpoints
+	var logsEndpoints []logsAdditionalEndpoint
+
+	for _, endpoint := range endpoints {
+		if endpoint.Type != endpointType {
+			continue
+		}
+
+		keyName := endpoint.APISecret.KeyName
+		if keyName == "" {
+			keyName = apicommon.DefaultAPIKeyKey
+		}
+		apiKeyEnvVarName := fmt.Sprintf("%s_API_KEY_%d", strings.TrimPrefix(envVarName, "DD_"), len(envVars))
+		envVars = append(envVars, BuildEnvVarFromSource(apiKeyEnvVarName, BuildEnvVarFromSecret(endpoint.APISecret.SecretName, keyName)))
+		// Kubernetes expands the reference to the API key environment variable
+		apiKey := fmt.Sprintf("$(%s)", apiKeyEnvVarName)
+
+		if endpointType != v2alpha1.AdditionalEndpointTypeLogs {
+			urlToAPIKeys[endpoint.URL] = append(urlToAPIKeys[endpoint.URL], apiKey)
+			continue
+		}
+
+		logsEndpoint := logsAdditionalEndpoint{
+			APIKey:     apiKey,
+			Host:       strings.TrimPrefix(strings.TrimPrefix(endpoint.URL, "https://"), "http://"),
+			IsReliable: true,
+		}
+		if host, port, err := net.SplitHostPort(logsEndpoint.Host); err == nil {
+			logsEndpoint.Host = host
+			if logsEndpoint.Port, err = strconv.Atoi(port); err != nil {
+				return nil, fmt.Errorf("invalid port in the logs additional endpoint %s: %w", endpoint.URL, err)
+			}
+		}
+		logsEndpoints = append(logsEndpoints, logsEndpoint)
+	}
+
+	if len(envVars) == 0 {
+		return nil, nil
+	}
+
+	var value []byte
+	var err error
+	if endpointType == v2alpha1.AdditionalEndpointTypeLogs {
+		value, err = json.Marshal(logsEndpoints)
+	} else {
+		value, err = json.Marshal(urlToAPIKeys)
+	}
+	if err != nil {
+		return nil, err
+	}
+	envVars = append(envVars, &corev1.EnvVar{
+		Name:  envVarName,
+		Value: string(value),
+	})
+
+	return envVars, nil
+}
+
 // BuildKubernetesNetworkPolicy creates the base node agent kubernetes network policy
 func BuildKubernetesNetworkPolicy(dda metav1.Object, componentName v2alpha1.ComponentName) (string, string, metav1.LabelSelector, []netv1.PolicyType, []netv1.NetworkPolicyIngressRule, []netv1.NetworkPolicyEgressRule) {
 	policyName, podSelector := GetNetworkPolicyMetadata(dda, componentName)
@@ -1078,3 +1154,49 @@ func egressDCA(podSelector metav1.LabelSelector, nodeAgentPodSelector metav1.Lab
 		},
 	}
 }
+
+// GetContainerFiltersEnvVars returns the environment variables configuring the container filters.
+// The filters of each list are rendered in the `<type>:<regex>` space-separated format expected by the Agent.
+// Lists without filters don't produce any environment variable.
+func GetContainerFiltersEnvVars(filters *v2alpha1.ContainerFiltersConfig) []*corev1.EnvVar {
+	if filters == nil {
+		return nil
+	}
+
+	var envVars []*corev1.EnvVar
+	for _, signal := range []struct {
+		config         *v2alpha1.ContainerFilterConfig
+		includeEnvName string
+		excludeEnvName string
+	}{
+		{filters.All, apicommon.DDContainerInclude, apicommon.DDContainerExclude},
+		{filters.Metrics, apicommon.DDContainerIncludeMetrics, apicommon.DDContainerExcludeMetrics},
+		{filters.Logs, apicommon.DDContainerIncludeLogs, apicommon.DDContainerExcludeLogs},
+	} {
+		if signal.config == nil {
+			continue
+		}
+		if len(signal.config.Include) > 0 {
+			envVars = append(envVars, &corev1.EnvVar{
+				Name:  signal.includeEnvName,
+				Value: containerFiltersValue(signal.config.Include),
+			})
+		}
+		if len(signal.config.Exclude) > 0 {
+			envVars = append(envVars, &corev1.EnvVar{
+				Name:  signal.excludeEnvName,
+				Value: containerFiltersValue(signal.config.Exclude),
+			})
+		}
+	}
+
+	return envVars
+}
+
+func containerFiltersValue(filters []v2alpha1.ContainerFilter) string {
+	values := make([]string, 0, len(filters))
+	for _, filter := range filters {
+		values = append(values, fmt.Sprintf("%s:%s", filter.Type, filter.Value))
+	}
+	return strings.Join(values, " ")
+}
@@ -36,6 +36,7 @@ import (
 	_ "github.com/DataDog/datadog-operator/controllers/datadogagent/feature/admissioncontroller"
 	_ "github.com/DataDog/datadog-operator/controllers/datadogagent/feature/apm"
 	_ "github.com/DataDog/datadog-operator/controllers/datadogagent/feature/clusterchecks"
+	_ "github.com/DataDog/datadog-operator/controllers/datadogagent/feature/controlplanemonitoring"
 	_ "github.com/DataDog/datadog-operator/controllers/datadogagent/feature/cspm"
 	_ "github.com/DataDog/datadog-operator/controllers/datadogagent/feature/cws"
 	_ "github.com/DataDog/datadog-operator/controllers/datadogagent/feature/dogstatsd"
@@ -7,8 +7,10 @@ package datadogagent
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
 	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
 	apiutils "github.com/DataDog/datadog-operator/apis/utils"
@@ -20,19 +22,87 @@ import (
 	edsv1alpha1 "github.com/DataDog/extendeddaemonset/api/v1alpha1"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents feature.RequiredComponents, features []feature.Feature, dda *datadoghqv2alpha1.DatadogAgent, resourcesManager feature.ResourceManagers, newStatus *datadoghqv2alpha1.DatadogAgentStatus, requiredContainers []common.AgentContainerName) (reconcile.Result, error) {
+func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents feature.RequiredComponents, features []feature.Feature, featStatus *featuresStatus, dda *datadoghqv2alpha1.DatadogAgent, resourcesManager feature.ResourceManagers, newStatus *datadoghqv2alpha1.DatadogAgentStatus, requiredContainers []common.AgentContainerName) (reconcile.Result, error) {
+	if err := r.checkV2AgentProfilesOverlap(dda); err != nil {
+		datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(
+			newStatus,
+			metav1.NewTime(time.Now()),
+			datadoghqv2alpha1.AgentReconcileConditionType,
+			metav1.ConditionFalse,
+			"ProfilesConflict",
+			err.Error(),
+			true,
+		)
+		return reconcile.Result{}, err
+	}
+
+	// Start with the default Agent, it runs on the nodes that don't belong to any profile.
+	// A failing DaemonSet doesn't prevent the other ones from being reconciled.
+	var errs []error
+	result, err := r.reconcileV2AgentDaemonSet(logger, requiredComponents, features, featStatus, dda, resourcesManager, newStatus, requiredContainers, nil)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	for i := range dda.Spec.Profiles {
+		profile := &dda.Spec.Profiles[i]
+		// The Agent of the profile reports its state in the status of the profile, but shares the conditions with the default Agent
+		profileStatus := &datadoghqv2alpha1.DatadogAgentStatus{
+			Agent:      getProfileAgentStatus(newStatus, profile.Name),
+			Conditions: newStatus.Conditions,
+		}
+		profileResult, profileErr := r.reconcileV2AgentDaemonSet(logger, requiredComponents, features, featStatus, dda, resourcesManager, profileStatus, requiredContainers, profile)
+		newStatus.Conditions = profileStatus.Conditions
+		setProfileAgentStatus(newStatus, profile.Name, profileStatus.Agent)
+		if profileErr != nil {
+			errs = append(errs, profileErr)
+		}
+		result = mergeResults(result, profileResult)
+	}
+
+	if err = r.cleanupV2AgentProfiles(logger, dda, newStatus); err != nil {
+		errs = append(errs, err)
+	}
+
+	return result, utilerrors.NewAggregate(errs)
+}
+
+// manageNodeAgentFeatures applies the changes of all the features on the node Agent pod template, and returns their errors
+func manageNodeAgentFeatures(features []feature.Feature, featStatus *featuresStatus, podManagers feature.PodTemplateManagers) error {
+	var errs []error
+	for _, feat := range features {
+		if errFeat := featStatus.record(feat.ID(), "ManageNodeAgent", feat.ManageNodeAgent(podManagers)); errFeat != nil {
+			errs = append(errs, errFeat)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// reconcileV2AgentDaemonSet reconciles the Agent DaemonSet (or ExtendedDaemonSet) of a profile, or the default one when profile is nil
+func (r *Reconciler) reconcileV2AgentDaemonSet(logger logr.Logger, requiredComponents feature.RequiredComponents, features []feature.Feature, featStatus *featuresStatus, dda *datadoghqv2alpha1.DatadogAgent, resourcesManager feature.ResourceManagers, newStatus *datadoghqv2alpha1.DatadogAgentStatus, requiredContainers []common.AgentContainerName, profile *datadoghqv2alpha1.DatadogAgentProfile) (reconcile.Result, error) {
 	var result reconcile.Result
 	var eds *edsv1alpha1.ExtendedDaemonSet
 	var daemonset *appsv1.DaemonSet
 	var podManagers feature.PodTemplateManagers
 
 	daemonsetLogger := logger.WithValues("component", datadoghqv2alpha1.NodeAgentComponentName)
+	// The default Agent doesn't run on the nodes of the profiles
+	nodeSelectorTerms := componentagent.DefaultNodeSelectorTerms(dda.Spec.Profiles)
+	if profile != nil {
+		daemonsetLogger = daemonsetLogger.WithValues("profile", profile.Name)
+		nodeSelectorTerms = componentagent.ProfileNodeSelectorTerms(profile)
+	}
 
 	// requiredComponents needs to be taken into account in case a feature(s) changes and
 	// a requiredComponent becomes disabled, in addition to taking into account override.Disabled
@@ -46,13 +116,15 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 		podManagers = feature.NewPodTemplateManagers(&eds.Spec.Template)
 
 		// Set Global setting on the default extendeddaemonset
-		eds.Spec.Template = *override.ApplyGlobalSettings(logger, podManagers, dda, resourcesManager, datadoghqv2alpha1.NodeAgentComponentName)
+		podTemplate, err := override.ApplyGlobalSettings(logger, podManagers, dda, resourcesManager, datadoghqv2alpha1.NodeAgentComponentName)
+		if err != nil {
+			return result, err
+		}
+		eds.Spec.Template = *podTemplate
 
-		// Apply features changes on the Deployment.Spec.Template
-		for _, feat := range features {
-			if errFeat := feat.ManageNodeAgent(podManagers); errFeat != nil {
-				return result, errFeat
-			}
+		// Apply features changes on the Deployment.Spec.Template, the ExtendedDaemonSet isn't updated if one of them fails
+		if errFeat := manageNodeAgentFeatures(features, featStatus, podManagers); errFeat != nil {
+			return result, errFeat
 		}
 
 		// If Override is defined for the node agent component, apply the override on the PodTemplateSpec, it will cascade to container.
@@ -63,6 +135,20 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 			override.PodTemplateSpec(logger, podManagers, componentOverride, datadoghqv2alpha1.NodeAgentComponentName, dda.Name)
 			override.ExtendedDaemonSet(eds, componentOverride)
 		}
+		if profile != nil {
+			eds.Name = getProfileDaemonSetName(eds.Name, profile)
+			setProfileLabel(eds.Labels, eds.Spec.Template.Labels, profile)
+			// The pods of an ExtendedDaemonSet are selected by its name, its selector selects the nodes to roll out on
+			eds.Spec.Selector = componentagent.ProfileNodeLabelSelector(profile)
+			if profile.Override != nil {
+				if apiutils.BoolValue(profile.Override.Disabled) {
+					disabledByOverride = true
+				}
+				override.PodTemplateSpec(logger, podManagers, profile.Override, datadoghqv2alpha1.NodeAgentComponentName, dda.Name)
+				override.ExtendedDaemonSet(eds, profile.Override)
+			}
+		}
+		eds.Spec.Template.Spec.Affinity = componentagent.AddNodeSelectorTerms(eds.Spec.Template.Spec.Affinity, nodeSelectorTerms)
 		if disabledByOverride {
 			if agentEnabled {
 				// The override supersedes what's set in requiredComponents; update status to reflect the conflict
@@ -86,13 +172,15 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 	podManagers = feature.NewPodTemplateManagers(&daemonset.Spec.Template)
 
 	// Set Global setting on the default daemonset
-	daemonset.Spec.Template = *override.ApplyGlobalSettings(logger, podManagers, dda, resourcesManager, datadoghqv2alpha1.NodeAgentComponentName)
+	podTemplate, err := override.ApplyGlobalSettings(logger, podManagers, dda, resourcesManager, datadoghqv2alpha1.NodeAgentComponentName)
+	if err != nil {
+		return result, err
+	}
+	daemonset.Spec.Template = *podTemplate
 
-	// Apply features changes on the Deployment.Spec.Template
-	for _, feat := range features {
-		if errFeat := feat.ManageNodeAgent(podManagers); errFeat != nil {
-			return result, errFeat
-		}
+	// Apply features changes on the Deployment.Spec.Template, the DaemonSet isn't updated if one of them fails
+	if errFeat := manageNodeAgentFeatures(features, featStatus, podManagers); errFeat != nil {
+		return result, errFeat
 	}
 
 	// If Override is defined for the node agent component, apply the override on the PodTemplateSpec, it will cascade to container.
@@ -103,6 +191,21 @@ func (r *Reconciler) reconcileV2Agent(logger logr.Logger, requiredComponents fea
 		override.PodTemplateSpec(logger, podManagers, componentOverride, datadoghqv2alpha1.NodeAgentComponentName, dda.Name)
 		override.DaemonSet(daemonset, componentOverride)
 	}
+	if profile == nil {
+		excludeProfilePods(daemonset.Spec.Selector)
+	} else {
+		daemonset.Name = getProfileDaemonSetName(daemonset.Name, profile)
+		setProfileLabel(daemonset.Labels, daemonset.Spec.Template.Labels, profile)
+		setProfileLabel(daemonset.Spec.Selector.MatchLabels, nil, profile)
+		if profile.Override != nil {
+			if apiutils.BoolValue(profile.Override.Disabled) {
+				disabledByOverride = true
+			}
+			override.PodTemplateSpec(logger, podManagers, profile.Override, datadoghqv2alpha1.NodeAgentComponentName, dda.Name)
+			override.DaemonSet(daemonset, profile.Override)
+		}
+	}
+	daemonset.Spec.Template.Spec.Affinity = componentagent.AddNodeSelectorTerms(daemonset.Spec.Template.Spec.Affinity, nodeSelectorTerms)
 	if disabledByOverride {
 		if agentEnabled {
 			// The override supersedes what's set in requiredComponents; update status to reflect the conflict
@@ -182,3 +285,153 @@ func (r *Reconciler) cleanupV2ExtendedDaemonSet(logger logr.Logger, dda *datadog
 
 	return reconcile.Result{}, nil
 }
+
+// checkV2AgentProfilesOverlap returns an error if a node is selected by several profiles
+func (r *Reconciler) checkV2AgentProfilesOverlap(dda *datadoghqv2alpha1.DatadogAgent) error {
+	if len(dda.Spec.Profiles) < 2 {
+		return nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := r.client.List(context.TODO(), nodeList); err != nil {
+		return err
+	}
+
+	for _, node := range nodeList.Items {
+		matchingProfile := ""
+		for i := range dda.Spec.Profiles {
+			profile := &dda.Spec.Profiles[i]
+			match, err := componentagent.ProfileMatchesNode(profile, node.Labels)
+			if err != nil {
+				return err
+			}
+			if !match {
+				continue
+			}
+			if matchingProfile != "" {
+				return fmt.Errorf("node %s is selected by the profiles %s and %s", node.Name, matchingProfile, profile.Name)
+			}
+			matchingProfile = profile.Name
+		}
+	}
+
+	return nil
+}
+
+// cleanupV2AgentProfiles deletes the Agent DaemonSets (or ExtendedDaemonSets) of the profiles removed from the spec, and their status
+func (r *Reconciler) cleanupV2AgentProfiles(logger logr.Logger, dda *datadoghqv2alpha1.DatadogAgent, newStatus *datadoghqv2alpha1.DatadogAgentStatus) error {
+	profileNames := make([]string, 0, len(dda.Spec.Profiles))
+	for _, profile := range dda.Spec.Profiles {
+		profileNames = append(profileNames, profile.Name)
+	}
+
+	var profileStatuses []datadoghqv2alpha1.DatadogAgentProfileStatus
+	for _, profileStatus := range newStatus.Profiles {
+		for _, name := range profileNames {
+			if profileStatus.Name == name {
+				profileStatuses = append(profileStatuses, profileStatus)
+				break
+			}
+		}
+	}
+	newStatus.Profiles = profileStatuses
+
+	selector := labels.SelectorFromSet(labels.Set{
+		apicommon.AgentDeploymentNameLabelKey:      dda.Name,
+		apicommon.AgentDeploymentComponentLabelKey: apicommon.DefaultAgentResourceSuffix,
+	})
+	requirement, err := labels.NewRequirement(apicommon.AgentProfileLabelKey, selection.Exists, nil)
+	if err != nil {
+		return err
+	}
+	selector = selector.Add(*requirement)
+	if len(profileNames) > 0 {
+		requirement, err = labels.NewRequirement(apicommon.AgentProfileLabelKey, selection.NotIn, profileNames)
+		if err != nil {
+			return err
+		}
+		selector = selector.Add(*requirement)
+	}
+	listOptions := []client.ListOption{
+		client.InNamespace(dda.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	}
+
+	if r.options.ExtendedDaemonsetOptions.Enabled {
+		edsList := &edsv1alpha1.ExtendedDaemonSetList{}
+		if err = r.client.List(context.TODO(), edsList, listOptions...); err != nil {
+			return err
+		}
+		for i := range edsList.Items {
+			if _, err = r.cleanupV2ExtendedDaemonSet(logger, dda, &edsList.Items[i], &datadoghqv2alpha1.DatadogAgentStatus{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	dsList := &appsv1.DaemonSetList{}
+	if err = r.client.List(context.TODO(), dsList, listOptions...); err != nil {
+		return err
+	}
+	for i := range dsList.Items {
+		if _, err = r.cleanupV2DaemonSet(logger, dda, &dsList.Items[i], &datadoghqv2alpha1.DatadogAgentStatus{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getProfileDaemonSetName(name string, profile *datadoghqv2alpha1.DatadogAgentProfile) string {
+	return fmt.Sprintf("%s-%s", name, profile.Name)
+}
+
+func setProfileLabel(objLabels, templateLabels map[string]string, profile *datadoghqv2alpha1.DatadogAgentProfile) {
+	objLabels[apicommon.AgentProfileLabelKey] = profile.Name
+	if templateLabels != nil {
+		templateLabels[apicommon.AgentProfileLabelKey] = profile.Name
+	}
+}
+
+// excludeProfilePods prevents the selector of the default Agent DaemonSet from matching the pods of the profiles
+func excludeProfilePods(selector *metav1.LabelSelector) {
+	selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      apicommon.AgentProfileLabelKey,
+		Operator: metav1.LabelSelectorOpDoesNotExist,
+	})
+}
+
+// excludesProfilePods returns true if the pods of the profiles are excluded from the selector by excludeProfilePods
+func excludesProfilePods(selector *metav1.LabelSelector) bool {
+	if selector == nil {
+		return false
+	}
+	for _, requirement := range selector.MatchExpressions {
+		if requirement.Key == apicommon.AgentProfileLabelKey && requirement.Operator == metav1.LabelSelectorOpDoesNotExist {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getProfileAgentStatus(status *datadoghqv2alpha1.DatadogAgentStatus, name string) *common.DaemonSetStatus {
+	for _, profileStatus := range status.Profiles {
+		if profileStatus.Name == name {
+			return profileStatus.Agent
+		}
+	}
+
+	return nil
+}
+
+func setProfileAgentStatus(status *datadoghqv2alpha1.DatadogAgentStatus, name string, agentStatus *common.DaemonSetStatus) {
+	for i := range status.Profiles {
+		if status.Profiles[i].Name == name {
+			status.Profiles[i].Agent = agentStatus
+			return
+		}
+	}
+	status.Profiles = append(status.Profiles, datadoghqv2alpha1.DatadogAgentProfileStatus{Name: name, Agent: agentStatus})
+}
@@ -0,0 +1,171 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+)
+
+func Test_excludeProfilePods(t *testing.T) {
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			apicommon.AgentDeploymentNameLabelKey:      "foo",
+			apicommon.AgentDeploymentComponentLabelKey: apicommon.DefaultAgentResourceSuffix,
+		},
+	}
+	excludeProfilePods(selector)
+
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	require.NoError(t, err)
+
+	defaultPodLabels := labels.Set{
+		apicommon.AgentDeploymentNameLabelKey:      "foo",
+		apicommon.AgentDeploymentComponentLabelKey: apicommon.DefaultAgentResourceSuffix,
+	}
+	assert.True(t, labelSelector.Matches(defaultPodLabels))
+
+	profilePodLabels := labels.Set{
+		apicommon.AgentDeploymentNameLabelKey:      "foo",
+		apicommon.AgentDeploymentComponentLabelKey: apicommon.DefaultAgentResourceSuffix,
+		apicommon.AgentProfileLabelKey:             "gpu",
+	}
+	assert.False(t, labelSelector.Matches(profilePodLabels))
+}
+
+func Test_createOrUpdateDaemonset_excludeProfilePods(t *testing.T) {
+	tests := []struct {
+		name            string
+		currentExcludes bool
+		wantRecreate    bool
+	}{
+		{
+			name:            "default DaemonSet created before the profiles",
+			currentExcludes: false,
+			wantRecreate:    true,
+		},
+		{
+			name:            "default DaemonSet already excluding the profiles",
+			currentExcludes: true,
+			wantRecreate:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(s))
+			require.NoError(t, v2alpha1.AddToScheme(s))
+
+			dda := &v2alpha1.DatadogAgent{ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo"}}
+			current := newTestAgentDaemonSet()
+			if tt.currentExcludes {
+				excludeProfilePods(current.Spec.Selector)
+			}
+			c := fake.NewClientBuilder().WithScheme(s).WithObjects(current).Build()
+			r := &Reconciler{
+				client:   c,
+				scheme:   s,
+				recorder: record.NewFakeRecorder(10),
+			}
+
+			desired := newTestAgentDaemonSet()
+			desired.Spec.Template.Spec.Containers = []corev1.Container{{Name: "agent", Image: "agent:latest"}}
+			excludeProfilePods(desired.Spec.Selector)
+
+			result, err := r.createOrUpdateDaemonset(logr.Discard(), dda, desired.DeepCopy(), &v2alpha1.DatadogAgentStatus{}, updateDSStatusV2WithAgent)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRecreate, result.Requeue)
+
+			nsName := types.NamespacedName{Namespace: "bar", Name: "foo-agent"}
+			ds := &appsv1.DaemonSet{}
+			err = c.Get(context.TODO(), nsName, ds)
+			if tt.wantRecreate {
+				assert.True(t, apierrors.IsNotFound(err), "The DaemonSet should have been deleted")
+
+				// The DaemonSet is created again on the next reconcile
+				_, err = r.createOrUpdateDaemonset(logr.Discard(), dda, desired.DeepCopy(), &v2alpha1.DatadogAgentStatus{}, updateDSStatusV2WithAgent)
+				require.NoError(t, err)
+				require.NoError(t, c.Get(context.TODO(), nsName, ds))
+			} else {
+				require.NoError(t, err)
+			}
+			assert.True(t, excludesProfilePods(ds.Spec.Selector))
+			assert.Equal(t, desired.Spec.Template.Spec.Containers, ds.Spec.Template.Spec.Containers)
+		})
+	}
+}
+
+func newTestAgentDaemonSet() *appsv1.DaemonSet {
+	podLabels := map[string]string{
+		apicommon.AgentDeploymentNameLabelKey:      "foo",
+		apicommon.AgentDeploymentComponentLabelKey: apicommon.DefaultAgentResourceSuffix,
+	}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "bar", Name: "foo-agent"},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: podLabels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: podLabels},
+			},
+		},
+	}
+}
+
+func Test_mergeResults(t *testing.T) {
+	tests := []struct {
+		name string
+		a    reconcile.Result
+		b    reconcile.Result
+		want reconcile.Result
+	}{
+		{
+			name: "no requeue",
+			want: reconcile.Result{},
+		},
+		{
+			name: "requeue",
+			a:    reconcile.Result{Requeue: true},
+			want: reconcile.Result{Requeue: true},
+		},
+		{
+			name: "requeue after",
+			b:    reconcile.Result{RequeueAfter: time.Minute},
+			want: reconcile.Result{RequeueAfter: time.Minute},
+		},
+		{
+			name: "shortest requeue after",
+			a:    reconcile.Result{RequeueAfter: time.Minute},
+			b:    reconcile.Result{RequeueAfter: time.Second},
+			want: reconcile.Result{RequeueAfter: time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mergeResults(tt.a, tt.b))
+		})
+	}
+}
@@ -21,10 +21,11 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
-func (r *Reconciler) reconcileV2ClusterChecksRunner(logger logr.Logger, requiredComponents feature.RequiredComponents, features []feature.Feature, dda *datadoghqv2alpha1.DatadogAgent, resourcesManager feature.ResourceManagers, newStatus *datadoghqv2alpha1.DatadogAgentStatus) (reconcile.Result, error) {
+func (r *Reconciler) reconcileV2ClusterChecksRunner(logger logr.Logger, requiredComponents feature.RequiredComponents, features []feature.Feature, featStatus *featuresStatus, dda *datadoghqv2alpha1.DatadogAgent, resourcesManager feature.ResourceManagers, newStatus *datadoghqv2alpha1.DatadogAgentStatus) (reconcile.Result, error) {
 	var result reconcile.Result
 
 	// Start by creating the Default Cluster-Agent deployment
@@ -32,14 +33,22 @@ func (r *Reconciler) reconcileV2ClusterChecksRunner(logger logr.Logger, required
 	podManagers := feature.NewPodTemplateManagers(&deployment.Spec.Template)
 
 	// Set Global setting on the default deployment
-	deployment.Spec.Template = *override.ApplyGlobalSettings(logger, podManagers, dda, resourcesManager, datadoghqv2alpha1.ClusterChecksRunnerComponentName)
+	podTemplate, err := override.ApplyGlobalSettings(logger, podManagers, dda, resourcesManager, datadoghqv2alpha1.ClusterChecksRunnerComponentName)
+	if err != nil {
+		return result, err
+	}
+	deployment.Spec.Template = *podTemplate
 
-	// Apply features changes on the Deployment.Spec.Template
+	// Apply features changes on the Deployment.Spec.Template, the Deployment isn't updated if one of them fails
+	var errs []error
 	for _, feat := range features {
-		if errFeat := feat.ManageClusterChecksRunner(podManagers); errFeat != nil {
-			return result, errFeat
+		if errFeat := featStatus.record(feat.ID(), "ManageClusterChecksRunner", feat.ManageClusterChecksRunner(podManagers)); errFeat != nil {
+			errs = append(errs, errFeat)
 		}
 	}
+	if len(errs) > 0 {
+		return result, utilerrors.NewAggregate(errs)
+	}
 
 	deploymentLogger := logger.WithValues("component", datadoghqv2alpha1.ClusterChecksRunnerReconcileConditionType)
 
@@ -80,6 +89,16 @@ func (r *Reconciler) reconcileV2ClusterChecksRunner(logger logr.Logger, required
 		return r.cleanupV2ClusterChecksRunner(deploymentLogger, dda, deployment, newStatus)
 	}
 
+	// Add the PodDisruptionBudget of the deployment to the dependencies store
+	if err := addPDBV2(deployment, dda.Spec.Override[datadoghqv2alpha1.ClusterChecksRunnerComponentName], resourcesManager); err != nil {
+		return result, err
+	}
+
+	// Add the HorizontalPodAutoscaler of the deployment to the dependencies store
+	if err := addHPAV2(deployment, dda.Spec.Override[datadoghqv2alpha1.ClusterChecksRunnerComponentName], resourcesManager); err != nil {
+		return result, err
+	}
+
 	return r.createOrUpdateDeployment(deploymentLogger, dda, deployment, newStatus, updateStatusV2WithClusterChecksRunner)
 }
 
@@ -75,13 +75,13 @@ func (r *Reconciler) internalReconcileV2(ctx context.Context, request reconcile.
 		return result, err
 	}
 
-	// TODO check if IsValideDatadogAgent function is needed for v2
-	/*
-		if err = datadoghqv2alpha1.IsValidDatadogAgent(&instance.Spec); err != nil {
-			reqLogger.V(1).Info("Invalid spec", "error", err)
-			return r.updateStatusIfNeeded(reqLogger, instance, &instance.Status, result, err)
-		}
-	*/
+	// The spec is also validated by the validating webhook, when it is enabled
+	if err = instance.Validate(); err != nil {
+		reqLogger.V(1).Info("Invalid spec", "error", err)
+		newStatus := instance.Status.DeepCopy()
+		datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(newStatus, metav1.NewTime(time.Now()), datadoghqv2alpha1.ValidConditionType, metav1.ConditionFalse, "InvalidSpec", err.Error(), true)
+		return r.updateStatusIfNeededV2(reqLogger, instance, newStatus, result, err)
+	}
 
 	// Set default values for GlobalConfig and Features
 	instanceCopy := instance.DeepCopy()
@@ -93,8 +93,10 @@ func (r *Reconciler) internalReconcileV2(ctx context.Context, request reconcile.
 func (r *Reconciler) reconcileInstanceV2(ctx context.Context, logger logr.Logger, instance *datadoghqv2alpha1.DatadogAgent) (reconcile.Result, error) {
 	var result reconcile.Result
 	newStatus := instance.Status.DeepCopy()
+	datadoghqv2alpha1.UpdateDatadogAgentStatusConditions(newStatus, metav1.NewTime(time.Now()), datadoghqv2alpha1.ValidConditionType, metav1.ConditionTrue, "ValidSpec", "DatadogAgent spec is valid", true)
 
-	features, requiredComponents := feature.BuildFeatures(instance, reconcilerOptionsToFeatureOptions(&r.options, logger))
+	features, requiredComponents, featuresRequiredComponents := feature.BuildFeaturesWithRequiredComponents(instance, reconcilerOptionsToFeatureOptions(&r.options, logger))
+	featStatus := newFeaturesStatus(features, featuresRequiredComponents)
 	// update list of enabled features for metrics forwarder
 	r.updateMetricsForwardersFeatures(instance, features)
 
@@ -112,12 +114,13 @@ func (r *Reconciler) reconcileInstanceV2(ctx context.Context, logger logr.Logger
 	resourceManagers := feature.NewResourceManagers(depsStore)
 
 	var errs []error
+	var featErrs []error
 
-	// Set up dependencies required by enabled features
+	// Set up dependencies required by enabled features, a failing feature doesn't prevent the dependencies of the other ones to be applied
 	for _, feat := range features {
 		logger.V(1).Info("Dependency ManageDependencies", "featureID", feat.ID())
-		if featErr := feat.ManageDependencies(resourceManagers, requiredComponents); featErr != nil {
-			errs = append(errs, featErr)
+		if featErr := featStatus.record(feat.ID(), "ManageDependencies", feat.ManageDependencies(resourceManagers, requiredComponents)); featErr != nil {
+			featErrs = append(featErrs, featErr)
 		}
 	}
 
@@ -133,21 +136,30 @@ func (r *Reconciler) reconcileInstanceV2(ctx context.Context, logger logr.Logger
 	// Start reconcile Components
 	// -----------------------------
 
-	var err error
+	// A failing component doesn't prevent the other ones from being reconciled
+	var componentErrs []error
 
-	result, err = r.reconcileV2ClusterAgent(logger, requiredComponents, features, instance, resourceManagers, newStatus)
-	if utils.ShouldReturn(result, err) {
-		return r.updateStatusIfNeededV2(logger, instance, newStatus, result, err)
+	componentResult, err := r.reconcileV2ClusterAgent(logger, requiredComponents, features, featStatus, instance, resourceManagers, newStatus)
+	if err != nil {
+		componentErrs = append(componentErrs, err)
 	}
+	result = mergeResults(result, componentResult)
 
 	requiredContainers := requiredComponents.Agent.Containers
-	result, err = r.reconcileV2Agent(logger, requiredComponents, features, instance, resourceManagers, newStatus, requiredContainers)
-	if utils.ShouldReturn(result, err) {
-		return r.updateStatusIfNeededV2(logger, instance, newStatus, result, err)
+	componentResult, err = r.reconcileV2Agent(logger, requiredComponents, features, featStatus, instance, resourceManagers, newStatus, requiredContainers)
+	if err != nil {
+		componentErrs = append(componentErrs, err)
 	}
+	result = mergeResults(result, componentResult)
 
-	result, err = r.reconcileV2ClusterChecksRunner(logger, requiredComponents, features, instance, resourceManagers, newStatus)
-	if utils.ShouldReturn(result, err) {
+	componentResult, err = r.reconcileV2ClusterChecksRunner(logger, requiredComponents, features, featStatus, instance, resourceManagers, newStatus)
+	if err != nil {
+		componentErrs = append(componentErrs, err)
+	}
+	result = mergeResults(result, componentResult)
+
+	featStatus.update(newStatus, metav1.NewTime(time.Now()))
+	if err = errors.NewAggregate(componentErrs); utils.ShouldReturn(result, err) {
 		return r.updateStatusIfNeededV2(logger, instance, newStatus, result, err)
 	}
 
@@ -157,7 +169,13 @@ func (r *Reconciler) reconcileInstanceV2(ctx context.Context, logger logr.Logger
 	errs = append(errs, depsStore.Apply(ctx, r.client)...)
 	if len(errs) > 0 {
 		logger.V(2).Info("Dependencies apply error", "errs", errs)
-		return result, errors.NewAggregate(errs)
+		return r.updateStatusIfNeededV2(logger, instance, newStatus, result, errors.NewAggregate(append(errs, featErrs...)))
+	}
+
+	// The dependencies of the failing features may be missing from the store, don't clean them up
+	if len(featErrs) > 0 {
+		logger.V(2).Info("Feature dependencies error", "errs", featErrs)
+		return r.updateStatusIfNeededV2(logger, instance, newStatus, result, errors.NewAggregate(featErrs))
 	}
 
 	// -----------------------------
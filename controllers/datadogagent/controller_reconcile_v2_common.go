@@ -19,6 +19,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
@@ -158,6 +159,21 @@ func (r *Reconciler) createOrUpdateDaemonset(parentLogger logr.Logger, dda *data
 		}
 	}
 
+	if alreadyExists && excludesProfilePods(daemonset.Spec.Selector) && !excludesProfilePods(currentDaemonset.Spec.Selector) {
+		// Spec.Selector is immutable: the DaemonSet created before the pods of the profiles were excluded from its selector
+		// is deleted and created again. Its pods are orphaned, and adopted by the new DaemonSet.
+		logger.Info("Deleting Daemonset to update its selector")
+		err = r.client.Delete(context.TODO(), currentDaemonset, client.PropagationPolicy(metav1.DeletePropagationOrphan))
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		event := buildEventInfo(currentDaemonset.Name, currentDaemonset.Namespace, daemonSetKind, datadog.DeletionEvent)
+		r.recordEvent(dda, event)
+
+		// The DaemonSet is created once the deletion is complete
+		return reconcile.Result{Requeue: true}, nil
+	}
+
 	if alreadyExists {
 		// check if same hash
 		needUpdate := !comparison.IsSameSpecMD5Hash(hash, currentDaemonset.GetAnnotations())
@@ -306,3 +322,18 @@ func (r *Reconciler) createOrUpdateExtendedDaemonset(parentLogger logr.Logger, d
 
 	return result, err
 }
+
+// mergeResults returns a result requeuing as soon as one of the results requires it
+func mergeResults(a, b reconcile.Result) reconcile.Result {
+	result := reconcile.Result{Requeue: a.Requeue || b.Requeue}
+	switch {
+	case a.RequeueAfter == 0:
+		result.RequeueAfter = b.RequeueAfter
+	case b.RequeueAfter == 0 || a.RequeueAfter < b.RequeueAfter:
+		result.RequeueAfter = a.RequeueAfter
+	default:
+		result.RequeueAfter = b.RequeueAfter
+	}
+
+	return result
+}
@@ -6,6 +6,8 @@
 package admissioncontroller
 
 import (
+	"encoding/json"
+
 	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	"github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
@@ -33,11 +35,20 @@ type admissionControllerFeature struct {
 	agentCommunicationMode string
 	localServiceName       string
 	failurePolicy          string
+	apmInstrumentation     *apmInstrumentationConfig
 
 	serviceAccountName string
 	owner              metav1.Object
 }
 
+// apmInstrumentationConfig contains the configuration of the APM libraries injection
+type apmInstrumentationConfig struct {
+	enabledNamespaces  []string
+	disabledNamespaces []string
+	libVersions        map[string]string
+	registry           string
+}
+
 func buildAdmissionControllerFeature(options *feature.Options) feature.Feature {
 	return &admissionControllerFeature{}
 }
@@ -83,6 +94,19 @@ func (f *admissionControllerFeature) Configure(dda *v2alpha1.DatadogAgent) (reqC
 		if ac.WebhookName != nil {
 			f.webhookName = *ac.WebhookName
 		}
+
+		// APM libraries injection (single-step instrumentation)
+		apm := dda.Spec.Features.APM
+		if apm != nil && apiutils.BoolValue(apm.Enabled) && apm.Instrumentation != nil && apiutils.BoolValue(apm.Instrumentation.Enabled) {
+			f.apmInstrumentation = &apmInstrumentationConfig{
+				enabledNamespaces:  apm.Instrumentation.EnabledNamespaces,
+				disabledNamespaces: apm.Instrumentation.DisabledNamespaces,
+				libVersions:        apm.Instrumentation.LibVersions,
+			}
+			if apm.Instrumentation.Registry != nil {
+				f.apmInstrumentation.registry = *apm.Instrumentation.Registry
+			}
+		}
 	}
 	return reqComp
 }
@@ -129,7 +153,7 @@ func (f *admissionControllerFeature) ManageDependencies(managers feature.Resourc
 	}
 
 	// rbac
-	if err := managers.RBACManager().AddClusterPolicyRules(ns, rbacName, f.serviceAccountName, getRBACClusterPolicyRules(f.webhookName)); err != nil {
+	if err := managers.RBACManager().AddClusterPolicyRules(ns, rbacName, f.serviceAccountName, getRBACClusterPolicyRules(f.webhookName, f.apmInstrumentation != nil)); err != nil {
 		return err
 	}
 	return managers.RBACManager().AddPolicyRules(ns, rbacName, f.serviceAccountName, getRBACPolicyRules())
@@ -177,6 +201,60 @@ func (f *admissionControllerFeature) ManageClusterAgent(managers feature.PodTemp
 		Value: f.webhookName,
 	})
 
+	if f.apmInstrumentation != nil {
+		return f.manageAPMInstrumentation(managers)
+	}
+
+	return nil
+}
+
+// manageAPMInstrumentation configures the injection of the APM libraries by the Cluster Agent
+func (f *admissionControllerFeature) manageAPMInstrumentation(managers feature.PodTemplateManagers) error {
+	managers.EnvVar().AddEnvVarToContainer(common.ClusterAgentContainerName, &corev1.EnvVar{
+		Name:  apicommon.DDAPMInstrumentationEnabled,
+		Value: "true",
+	})
+
+	if len(f.apmInstrumentation.enabledNamespaces) > 0 {
+		enabledNamespaces, err := json.Marshal(f.apmInstrumentation.enabledNamespaces)
+		if err != nil {
+			return err
+		}
+		managers.EnvVar().AddEnvVarToContainer(common.ClusterAgentContainerName, &corev1.EnvVar{
+			Name:  apicommon.DDAPMInstrumentationEnabledNamespaces,
+			Value: string(enabledNamespaces),
+		})
+	}
+
+	if len(f.apmInstrumentation.disabledNamespaces) > 0 {
+		disabledNamespaces, err := json.Marshal(f.apmInstrumentation.disabledNamespaces)
+		if err != nil {
+			return err
+		}
+		managers.EnvVar().AddEnvVarToContainer(common.ClusterAgentContainerName, &corev1.EnvVar{
+			Name:  apicommon.DDAPMInstrumentationDisabledNamespaces,
+			Value: string(disabledNamespaces),
+		})
+	}
+
+	if len(f.apmInstrumentation.libVersions) > 0 {
+		libVersions, err := json.Marshal(f.apmInstrumentation.libVersions)
+		if err != nil {
+			return err
+		}
+		managers.EnvVar().AddEnvVarToContainer(common.ClusterAgentContainerName, &corev1.EnvVar{
+			Name:  apicommon.DDAPMInstrumentationLibVersions,
+			Value: string(libVersions),
+		})
+	}
+
+	if f.apmInstrumentation.registry != "" {
+		managers.EnvVar().AddEnvVarToContainer(common.ClusterAgentContainerName, &corev1.EnvVar{
+			Name:  apicommon.DDAdmissionControllerAutoInstrumentationRegistry,
+			Value: f.apmInstrumentation.registry,
+		})
+	}
+
 	return nil
 }
 
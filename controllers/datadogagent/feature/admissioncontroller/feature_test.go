@@ -13,13 +13,20 @@ import (
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
 	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	componentdca "github.com/DataDog/datadog-operator/controllers/datadogagent/component/clusteragent"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/dependencies"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/fake"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/test"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func TestAdmissionControllerFeature(t *testing.T) {
@@ -35,6 +42,19 @@ func TestAdmissionControllerFeature(t *testing.T) {
 		},
 	}
 
+	apmInstrumentation := &v2alpha1.APMFeatureConfig{
+		Enabled: apiutils.NewBoolPointer(true),
+		Instrumentation: &v2alpha1.APMInstrumentationConfig{
+			Enabled:            apiutils.NewBoolPointer(true),
+			DisabledNamespaces: []string{"kube-system", "datadog"},
+			LibVersions: map[string]string{
+				"java":   "v1.20.0",
+				"python": "v1",
+			},
+			Registry: apiutils.NewStringPointer("public.ecr.aws/datadog"),
+		},
+	}
+
 	tests := test.FeatureTestSuite{
 		//////////////////////////
 		// v1Alpha1.DatadogAgent
@@ -77,6 +97,71 @@ func TestAdmissionControllerFeature(t *testing.T) {
 			WantConfigure: true,
 			ClusterAgent:  testDCAResources("socket"),
 		},
+		{
+			Name:          "v2alpha1 admission controller enabled, apm instrumentation enabled",
+			DDAv2:         newV2Agent(true, "hostip", apmInstrumentation, &v2alpha1.DogstatsdFeatureConfig{}),
+			WantConfigure: true,
+			WantDependenciesFunc: func(t testing.TB, store dependencies.StoreClient) {
+				obj, found := store.Get(kubernetes.ClusterRolesKind, "", componentdca.GetClusterAgentRbacResourcesName(&metav1.ObjectMeta{}))
+				require.True(t, found, "Should have created the Cluster Agent ClusterRole")
+				assert.Contains(t, obj.(*rbacv1.ClusterRole).Rules, rbacv1.PolicyRule{
+					APIGroups: []string{rbac.CoreAPIGroup},
+					Resources: []string{rbac.PodsResource},
+					Verbs:     []string{rbac.GetVerb, rbac.ListVerb, rbac.WatchVerb, rbac.PatchVerb},
+				})
+			},
+			ClusterAgent: testDCAResources("hostip",
+				&corev1.EnvVar{
+					Name:  apicommon.DDAPMInstrumentationEnabled,
+					Value: "true",
+				},
+				&corev1.EnvVar{
+					Name:  apicommon.DDAPMInstrumentationDisabledNamespaces,
+					Value: `["kube-system","datadog"]`,
+				},
+				&corev1.EnvVar{
+					Name:  apicommon.DDAPMInstrumentationLibVersions,
+					Value: `{"java":"v1.20.0","python":"v1"}`,
+				},
+				&corev1.EnvVar{
+					Name:  apicommon.DDAdmissionControllerAutoInstrumentationRegistry,
+					Value: "public.ecr.aws/datadog",
+				},
+			),
+		},
+		{
+			Name: "v2alpha1 admission controller enabled, apm instrumentation enabled in some namespaces",
+			DDAv2: newV2Agent(true, "hostip", &v2alpha1.APMFeatureConfig{
+				Enabled: apiutils.NewBoolPointer(true),
+				Instrumentation: &v2alpha1.APMInstrumentationConfig{
+					Enabled:           apiutils.NewBoolPointer(true),
+					EnabledNamespaces: []string{"payments"},
+				},
+			}, &v2alpha1.DogstatsdFeatureConfig{}),
+			WantConfigure: true,
+			ClusterAgent: testDCAResources("hostip",
+				&corev1.EnvVar{
+					Name:  apicommon.DDAPMInstrumentationEnabled,
+					Value: "true",
+				},
+				&corev1.EnvVar{
+					Name:  apicommon.DDAPMInstrumentationEnabledNamespaces,
+					Value: `["payments"]`,
+				},
+			),
+		},
+		{
+			Name: "v2alpha1 admission controller enabled, apm instrumentation disabled",
+			DDAv2: newV2Agent(true, "hostip", &v2alpha1.APMFeatureConfig{
+				Enabled: apiutils.NewBoolPointer(true),
+				Instrumentation: &v2alpha1.APMInstrumentationConfig{
+					Enabled:            apiutils.NewBoolPointer(false),
+					DisabledNamespaces: []string{"kube-system"},
+				},
+			}, &v2alpha1.DogstatsdFeatureConfig{}),
+			WantConfigure: true,
+			ClusterAgent:  testDCAResources("hostip"),
+		},
 	}
 
 	tests.Run(t, buildAdmissionControllerFeature)
@@ -124,7 +209,7 @@ func newV2Agent(enabled bool, acm string, apm *v2alpha1.APMFeatureConfig, dsd *v
 	return dda
 }
 
-func testDCAResources(acm string) *test.ComponentTest {
+func testDCAResources(acm string, extraEnvs ...*corev1.EnvVar) *test.ComponentTest {
 	return test.NewDefaultComponentTest().WithWantFunc(
 		func(t testing.TB, mgrInterface feature.PodTemplateManagers) {
 			mgr := mgrInterface.(*fake.PodTemplateManagers)
@@ -159,6 +244,7 @@ func testDCAResources(acm string) *test.ComponentTest {
 				}
 				expectedAgentEnvs = append(expectedAgentEnvs, &acmEnv)
 			}
+			expectedAgentEnvs = append(expectedAgentEnvs, extraEnvs...)
 
 			assert.ElementsMatch(t,
 				agentEnvs,
@@ -12,8 +12,8 @@ import (
 	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
 )
 
-func getRBACClusterPolicyRules(webhookName string) []rbacv1.PolicyRule {
-	return []rbacv1.PolicyRule{
+func getRBACClusterPolicyRules(webhookName string, apmInstrumentation bool) []rbacv1.PolicyRule {
+	rules := []rbacv1.PolicyRule{
 		// MutatingWebhooksConfigs
 		{
 			APIGroups: []string{rbac.AdmissionAPIGroup},
@@ -73,6 +73,34 @@ func getRBACClusterPolicyRules(webhookName string) []rbacv1.PolicyRule {
 			},
 		},
 	}
+
+	if apmInstrumentation {
+		rules = append(rules,
+			// Namespaces, to select the namespaces to instrument
+			rbacv1.PolicyRule{
+				APIGroups: []string{rbac.CoreAPIGroup},
+				Resources: []string{rbac.NamespaceResource},
+				Verbs: []string{
+					rbac.GetVerb,
+					rbac.ListVerb,
+					rbac.WatchVerb,
+				},
+			},
+			// Pods, to patch the pods with the APM libraries
+			rbacv1.PolicyRule{
+				APIGroups: []string{rbac.CoreAPIGroup},
+				Resources: []string{rbac.PodsResource},
+				Verbs: []string{
+					rbac.GetVerb,
+					rbac.ListVerb,
+					rbac.WatchVerb,
+					rbac.PatchVerb,
+				},
+			},
+		)
+	}
+
+	return rules
 }
 
 func getRBACPolicyRules() []rbacv1.PolicyRule {
@@ -56,6 +56,8 @@ type apmFeature struct {
 	createKubernetesNetworkPolicy bool
 	createCiliumNetworkPolicy     bool
 	createSCC                     bool
+
+	additionalEndpoints []v2alpha1.AdditionalEndpoint
 }
 
 // ID returns the ID of the Feature
@@ -92,6 +94,10 @@ func (f *apmFeature) Configure(dda *v2alpha1.DatadogAgent) (reqComp feature.Requ
 
 		f.createSCC = v2alpha1.ShouldCreateSCC(dda, v2alpha1.NodeAgentComponentName)
 
+		if dda.Spec.Global != nil {
+			f.additionalEndpoints = dda.Spec.Global.AdditionalEndpoints
+		}
+
 		reqComp = feature.RequiredComponents{
 			Agent: feature.RequiredComponent{
 				IsRequired: apiutils.NewBoolPointer(true),
@@ -293,6 +299,15 @@ func (f *apmFeature) ManageNodeAgent(managers feature.PodTemplateManagers) error
 		managers.Volume().AddVolume(&socketVol)
 	}
 
+	// additional endpoints
+	endpointsEnvVars, err := component.GetAdditionalEndpointsEnvVars(f.additionalEndpoints, v2alpha1.AdditionalEndpointTypeAPM, apicommon.DDAPMAdditionalEndpoints)
+	if err != nil {
+		return err
+	}
+	for _, envVar := range endpointsEnvVars {
+		managers.EnvVar().AddEnvVarToContainer(apicommonv1.TraceAgentContainerName, envVar)
+	}
+
 	return nil
 }
 
@@ -70,6 +70,12 @@ func TestAPMFeature(t *testing.T) {
 			WantConfigure: true,
 			Agent:         testAgentHostPortUDS(),
 		},
+		{
+			Name:          "v2alpha1 apm enabled, with additional endpoints",
+			DDAv2:         newV2AgentWithAdditionalEndpoints(),
+			WantConfigure: true,
+			Agent:         testAgentAdditionalEndpoints(),
+		},
 	}
 
 	tests.Run(t, buildAPMFeature)
@@ -113,6 +119,23 @@ func newV2Agent(enableAPM bool, hostPort bool) *v2alpha1.DatadogAgent {
 	}
 }
 
+func newV2AgentWithAdditionalEndpoints() *v2alpha1.DatadogAgent {
+	dda := newV2Agent(true, false)
+	dda.Spec.Global.AdditionalEndpoints = []v2alpha1.AdditionalEndpoint{
+		{
+			Type:      v2alpha1.AdditionalEndpointTypeAPM,
+			URL:       "https://trace.agent.datadoghq.eu",
+			APISecret: apicommonv1.SecretConfig{SecretName: "datadog-eu"},
+		},
+		{
+			Type:      v2alpha1.AdditionalEndpointTypeMetrics,
+			URL:       "https://app.datadoghq.eu",
+			APISecret: apicommonv1.SecretConfig{SecretName: "datadog-eu"},
+		},
+	}
+	return dda
+}
+
 func testAgentHostPortOnly() *test.ComponentTest {
 	return test.NewDefaultComponentTest().WithWantFunc(
 		func(t testing.TB, mgrInterface feature.PodTemplateManagers) {
@@ -309,3 +332,41 @@ func testAgentHostPortUDS() *test.ComponentTest {
 		},
 	)
 }
+
+func testAgentAdditionalEndpoints() *test.ComponentTest {
+	return test.NewDefaultComponentTest().WithWantFunc(
+		func(t testing.TB, mgrInterface feature.PodTemplateManagers) {
+			mgr := mgrInterface.(*fake.PodTemplateManagers)
+
+			agentEnvs := mgr.EnvVarMgr.EnvVarsByC[apicommonv1.TraceAgentContainerName]
+			expectedAgentEnvs := []*corev1.EnvVar{
+				{
+					Name:  apicommon.DDAPMEnabled,
+					Value: "true",
+				},
+				{
+					Name:  apicommon.DDAPMReceiverSocket,
+					Value: apmSocketLocalPath,
+				},
+				{
+					Name: "APM_ADDITIONAL_ENDPOINTS_API_KEY_0",
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "datadog-eu"},
+							Key:                  apicommon.DefaultAPIKeyKey,
+						},
+					},
+				},
+				{
+					Name:  apicommon.DDAPMAdditionalEndpoints,
+					Value: `{"https://trace.agent.datadoghq.eu":["$(APM_ADDITIONAL_ENDPOINTS_API_KEY_0)"]}`,
+				},
+			}
+			assert.True(
+				t,
+				apiutils.IsEqualStruct(agentEnvs, expectedAgentEnvs),
+				"Trace Agent ENVs \ndiff = %s", cmp.Diff(agentEnvs, expectedAgentEnvs),
+			)
+		},
+	)
+}
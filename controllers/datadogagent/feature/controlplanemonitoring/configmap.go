@@ -0,0 +1,196 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controlplanemonitoring
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+const (
+	apiServerCheckName         = "kube_apiserver_metrics"
+	etcdCheckName              = "etcd"
+	schedulerCheckName         = "kube_scheduler"
+	controllerManagerCheckName = "kube_controller_manager"
+
+	kubeadmSchedulerPort         = "10259"
+	kubeadmControllerManagerPort = "10257"
+	kubeadmEtcdPort              = "2379"
+	openshiftEtcdMetricsPort     = "9979"
+
+	etcdCertsVolumeName          = "etcd-certs"
+	kubeadmEtcdCertsHostPath     = "/etc/kubernetes/pki/etcd"
+	kubeadmEtcdCertsMountPath    = "/host/etc/kubernetes/pki/etcd"
+	openshiftEtcdCertsSecretName = "etcd-metric-client"
+	openshiftEtcdCertsMountPath  = "/etc/etcd-certs"
+)
+
+// controlPlaneComponents lists the control plane components to monitor
+type controlPlaneComponents struct {
+	apiServer         bool
+	etcd              bool
+	scheduler         bool
+	controllerManager bool
+}
+
+// checkConfig is the configuration of the check of a control plane component
+type checkConfig struct {
+	name string
+	// clusterCheck is true when the check is dispatched by the Cluster Agent,
+	// false when the node Agent schedules it on the control plane pods.
+	clusterCheck bool
+	content      string
+}
+
+// getCheckConfigs returns the configuration of the checks of the control plane components exposed by the provider.
+func getCheckConfigs(provider kubernetes.ControlPlaneProvider, comps controlPlaneComponents) []checkConfig {
+	var checks []checkConfig
+
+	// The API server is reachable through the `kubernetes` service on every platform
+	if comps.apiServer {
+		checks = append(checks, checkConfig{
+			name:         apiServerCheckName,
+			clusterCheck: true,
+			content:      apiServerCheckConfig,
+		})
+	}
+
+	switch provider {
+	case kubernetes.OpenShiftControlPlaneProvider:
+		// The components are exposed by services, their endpoints are checked by the node Agents
+		if comps.etcd {
+			checks = append(checks, checkConfig{
+				name:         etcdCheckName,
+				clusterCheck: true,
+				content:      endpointsCheckConfig("openshift-etcd", "etcd", openshiftEtcdInstance()),
+			})
+		}
+		if comps.scheduler {
+			checks = append(checks, checkConfig{
+				name:         schedulerCheckName,
+				clusterCheck: true,
+				content:      endpointsCheckConfig("openshift-kube-scheduler", "scheduler", secureMetricsInstance("%%port%%")),
+			})
+		}
+		if comps.controllerManager {
+			checks = append(checks, checkConfig{
+				name:         controllerManagerCheckName,
+				clusterCheck: true,
+				content:      endpointsCheckConfig("openshift-kube-controller-manager", "kube-controller-manager", secureMetricsInstance("%%port%%")),
+			})
+		}
+	case kubernetes.DefaultControlPlaneProvider:
+		// The components run as static pods on the control plane nodes
+		if comps.etcd {
+			checks = append(checks, checkConfig{
+				name:    etcdCheckName,
+				content: nodeCheckConfig("etcd", kubeadmEtcdInstance()),
+			})
+		}
+		if comps.scheduler {
+			checks = append(checks, checkConfig{
+				name:    schedulerCheckName,
+				content: nodeCheckConfig("kube-scheduler", secureMetricsInstance(kubeadmSchedulerPort)),
+			})
+		}
+		if comps.controllerManager {
+			checks = append(checks, checkConfig{
+				name:    controllerManagerCheckName,
+				content: nodeCheckConfig("kube-controller-manager", secureMetricsInstance(kubeadmControllerManagerPort)),
+			})
+		}
+	}
+
+	return checks
+}
+
+// apiServerCheckConfig is dispatched by the Cluster Agent on the `kubernetes` service
+const apiServerCheckConfig = `---
+cluster_check: true
+advanced_ad_identifiers:
+  - kube_service:
+      name: kubernetes
+      namespace: default
+init_config:
+instances:
+  - prometheus_url: https://%%host%%:%%port%%/metrics
+    bearer_token_auth: true
+    tls_ca_cert: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
+`
+
+// endpointsCheckConfig returns the configuration of a check dispatched by the Cluster Agent on the endpoints of a service
+func endpointsCheckConfig(namespace, service, instance string) string {
+	return fmt.Sprintf(`---
+cluster_check: true
+advanced_ad_identifiers:
+  - kube_endpoints:
+      name: %s
+      namespace: %s
+init_config:
+instances:
+%s`, service, namespace, instance)
+}
+
+// nodeCheckConfig returns the configuration of a check scheduled by the node Agent on the containers of a static pod
+func nodeCheckConfig(adIdentifier, instance string) string {
+	return fmt.Sprintf(`---
+ad_identifiers:
+  - %s
+init_config:
+instances:
+%s`, adIdentifier, instance)
+}
+
+// secureMetricsInstance returns an instance scraping a metrics endpoint protected by the Kubernetes authentication
+func secureMetricsInstance(port string) string {
+	return `  - prometheus_url: https://%%host%%:` + port + `/metrics
+    bearer_token_auth: true
+    tls_verify: false
+`
+}
+
+func kubeadmEtcdInstance() string {
+	return `  - prometheus_url: https://%%host%%:` + kubeadmEtcdPort + `/metrics
+    tls_ca_cert: ` + kubeadmEtcdCertsMountPath + `/ca.crt
+    tls_cert: ` + kubeadmEtcdCertsMountPath + `/healthcheck-client.crt
+    tls_private_key: ` + kubeadmEtcdCertsMountPath + `/healthcheck-client.key
+`
+}
+
+func openshiftEtcdInstance() string {
+	return `  - prometheus_url: https://%%host%%:` + openshiftEtcdMetricsPort + `/metrics
+    tls_verify: false
+    tls_cert: ` + openshiftEtcdCertsMountPath + `/tls.crt
+    tls_private_key: ` + openshiftEtcdCertsMountPath + `/tls.key
+`
+}
+
+// getConfigMapName returns the name of the ConfigMap of a check
+func getConfigMapName(owner metav1.Object, checkName string) string {
+	return fmt.Sprintf("%s-%s-config", owner.GetName(), strings.ReplaceAll(checkName, "_", "-"))
+}
+
+// getVolumeName returns the name of the volume of the ConfigMap of a check
+func getVolumeName(checkName string) string {
+	return fmt.Sprintf("%s-config", strings.ReplaceAll(checkName, "_", "-"))
+}
+
+func buildCheckConfigMap(owner metav1.Object, check checkConfig) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getConfigMapName(owner, check.name),
+			Namespace: owner.GetNamespace(),
+		},
+		Data: map[string]string{
+			fmt.Sprintf("%s.yaml", check.name): check.content,
+		},
+	}
+}
@@ -0,0 +1,247 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controlplanemonitoring
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
+	apicommonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/merger"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/object"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/object/volume"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+func init() {
+	err := feature.Register(feature.ControlPlaneMonitoringIDType, buildControlPlaneMonitoringFeature)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func buildControlPlaneMonitoringFeature(options *feature.Options) feature.Feature {
+	controlPlaneFeat := &controlPlaneMonitoringFeature{}
+
+	if options != nil {
+		controlPlaneFeat.logger = options.Logger
+	}
+
+	return controlPlaneFeat
+}
+
+type controlPlaneMonitoringFeature struct {
+	components controlPlaneComponents
+
+	agentServiceAccountName               string
+	clusterAgentServiceAccountName        string
+	clusterChecksRunnerServiceAccountName string
+
+	owner  metav1.Object
+	logger logr.Logger
+
+	// configuredProvider overrides the provider detected from the platform of the cluster
+	configuredProvider kubernetes.ControlPlaneProvider
+	// etcdCertsSecretName is the Secret of the etcd client certificates on OpenShift
+	etcdCertsSecretName string
+
+	// provider and checks are set by ManageDependencies, from the platform of the cluster
+	provider              kubernetes.ControlPlaneProvider
+	checks                []checkConfig
+	checksAnnotationKey   string
+	checksAnnotationValue string
+}
+
+// ID returns the ID of the Feature
+func (f *controlPlaneMonitoringFeature) ID() feature.IDType {
+	return feature.ControlPlaneMonitoringIDType
+}
+
+// Configure is used to configure the feature from a v2alpha1.DatadogAgent instance.
+func (f *controlPlaneMonitoringFeature) Configure(dda *v2alpha1.DatadogAgent) (reqComp feature.RequiredComponents) {
+	f.owner = dda
+
+	controlPlane := dda.Spec.Features.ControlPlaneMonitoring
+	if controlPlane == nil || !apiutils.BoolValue(controlPlane.Enabled) {
+		return reqComp
+	}
+
+	f.components = controlPlaneComponents{
+		apiServer:         isComponentEnabled(controlPlane.APIServer),
+		etcd:              controlPlane.Etcd == nil || controlPlane.Etcd.Enabled == nil || *controlPlane.Etcd.Enabled,
+		scheduler:         isComponentEnabled(controlPlane.Scheduler),
+		controllerManager: isComponentEnabled(controlPlane.ControllerManager),
+	}
+	if f.components == (controlPlaneComponents{}) {
+		return reqComp
+	}
+	if controlPlane.Provider != nil {
+		f.configuredProvider = kubernetes.ControlPlaneProvider(*controlPlane.Provider)
+	}
+	f.etcdCertsSecretName = openshiftEtcdCertsSecretName
+	if controlPlane.Etcd != nil && controlPlane.Etcd.CertificatesSecretName != nil && *controlPlane.Etcd.CertificatesSecretName != "" {
+		f.etcdCertsSecretName = *controlPlane.Etcd.CertificatesSecretName
+	}
+
+	f.agentServiceAccountName = v2alpha1.GetAgentServiceAccount(dda)
+	f.clusterAgentServiceAccountName = v2alpha1.GetClusterAgentServiceAccount(dda)
+	f.clusterChecksRunnerServiceAccountName = v2alpha1.GetClusterChecksRunnerServiceAccount(dda)
+
+	reqComp = feature.RequiredComponents{
+		Agent: feature.RequiredComponent{
+			IsRequired: apiutils.NewBoolPointer(true),
+			Containers: []apicommonv1.AgentContainerName{
+				apicommonv1.CoreAgentContainerName,
+			},
+		},
+		ClusterAgent: feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+	}
+
+	return reqComp
+}
+
+// ConfigureV1 use to configure the feature from a v1alpha1.DatadogAgent instance.
+func (f *controlPlaneMonitoringFeature) ConfigureV1(dda *v1alpha1.DatadogAgent) (reqComp feature.RequiredComponents) {
+	return
+}
+
+// isComponentEnabled returns whether a control plane component is monitored, the components are monitored by default
+func isComponentEnabled(config *v2alpha1.ControlPlaneComponentConfig) bool {
+	return config == nil || config.Enabled == nil || *config.Enabled
+}
+
+// ManageDependencies allows a feature to manage its dependencies.
+// Feature's dependencies should be added in the store.
+func (f *controlPlaneMonitoringFeature) ManageDependencies(managers feature.ResourceManagers, components feature.RequiredComponents) error {
+	f.provider = f.configuredProvider
+	if f.provider == "" {
+		platformInfo := managers.Store().GetPlatformInfo()
+		f.provider = platformInfo.GetControlPlaneProvider()
+	}
+	f.checks = getCheckConfigs(f.provider, f.components)
+
+	checksContent := make(map[string]string, len(f.checks))
+	for _, check := range f.checks {
+		if err := managers.Store().AddOrUpdate(kubernetes.ConfigMapKind, buildCheckConfigMap(f.owner, check)); err != nil {
+			return err
+		}
+		checksContent[check.name] = check.content
+	}
+
+	// Restart the Agents when the checks configuration changes
+	hash, err := comparison.GenerateMD5ForSpec(checksContent)
+	if err != nil {
+		f.logger.Error(err, "couldn't generate hash for control plane checks config")
+	} else {
+		f.checksAnnotationKey = object.GetChecksumAnnotationKey(feature.ControlPlaneMonitoringIDType)
+		f.checksAnnotationValue = hash
+	}
+
+	// Manage RBAC permission
+	if err := managers.RBACManager().AddClusterPolicyRules(f.owner.GetNamespace(), getRBACResourceName(f.owner, agentRBACSuffix), f.agentServiceAccountName, getCheckRunnerRBACPolicyRules()); err != nil {
+		return err
+	}
+	if err := managers.RBACManager().AddClusterPolicyRules(f.owner.GetNamespace(), getRBACResourceName(f.owner, clusterAgentRBACSuffix), f.clusterAgentServiceAccountName, getClusterAgentRBACPolicyRules()); err != nil {
+		return err
+	}
+	if components.ClusterChecksRunner.IsEnabled() {
+		return managers.RBACManager().AddClusterPolicyRules(f.owner.GetNamespace(), getRBACResourceName(f.owner, clusterChecksRunnerRBACSuffix), f.clusterChecksRunnerServiceAccountName, getCheckRunnerRBACPolicyRules())
+	}
+
+	return nil
+}
+
+// ManageClusterAgent allows a feature to configure the ClusterAgent's corev1.PodTemplateSpec
+// It should do nothing if the feature doesn't need to configure it.
+func (f *controlPlaneMonitoringFeature) ManageClusterAgent(managers feature.PodTemplateManagers) error {
+	f.addCheckConfigVolumes(managers, apicommonv1.ClusterAgentContainerName, true)
+
+	return nil
+}
+
+// ManageNodeAgent allows a feature to configure the Node Agent's corev1.PodTemplateSpec
+// It should do nothing if the feature doesn't need to configure it.
+func (f *controlPlaneMonitoringFeature) ManageNodeAgent(managers feature.PodTemplateManagers) error {
+	f.addCheckConfigVolumes(managers, apicommonv1.CoreAgentContainerName, false)
+
+	if f.components.apiServer {
+		// The API server is monitored by a cluster check, not by the default node check
+		ignoreAutoConf := &corev1.EnvVar{
+			Name:  apicommon.DDIgnoreAutoConf,
+			Value: apiServerCheckName,
+		}
+		if err := managers.EnvVar().AddEnvVarToContainerWithMergeFunc(apicommonv1.CoreAgentContainerName, ignoreAutoConf, merger.AppendToValueEnvVarMergeFunction); err != nil {
+			return err
+		}
+	}
+
+	// etcd client certificates
+	if f.components.etcd {
+		switch f.provider {
+		case kubernetes.DefaultControlPlaneProvider:
+			vol, volMount := volume.GetVolumes(etcdCertsVolumeName, kubeadmEtcdCertsHostPath, kubeadmEtcdCertsMountPath, true)
+			managers.VolumeMount().AddVolumeMountToContainer(&volMount, apicommonv1.CoreAgentContainerName)
+			managers.Volume().AddVolume(&vol)
+		case kubernetes.OpenShiftControlPlaneProvider:
+			// The Secret is required: the etcd check can't connect without the client certificates
+			vol := corev1.Volume{
+				Name: etcdCertsVolumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName: f.etcdCertsSecretName,
+					},
+				},
+			}
+			volMount := corev1.VolumeMount{
+				Name:      etcdCertsVolumeName,
+				MountPath: openshiftEtcdCertsMountPath,
+				ReadOnly:  true,
+			}
+			managers.VolumeMount().AddVolumeMountToContainer(&volMount, apicommonv1.CoreAgentContainerName)
+			managers.Volume().AddVolume(&vol)
+		}
+	}
+
+	return nil
+}
+
+// ManageClusterChecksRunner allows a feature to configure the ClusterChecksRunner's corev1.PodTemplateSpec
+// It should do nothing if the feature doesn't need to configure it.
+func (f *controlPlaneMonitoringFeature) ManageClusterChecksRunner(managers feature.PodTemplateManagers) error {
+	return nil
+}
+
+// addCheckConfigVolumes mounts the ConfigMaps of the cluster checks or of the node checks in the conf.d folder of a container
+func (f *controlPlaneMonitoringFeature) addCheckConfigVolumes(managers feature.PodTemplateManagers, containerName apicommonv1.AgentContainerName, clusterChecks bool) {
+	added := false
+	for _, check := range f.checks {
+		if check.clusterCheck != clusterChecks {
+			continue
+		}
+		vol := volume.GetBasicVolume(getConfigMapName(f.owner, check.name), getVolumeName(check.name))
+		volMount := corev1.VolumeMount{
+			Name:      getVolumeName(check.name),
+			MountPath: fmt.Sprintf("%s%s/%s.d", apicommon.ConfigVolumePath, apicommon.ConfdVolumePath, check.name),
+			ReadOnly:  true,
+		}
+		managers.VolumeMount().AddVolumeMountToContainer(&volMount, containerName)
+		managers.Volume().AddVolume(&vol)
+		added = true
+	}
+
+	if added && f.checksAnnotationKey != "" && f.checksAnnotationValue != "" {
+		managers.Annotation().AddAnnotation(f.checksAnnotationKey, f.checksAnnotationValue)
+	}
+}
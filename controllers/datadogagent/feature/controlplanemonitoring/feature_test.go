@@ -0,0 +1,218 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controlplanemonitoring
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+
+	apicommonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/dependencies"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/fake"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/test"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+func TestControlPlaneMonitoringFeature(t *testing.T) {
+	tests := test.FeatureTestSuite{
+		{
+			Name:          "v2alpha1 control plane monitoring not enabled",
+			DDAv2:         newV2Agent(false, nil),
+			WantConfigure: false,
+		},
+		{
+			Name:          "v2alpha1 control plane monitoring enabled, all components disabled",
+			DDAv2:         newV2Agent(true, apiutils.NewBoolPointer(false)),
+			WantConfigure: false,
+		},
+		{
+			Name:          "v2alpha1 control plane monitoring enabled, kubeadm",
+			DDAv2:         newV2Agent(true, nil),
+			StoreOption:   storeOptions("v1.26.3", nil),
+			WantConfigure: true,
+			WantDependenciesFunc: wantConfigMaps(map[string]string{
+				"datadog-kube-apiserver-metrics-config":  "cluster_check: true",
+				"datadog-etcd-config":                    "tls_cert: /host/etc/kubernetes/pki/etcd/healthcheck-client.crt",
+				"datadog-kube-scheduler-config":          "https://%%host%%:10259/metrics",
+				"datadog-kube-controller-manager-config": "https://%%host%%:10257/metrics",
+			}),
+			ClusterAgent: testVolumes(apicommonv1.ClusterAgentContainerName, []string{
+				"kube-apiserver-metrics-config",
+			}),
+			Agent: testVolumes(apicommonv1.CoreAgentContainerName, []string{
+				"etcd-config",
+				"kube-scheduler-config",
+				"kube-controller-manager-config",
+				etcdCertsVolumeName,
+			}),
+		},
+		{
+			Name:          "v2alpha1 control plane monitoring enabled, OpenShift",
+			DDAv2:         newV2Agent(true, nil),
+			StoreOption:   storeOptions("v1.25.4+77bec7a", map[string]string{"ClusterVersion": "config.openshift.io/v1"}),
+			WantConfigure: true,
+			WantDependenciesFunc: wantConfigMaps(map[string]string{
+				"datadog-kube-apiserver-metrics-config":  "name: kubernetes",
+				"datadog-etcd-config":                    "namespace: openshift-etcd",
+				"datadog-kube-scheduler-config":          "namespace: openshift-kube-scheduler",
+				"datadog-kube-controller-manager-config": "namespace: openshift-kube-controller-manager",
+			}),
+			ClusterAgent: testVolumes(apicommonv1.ClusterAgentContainerName, []string{
+				"kube-apiserver-metrics-config",
+				"etcd-config",
+				"kube-scheduler-config",
+				"kube-controller-manager-config",
+			}),
+			Agent: testEtcdCertsSecret(openshiftEtcdCertsSecretName),
+		},
+		{
+			Name:          "v2alpha1 control plane monitoring enabled, OpenShift, configured etcd certificates Secret",
+			DDAv2:         newV2AgentWithEtcdCertsSecret("etcd-client-certs"),
+			StoreOption:   storeOptions("v1.25.4+77bec7a", map[string]string{"ClusterVersion": "config.openshift.io/v1"}),
+			WantConfigure: true,
+			Agent:         testEtcdCertsSecret("etcd-client-certs"),
+		},
+		{
+			Name:          "v2alpha1 control plane monitoring enabled, managed control plane",
+			DDAv2:         newV2Agent(true, nil),
+			StoreOption:   storeOptions("v1.24.10-eks-48e63af", nil),
+			WantConfigure: true,
+			WantDependenciesFunc: func(t testing.TB, store dependencies.StoreClient) {
+				_, found := store.Get(kubernetes.ConfigMapKind, "", "datadog-kube-apiserver-metrics-config")
+				assert.True(t, found, "Should have created the API server check ConfigMap")
+				_, found = store.Get(kubernetes.ConfigMapKind, "", "datadog-etcd-config")
+				assert.False(t, found, "Shouldn't have created the etcd check ConfigMap")
+			},
+			ClusterAgent: testVolumes(apicommonv1.ClusterAgentContainerName, []string{
+				"kube-apiserver-metrics-config",
+			}),
+			Agent: testVolumes(apicommonv1.CoreAgentContainerName, nil),
+		},
+		{
+			Name:          "v2alpha1 control plane monitoring enabled, configured managed control plane",
+			DDAv2:         newV2AgentWithProvider(v2alpha1.ControlPlaneProviderManaged),
+			StoreOption:   storeOptions("v1.27.3", nil),
+			WantConfigure: true,
+			WantDependenciesFunc: func(t testing.TB, store dependencies.StoreClient) {
+				_, found := store.Get(kubernetes.ConfigMapKind, "", "datadog-kube-apiserver-metrics-config")
+				assert.True(t, found, "Should have created the API server check ConfigMap")
+				_, found = store.Get(kubernetes.ConfigMapKind, "", "datadog-etcd-config")
+				assert.False(t, found, "Shouldn't have created the etcd check ConfigMap")
+			},
+			ClusterAgent: testVolumes(apicommonv1.ClusterAgentContainerName, []string{
+				"kube-apiserver-metrics-config",
+			}),
+			Agent: testVolumes(apicommonv1.CoreAgentContainerName, nil),
+		},
+	}
+
+	tests.Run(t, buildControlPlaneMonitoringFeature)
+}
+
+func newV2AgentWithProvider(provider v2alpha1.ControlPlaneProvider) *v2alpha1.DatadogAgent {
+	dda := newV2Agent(true, nil)
+	dda.Spec.Features.ControlPlaneMonitoring.Provider = &provider
+	return dda
+}
+
+func newV2AgentWithEtcdCertsSecret(secretName string) *v2alpha1.DatadogAgent {
+	dda := newV2Agent(true, nil)
+	dda.Spec.Features.ControlPlaneMonitoring.Etcd.CertificatesSecretName = apiutils.NewStringPointer(secretName)
+	return dda
+}
+
+func newV2Agent(enabled bool, componentsEnabled *bool) *v2alpha1.DatadogAgent {
+	return &v2alpha1.DatadogAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "datadog",
+		},
+		Spec: v2alpha1.DatadogAgentSpec{
+			Features: &v2alpha1.DatadogFeatures{
+				ControlPlaneMonitoring: &v2alpha1.ControlPlaneMonitoringFeatureConfig{
+					Enabled:           apiutils.NewBoolPointer(enabled),
+					APIServer:         &v2alpha1.ControlPlaneComponentConfig{Enabled: componentsEnabled},
+					Etcd:              &v2alpha1.EtcdMonitoringConfig{Enabled: componentsEnabled},
+					Scheduler:         &v2alpha1.ControlPlaneComponentConfig{Enabled: componentsEnabled},
+					ControllerManager: &v2alpha1.ControlPlaneComponentConfig{Enabled: componentsEnabled},
+				},
+			},
+			Global: &v2alpha1.GlobalConfig{},
+		},
+	}
+}
+
+func storeOptions(gitVersion string, preferredVersions map[string]string) *dependencies.StoreOptions {
+	versionInfo := &version.Info{GitVersion: gitVersion}
+	return &dependencies.StoreOptions{
+		VersionInfo:  versionInfo,
+		PlatformInfo: kubernetes.NewPlatformInfoFromVersionMaps(versionInfo, preferredVersions, map[string]string{}),
+		Logger:       logr.Discard(),
+	}
+}
+
+// wantConfigMaps checks that the ConfigMaps of the checks are created and contain a line of their expected configuration
+func wantConfigMaps(configMaps map[string]string) func(testing.TB, dependencies.StoreClient) {
+	return func(t testing.TB, store dependencies.StoreClient) {
+		for name, content := range configMaps {
+			obj, found := store.Get(kubernetes.ConfigMapKind, "", name)
+			require.True(t, found, "Should have created the ConfigMap %s", name)
+			configMap := obj.(*corev1.ConfigMap)
+			require.Len(t, configMap.Data, 1)
+			for _, data := range configMap.Data {
+				assert.Contains(t, data, content)
+			}
+		}
+	}
+}
+
+func testVolumes(containerName apicommonv1.AgentContainerName, wantVolumes []string) *test.ComponentTest {
+	return test.NewDefaultComponentTest().WithWantFunc(
+		func(t testing.TB, mgrInterface feature.PodTemplateManagers) {
+			mgr := mgrInterface.(*fake.PodTemplateManagers)
+
+			var volumes []string
+			for _, vol := range mgr.VolumeMgr.Volumes {
+				volumes = append(volumes, vol.Name)
+			}
+			assert.Equal(t, wantVolumes, volumes)
+
+			var volumeMounts []string
+			for _, volMount := range mgr.VolumeMountMgr.VolumeMountsByC[containerName] {
+				volumeMounts = append(volumeMounts, volMount.Name)
+			}
+			assert.Equal(t, wantVolumes, volumeMounts)
+		},
+	)
+}
+
+// testEtcdCertsSecret checks that the etcd client certificates are mounted from the required Secret
+func testEtcdCertsSecret(secretName string) *test.ComponentTest {
+	return test.NewDefaultComponentTest().WithWantFunc(
+		func(t testing.TB, mgrInterface feature.PodTemplateManagers) {
+			mgr := mgrInterface.(*fake.PodTemplateManagers)
+
+			require.Len(t, mgr.VolumeMgr.Volumes, 1)
+			volume := mgr.VolumeMgr.Volumes[0]
+			assert.Equal(t, etcdCertsVolumeName, volume.Name)
+			require.NotNil(t, volume.Secret)
+			assert.Equal(t, secretName, volume.Secret.SecretName)
+			assert.Nil(t, volume.Secret.Optional, "The etcd certificates Secret should be required")
+
+			mounts := mgr.VolumeMountMgr.VolumeMountsByC[apicommonv1.CoreAgentContainerName]
+			require.Len(t, mounts, 1)
+			assert.Equal(t, etcdCertsVolumeName, mounts[0].Name)
+		},
+	)
+}
@@ -0,0 +1,54 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controlplanemonitoring
+
+import (
+	"fmt"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
+)
+
+const (
+	controlPlaneMonitoringRBACPrefix = "control-plane-monitoring"
+
+	agentRBACSuffix               = "agent"
+	clusterAgentRBACSuffix        = "cluster-agent"
+	clusterChecksRunnerRBACSuffix = "cluster-checks-runner"
+)
+
+// getRBACResourceName return the RBAC resources name
+func getRBACResourceName(owner metav1.Object, suffix string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", owner.GetNamespace(), owner.GetName(), controlPlaneMonitoringRBACPrefix, suffix)
+}
+
+// getCheckRunnerRBACPolicyRules returns the rules required by the Agents running the checks:
+// the scheduler and the controller manager authorize the access to their metrics endpoint with the Kubernetes RBAC.
+func getCheckRunnerRBACPolicyRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			NonResourceURLs: []string{rbac.MetricsURL},
+			Verbs:           []string{rbac.GetVerb},
+		},
+	}
+}
+
+// getClusterAgentRBACPolicyRules returns the rules required by the Cluster Agent to dispatch the checks
+// on the services and endpoints of the control plane components.
+func getClusterAgentRBACPolicyRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{rbac.CoreAPIGroup},
+			Resources: []string{
+				rbac.ServicesResource,
+				rbac.EndpointsResource,
+			},
+			Verbs: []string{rbac.GetVerb, rbac.ListVerb, rbac.WatchVerb},
+		},
+	}
+}
@@ -7,6 +7,8 @@ package enabledefault
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
@@ -21,10 +23,12 @@ import (
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/object"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
 	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
 	"github.com/DataDog/datadog-operator/pkg/version"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/errors"
 )
@@ -65,6 +69,7 @@ type defaultFeature struct {
 	clusterAgent        clusterAgentConfig
 	agent               agentConfig
 	clusterChecksRunner clusterChecksRunnerConfig
+	secretBackend       *v2alpha1.SecretBackendConfig
 	logger              logr.Logger
 
 	customConfigAnnotationKey   string
@@ -176,6 +181,8 @@ func (f *defaultFeature) Configure(dda *v2alpha1.DatadogAgent) feature.RequiredC
 		}
 		f.customConfigAnnotationValue = hash
 		f.customConfigAnnotationKey = object.GetChecksumAnnotationKey(string(feature.DefaultIDType))
+
+		f.secretBackend = dda.Spec.Global.SecretBackend
 	}
 
 	return feature.RequiredComponents{
@@ -296,6 +303,70 @@ func (f *defaultFeature) ManageDependencies(managers feature.ResourceManagers, c
 		}
 	}
 
+	if f.secretBackend != nil {
+		if err := f.secretBackendDependencies(managers, components); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.NewAggregate(errs)
+}
+
+// secretBackendDependencies grants the ServiceAccounts of the enabled components
+// read access to the Secrets resolved by the secret backend.
+func (f *defaultFeature) secretBackendDependencies(managers feature.ResourceManagers, components feature.RequiredComponents) error {
+	var serviceAccounts []string
+	if components.Agent.IsEnabled() && f.agent.serviceAccountName != "" {
+		serviceAccounts = append(serviceAccounts, f.agent.serviceAccountName)
+	}
+	if components.ClusterAgent.IsEnabled() && f.clusterAgent.serviceAccountName != "" {
+		serviceAccounts = append(serviceAccounts, f.clusterAgent.serviceAccountName)
+	}
+	if components.ClusterChecksRunner.IsEnabled() && f.clusterChecksRunner.serviceAccountName != "" {
+		serviceAccounts = append(serviceAccounts, f.clusterChecksRunner.serviceAccountName)
+	}
+	if len(serviceAccounts) == 0 {
+		return nil
+	}
+
+	var errs []error
+	roleName := getSecretBackendRbacResourcesName(f.owner)
+
+	// Role creation, in each namespace containing Secrets to read
+	for _, role := range f.secretBackend.Roles {
+		if err := managers.RBACManager().AddRole(role.Namespace, roleName, getSecretBackendPolicyRules(role.Secrets)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		roleRef := rbacv1.RoleRef{
+			APIGroup: rbac.RbacAPIGroup,
+			Kind:     rbac.RoleKind,
+			Name:     roleName,
+		}
+		for _, saName := range serviceAccounts {
+			if err := managers.RBACManager().AddRoleBinding(role.Namespace, roleName, f.owner.GetNamespace(), saName, roleRef); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	// ClusterRole creation
+	if apiutils.BoolValue(f.secretBackend.EnableGlobalPermissions) {
+		if err := managers.RBACManager().AddClusterPolicyRules(f.owner.GetNamespace(), roleName, serviceAccounts[0], getSecretBackendPolicyRules(nil)); err != nil {
+			errs = append(errs, err)
+		}
+		roleRef := rbacv1.RoleRef{
+			APIGroup: rbac.RbacAPIGroup,
+			Kind:     rbac.ClusterRoleKind,
+			Name:     roleName,
+		}
+		for _, saName := range serviceAccounts[1:] {
+			if err := managers.RBACManager().AddClusterRoleBinding(f.owner.GetNamespace(), roleName, saName, roleRef); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	return errors.NewAggregate(errs)
 }
 
@@ -421,6 +492,43 @@ func (f *defaultFeature) addDefaultCommonEnvs(managers feature.PodTemplateManage
 		appKeyEnvVar := component.BuildEnvVarFromSource(apicommon.DDAppKey, component.BuildEnvVarFromSecret(f.credentialsInfo.appKey.SecretName, f.credentialsInfo.appKey.SecretKey))
 		managers.EnvVar().AddEnvVar(appKeyEnvVar)
 	}
+
+	if f.secretBackend != nil && f.secretBackend.Command != nil {
+		managers.EnvVar().AddEnvVar(&corev1.EnvVar{
+			Name:  apicommon.DDSecretBackendCommand,
+			Value: *f.secretBackend.Command,
+		})
+
+		if len(f.secretBackend.Args) > 0 {
+			managers.EnvVar().AddEnvVar(&corev1.EnvVar{
+				Name:  apicommon.DDSecretBackendArguments,
+				Value: strings.Join(f.secretBackend.Args, " "),
+			})
+		}
+
+		if f.secretBackend.Timeout != nil {
+			managers.EnvVar().AddEnvVar(&corev1.EnvVar{
+				Name:  apicommon.DDSecretBackendTimeout,
+				Value: strconv.FormatInt(int64(*f.secretBackend.Timeout), 10),
+			})
+		}
+	}
+}
+
+func getSecretBackendRbacResourcesName(dda metav1.Object) string {
+	return fmt.Sprintf("%s-secret-backend", dda.GetName())
+}
+
+// getSecretBackendPolicyRules returns the policy rules to read the given Secrets, or all the Secrets if none is given
+func getSecretBackendPolicyRules(secretNames []string) []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups:     []string{rbac.CoreAPIGroup},
+			Resources:     []string{rbac.SecretsResource},
+			ResourceNames: secretNames,
+			Verbs:         []string{rbac.GetVerb},
+		},
+	}
 }
 
 func buildInstallInfoConfigMap(dda metav1.Object) *corev1.ConfigMap {
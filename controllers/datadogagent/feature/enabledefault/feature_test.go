@@ -0,0 +1,175 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package enabledefault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/dependencies"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/fake"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/test"
+	mergerfake "github.com/DataDog/datadog-operator/controllers/datadogagent/merger/fake"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
+)
+
+const (
+	ddaName      = "datadog"
+	ddaNamespace = "datadog-ns"
+)
+
+func Test_defaultFeature_SecretBackend(t *testing.T) {
+	requiredComponents := feature.RequiredComponents{
+		Agent:        feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+		ClusterAgent: feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+	}
+
+	tests := test.FeatureTestSuite{
+		{
+			Name: "v2alpha1 secret backend command",
+			DDAv2: newV2Agent(&v2alpha1.SecretBackendConfig{
+				Command: apiutils.NewStringPointer("/readsecret_multiple_providers.sh"),
+				Args:    []string{"--debug", "--timeout=10"},
+				Timeout: apiutils.NewInt32Pointer(60),
+			}),
+			RequiredComponents: requiredComponents,
+			WantConfigure:      true,
+			WantDependenciesFunc: func(t testing.TB, store dependencies.StoreClient) {
+				_, found := store.Get(kubernetes.ClusterRolesKind, "", getSecretBackendRbacResourcesName(newV2Agent(nil)))
+				assert.False(t, found, "Shouldn't have created the secret backend ClusterRole")
+			},
+			Agent:               secretBackendEnvVarsTest("/readsecret_multiple_providers.sh", "--debug --timeout=10", "60"),
+			ClusterAgent:        secretBackendEnvVarsTest("/readsecret_multiple_providers.sh", "--debug --timeout=10", "60"),
+			ClusterChecksRunner: secretBackendEnvVarsTest("/readsecret_multiple_providers.sh", "--debug --timeout=10", "60"),
+		},
+		{
+			Name: "v2alpha1 secret backend without command",
+			DDAv2: newV2Agent(&v2alpha1.SecretBackendConfig{
+				Roles: []v2alpha1.SecretBackendRolesConfig{
+					{Namespace: "secrets-ns", Secrets: []string{"api-key"}},
+				},
+			}),
+			RequiredComponents: requiredComponents,
+			WantConfigure:      true,
+			Agent:              secretBackendEnvVarsTest("", "", ""),
+		},
+		{
+			Name: "v2alpha1 secret backend roles",
+			DDAv2: newV2Agent(&v2alpha1.SecretBackendConfig{
+				Command: apiutils.NewStringPointer("/readsecret_multiple_providers.sh"),
+				Roles: []v2alpha1.SecretBackendRolesConfig{
+					{Namespace: "secrets-ns", Secrets: []string{"api-key", "app-key"}},
+				},
+			}),
+			RequiredComponents: requiredComponents,
+			WantConfigure:      true,
+			WantDependenciesFunc: func(t testing.TB, store dependencies.StoreClient) {
+				dda := newV2Agent(nil)
+				name := getSecretBackendRbacResourcesName(dda)
+
+				obj, found := store.Get(kubernetes.RolesKind, "secrets-ns", name)
+				require.True(t, found, "Should have created the secret backend Role")
+				assert.Equal(t, getSecretBackendPolicyRules([]string{"api-key", "app-key"}), obj.(*rbacv1.Role).Rules)
+
+				obj, found = store.Get(kubernetes.RoleBindingKind, "secrets-ns", name)
+				require.True(t, found, "Should have created the secret backend RoleBinding")
+				roleBinding := obj.(*rbacv1.RoleBinding)
+				assert.Equal(t, rbacv1.RoleRef{APIGroup: rbac.RbacAPIGroup, Kind: rbac.RoleKind, Name: name}, roleBinding.RoleRef)
+				assert.Equal(t, []rbacv1.Subject{
+					{Kind: rbac.ServiceAccountKind, Name: v2alpha1.GetAgentServiceAccount(dda), Namespace: ddaNamespace},
+					{Kind: rbac.ServiceAccountKind, Name: v2alpha1.GetClusterAgentServiceAccount(dda), Namespace: ddaNamespace},
+				}, roleBinding.Subjects)
+
+				_, found = store.Get(kubernetes.ClusterRolesKind, "", name)
+				assert.False(t, found, "Shouldn't have created the secret backend ClusterRole")
+			},
+			Agent: secretBackendEnvVarsTest("/readsecret_multiple_providers.sh", "", ""),
+		},
+		{
+			Name: "v2alpha1 secret backend global permissions",
+			DDAv2: newV2Agent(&v2alpha1.SecretBackendConfig{
+				Command:                 apiutils.NewStringPointer("/readsecret_multiple_providers.sh"),
+				EnableGlobalPermissions: apiutils.NewBoolPointer(true),
+			}),
+			RequiredComponents: requiredComponents,
+			WantConfigure:      true,
+			WantDependenciesFunc: func(t testing.TB, store dependencies.StoreClient) {
+				dda := newV2Agent(nil)
+				name := getSecretBackendRbacResourcesName(dda)
+
+				obj, found := store.Get(kubernetes.ClusterRolesKind, "", name)
+				require.True(t, found, "Should have created the secret backend ClusterRole")
+				assert.Equal(t, getSecretBackendPolicyRules(nil), obj.(*rbacv1.ClusterRole).Rules)
+
+				obj, found = store.Get(kubernetes.ClusterRoleBindingKind, "", name)
+				require.True(t, found, "Should have created the secret backend ClusterRoleBinding")
+				clusterRoleBinding := obj.(*rbacv1.ClusterRoleBinding)
+				assert.Equal(t, rbacv1.RoleRef{APIGroup: rbac.RbacAPIGroup, Kind: rbac.ClusterRoleKind, Name: name}, clusterRoleBinding.RoleRef)
+				assert.Equal(t, []rbacv1.Subject{
+					{Kind: rbac.ServiceAccountKind, Name: v2alpha1.GetAgentServiceAccount(dda), Namespace: ddaNamespace},
+					{Kind: rbac.ServiceAccountKind, Name: v2alpha1.GetClusterAgentServiceAccount(dda), Namespace: ddaNamespace},
+				}, clusterRoleBinding.Subjects)
+
+				_, found = store.Get(kubernetes.RolesKind, "secrets-ns", name)
+				assert.False(t, found, "Shouldn't have created the secret backend Role")
+			},
+			Agent: secretBackendEnvVarsTest("/readsecret_multiple_providers.sh", "", ""),
+		},
+	}
+
+	tests.Run(t, buildDefaultFeature)
+}
+
+func newV2Agent(secretBackend *v2alpha1.SecretBackendConfig) *v2alpha1.DatadogAgent {
+	return &v2alpha1.DatadogAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ddaName,
+			Namespace: ddaNamespace,
+		},
+		Spec: v2alpha1.DatadogAgentSpec{
+			Global: &v2alpha1.GlobalConfig{
+				Credentials: &v2alpha1.DatadogCredentials{
+					APIKey: apiutils.NewStringPointer("0000000000000000000000"),
+				},
+				SecretBackend: secretBackend,
+			},
+		},
+	}
+}
+
+// secretBackendEnvVarsTest checks the secret backend environment variables, an empty value means the environment variable isn't set
+func secretBackendEnvVarsTest(command, args, timeout string) *test.ComponentTest {
+	return test.NewDefaultComponentTest().WithWantFunc(
+		func(t testing.TB, mgrInterface feature.PodTemplateManagers) {
+			mgr := mgrInterface.(*fake.PodTemplateManagers)
+			envVars := mgr.EnvVarMgr.EnvVarsByC[mergerfake.AllContainers]
+
+			for name, value := range map[string]string{
+				apicommon.DDSecretBackendCommand:   command,
+				apicommon.DDSecretBackendArguments: args,
+				apicommon.DDSecretBackendTimeout:   timeout,
+			} {
+				if value == "" {
+					for _, envVar := range envVars {
+						assert.NotEqual(t, name, envVar.Name, "%s shouldn't be set", name)
+					}
+					continue
+				}
+				assert.Contains(t, envVars, &corev1.EnvVar{Name: name, Value: value})
+			}
+		},
+	)
+}
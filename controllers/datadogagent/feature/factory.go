@@ -32,11 +32,18 @@ func Register(id IDType, buildFunc BuildFunc) error {
 
 // BuildFeatures use to build a list features depending of the v2alpha1.DatadogAgent instance
 func BuildFeatures(dda *v2alpha1.DatadogAgent, options *Options) ([]Feature, RequiredComponents) {
+	features, requiredComponents, _ := BuildFeaturesWithRequiredComponents(dda, options)
+	return features, requiredComponents
+}
+
+// BuildFeaturesWithRequiredComponents is similar to BuildFeatures, but it also returns the RequiredComponents of each feature
+func BuildFeaturesWithRequiredComponents(dda *v2alpha1.DatadogAgent, options *Options) ([]Feature, RequiredComponents, map[IDType]RequiredComponents) {
 	builderMutex.RLock()
 	defer builderMutex.RUnlock()
 
 	var output []Feature
 	var requiredComponents RequiredComponents
+	featuresRequiredComponents := make(map[IDType]RequiredComponents)
 
 	// to always return in feature in the same order we need to sort the map keys
 	sortedkeys := make([]IDType, 0, len(featureBuilders))
@@ -53,11 +60,12 @@ func BuildFeatures(dda *v2alpha1.DatadogAgent, options *Options) ([]Feature, Req
 		// only add feature to the output if one of the components is configured (but not necessarily required)
 		if reqComponents.IsConfigured() {
 			output = append(output, feat)
+			featuresRequiredComponents[feat.ID()] = reqComponents
 		}
 		requiredComponents.Merge(&reqComponents)
 	}
 
-	return output, requiredComponents
+	return output, requiredComponents, featuresRequiredComponents
 }
 
 // BuildFeaturesV1 use to build a list features depending of the v1alpha1.DatadogAgent instance
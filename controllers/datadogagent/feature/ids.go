@@ -57,6 +57,8 @@ const (
 	RemoteConfigurationIDType = "remote_config"
 	// SBOMIDType SBOM collection feature
 	SBOMIDType = "sbom"
+	// ControlPlaneMonitoringIDType Control Plane Monitoring feature
+	ControlPlaneMonitoringIDType = "control_plane_monitoring"
 	// DummyIDType Dummy feature.
 	DummyIDType = "dummy"
 )
@@ -8,10 +8,15 @@ package kubernetesstatecore
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/object/configmap"
+	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
 )
 
 func (f *ksmFeature) buildKSMCoreConfigMap(collectorOpts collectorOptions) (*corev1.ConfigMap, error) {
@@ -22,7 +27,11 @@ func (f *ksmFeature) buildKSMCoreConfigMap(collectorOpts collectorOptions) (*cor
 		return configmap.BuildConfigMapConfigData(f.owner.GetNamespace(), f.customConfig.ConfigData, f.configConfigMapName, ksmCoreCheckName)
 	}
 
-	configMap := buildDefaultConfigMap(f.owner.GetNamespace(), f.configConfigMapName, ksmCheckConfig(f.runInClusterChecksRunner, collectorOpts))
+	config, err := ksmCheckConfig(f.runInClusterChecksRunner, collectorOpts, f.instanceOpts)
+	if err != nil {
+		return nil, err
+	}
+	configMap := buildDefaultConfigMap(f.owner.GetNamespace(), f.configConfigMapName, config)
 	return configMap, nil
 }
 
@@ -39,13 +48,37 @@ func buildDefaultConfigMap(namespace, cmName string, content string) *corev1.Con
 	return configMap
 }
 
+// defaultCollectors lists the resources collected by default by the check.
+var defaultCollectors = []string{
+	"pods",
+	"replicationcontrollers",
+	"statefulsets",
+	"nodes",
+	"cronjobs",
+	"jobs",
+	"replicasets",
+	"deployments",
+	"configmaps",
+	"services",
+	"endpoints",
+	"daemonsets",
+	"horizontalpodautoscalers",
+	"limitranges",
+	"resourcequotas",
+	"secrets",
+	"namespaces",
+	"persistentvolumeclaims",
+	"persistentvolumes",
+	"ingresses",
+}
+
 // KSM should be configured as a cluster check only when there are Cluster Check
 // Runners deployed.
 // This check is not designed to work on the DaemonSet Agent. That's why when
 // cluster checks are enabled but without Cluster Check Runners, we don't want
 // to set this check as a cluster check, because then it would be scheduled in
 // the DaemonSet agent instead of the DCA.
-func ksmCheckConfig(clusterCheck bool, collectorOpts collectorOptions) string {
+func ksmCheckConfig(clusterCheck bool, collectorOpts collectorOptions, instanceOpts instanceOptions) (string, error) {
 	stringVal := strconv.FormatBool(clusterCheck)
 	config := fmt.Sprintf(`---
 cluster_check: %s
@@ -53,39 +86,179 @@ init_config:
 instances:
   - skip_leader_election: %s
     collectors:
-    - pods
-    - replicationcontrollers
-    - statefulsets
-    - nodes
-    - cronjobs
-    - jobs
-    - replicasets
-    - deployments
-    - configmaps
-    - services
-    - endpoints
-    - daemonsets
-    - horizontalpodautoscalers
-    - limitranges
-    - resourcequotas
-    - secrets
-    - namespaces
-    - persistentvolumeclaims
-    - persistentvolumes
-    - ingresses
 `, stringVal, stringVal)
 
+	for _, collector := range getCollectors(collectorOpts) {
+		config += fmt.Sprintf("    - %s\n", collector)
+	}
+
+	extraConfig, err := instanceOpts.toYAML()
+	if err != nil {
+		return "", err
+	}
+	// The extra fields of the instance are indented under the list item
+	for _, line := range strings.SplitAfter(extraConfig, "\n") {
+		if line != "" {
+			config += "    " + line
+		}
+	}
+
+	return config, nil
+}
+
+// getCollectors returns the default collectors, with the optional ones and the ones enabled by the user,
+// without the ones disabled by the user.
+func getCollectors(collectorOpts collectorOptions) []string {
+	collectors := append([]string{}, defaultCollectors...)
+
 	if collectorOpts.enableVPA {
-		config += "    - verticalpodautoscalers\n"
+		collectors = append(collectors, "verticalpodautoscalers")
 	}
 
 	if collectorOpts.enableAPIService {
-		config += "    - apiservices\n"
+		collectors = append(collectors, "apiservices")
 	}
 
 	if collectorOpts.enableCRD {
-		config += "    - customresourcedefinitions\n"
+		collectors = append(collectors, "customresourcedefinitions")
+	}
+
+	for _, collector := range collectorOpts.enabledCollectors {
+		if !ctrutils.ContainsString(collectors, collector) {
+			collectors = append(collectors, collector)
+		}
+	}
+
+	enabledCollectors := make([]string, 0, len(collectors))
+	for _, collector := range collectors {
+		if !ctrutils.ContainsString(collectorOpts.disabledCollectors, collector) {
+			enabledCollectors = append(enabledCollectors, collector)
+		}
+	}
+
+	return enabledCollectors
+}
+
+// instanceOptions contains the optional fields of the check instance
+type instanceOptions struct {
+	LabelsAsTags      map[string]map[string]string `json:"labels_as_tags,omitempty"`
+	AnnotationsAsTags map[string]map[string]string `json:"annotations_as_tags,omitempty"`
+	Namespaces        []string                     `json:"namespaces,omitempty"`
+	NamespacesExclude []string                     `json:"namespaces_exclude,omitempty"`
+	CustomResource    *customResourceStateConfig   `json:"custom_resource,omitempty"`
+}
+
+// customResourceStateConfig follows the kube-state-metrics custom resource state configuration format
+// https://github.com/kubernetes/kube-state-metrics/blob/main/docs/customresourcestate-metrics.md
+type customResourceStateConfig struct {
+	Spec customResourceStateSpec `json:"spec"`
+}
+
+type customResourceStateSpec struct {
+	Resources []customResourceStateResource `json:"resources"`
+}
+
+type customResourceStateResource struct {
+	GroupVersionKind struct {
+		Group   string `json:"group"`
+		Version string `json:"version"`
+		Kind    string `json:"kind"`
+	} `json:"groupVersionKind"`
+	ResourcePlural   string                      `json:"resourcePlural,omitempty"`
+	MetricNamePrefix *string                     `json:"metricNamePrefix,omitempty"`
+	LabelsFromPath   map[string][]string         `json:"labelsFromPath,omitempty"`
+	Metrics          []customResourceStateMetric `json:"metrics"`
+}
+
+type customResourceStateMetric struct {
+	Name string                        `json:"name"`
+	Help string                        `json:"help,omitempty"`
+	Each customResourceStateMetricEach `json:"each"`
+}
+
+type customResourceStateMetricEach struct {
+	Type     string                          `json:"type"`
+	Gauge    *customResourceStateMetricValue `json:"gauge,omitempty"`
+	StateSet *customResourceStateMetricValue `json:"stateSet,omitempty"`
+	Info     *customResourceStateMetricValue `json:"info,omitempty"`
+}
+
+type customResourceStateMetricValue struct {
+	Path           []string            `json:"path,omitempty"`
+	ValueFrom      []string            `json:"valueFrom,omitempty"`
+	LabelsFromPath map[string][]string `json:"labelsFromPath,omitempty"`
+	LabelName      *string             `json:"labelName,omitempty"`
+	List           []string            `json:"list,omitempty"`
+}
+
+// toYAML returns the YAML of the options, or an empty string if no option is set
+func (o instanceOptions) toYAML() (string, error) {
+	if apiutils.IsEqualStruct(o, instanceOptions{}) {
+		return "", nil
+	}
+
+	out, err := yaml.Marshal(o)
+	if err != nil {
+		return "", fmt.Errorf("unable to generate the kubernetes_state_core check configuration: %w", err)
+	}
+	return string(out), nil
+}
+
+// newCustomResourceStateConfig converts the custom resources of the DatadogAgent
+// to the kube-state-metrics custom resource state configuration.
+func newCustomResourceStateConfig(customResources []v2alpha1.KubeStateMetricsCoreCustomResource) *customResourceStateConfig {
+	if len(customResources) == 0 {
+		return nil
+	}
+
+	config := &customResourceStateConfig{}
+	for _, cr := range customResources {
+		resource := customResourceStateResource{
+			ResourcePlural:   getCustomResourcePlural(cr),
+			MetricNamePrefix: cr.MetricNamePrefix,
+			LabelsFromPath:   cr.LabelsFromPath,
+		}
+		resource.GroupVersionKind.Group = cr.Group
+		resource.GroupVersionKind.Version = cr.Version
+		resource.GroupVersionKind.Kind = cr.Kind
+
+		for _, metric := range cr.Metrics {
+			value := &customResourceStateMetricValue{
+				Path:           metric.Path,
+				LabelsFromPath: metric.LabelsFromPath,
+			}
+			each := customResourceStateMetricEach{Type: string(metric.Type)}
+			switch metric.Type {
+			case v2alpha1.KubeStateMetricsCoreCustomResourceMetricTypeStateSet:
+				value.ValueFrom = metric.ValueFrom
+				value.LabelName = metric.LabelName
+				value.List = metric.List
+				each.StateSet = value
+			case v2alpha1.KubeStateMetricsCoreCustomResourceMetricTypeInfo:
+				each.Info = value
+			default:
+				value.ValueFrom = metric.ValueFrom
+				each.Gauge = value
+			}
+			crMetric := customResourceStateMetric{
+				Name: metric.Name,
+				Each: each,
+			}
+			if metric.Help != nil {
+				crMetric.Help = *metric.Help
+			}
+			resource.Metrics = append(resource.Metrics, crMetric)
+		}
+		config.Spec.Resources = append(config.Spec.Resources, resource)
 	}
 
 	return config
 }
+
+// getCustomResourcePlural returns the plural name of a custom resource, the lowercase kind followed by `s` by default
+func getCustomResourcePlural(cr v2alpha1.KubeStateMetricsCoreCustomResource) string {
+	if cr.Resource != nil && *cr.Resource != "" {
+		return *cr.Resource
+	}
+	return strings.ToLower(cr.Kind) + "s"
+}
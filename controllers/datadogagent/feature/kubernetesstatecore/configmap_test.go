@@ -11,6 +11,8 @@ import (
 
 	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	apicommonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -40,6 +42,7 @@ instances:
 		customConfig             *apicommonv1.CustomConfig
 		configConfigMapName      string
 		collectorOpts            collectorOptions
+		instanceOpts             instanceOptions
 	}
 	tests := []struct {
 		name    string
@@ -55,7 +58,7 @@ instances:
 				runInClusterChecksRunner: true,
 				configConfigMapName:      apicommon.DefaultKubeStateMetricsCoreConf,
 			},
-			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, ksmCheckConfig(true, defaultOptions)),
+			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, mustKSMCheckConfig(true, defaultOptions, instanceOptions{})),
 		},
 		{
 			name: "override",
@@ -78,7 +81,7 @@ instances:
 				runInClusterChecksRunner: false,
 				configConfigMapName:      apicommon.DefaultKubeStateMetricsCoreConf,
 			},
-			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, ksmCheckConfig(false, defaultOptions)),
+			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, mustKSMCheckConfig(false, defaultOptions, instanceOptions{})),
 		},
 		{
 			name: "with vpa",
@@ -89,7 +92,7 @@ instances:
 				configConfigMapName:      apicommon.DefaultKubeStateMetricsCoreConf,
 				collectorOpts:            optionsWithVPA,
 			},
-			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, ksmCheckConfig(true, optionsWithVPA)),
+			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, mustKSMCheckConfig(true, optionsWithVPA, instanceOptions{})),
 		},
 		{
 			name: "with CRDs",
@@ -100,7 +103,7 @@ instances:
 				configConfigMapName:      apicommon.DefaultKubeStateMetricsCoreConf,
 				collectorOpts:            optionsWithCRD,
 			},
-			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, ksmCheckConfig(true, optionsWithCRD)),
+			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, mustKSMCheckConfig(true, optionsWithCRD, instanceOptions{})),
 		},
 		{
 			name: "with APIServices",
@@ -111,7 +114,18 @@ instances:
 				configConfigMapName:      apicommon.DefaultKubeStateMetricsCoreConf,
 				collectorOpts:            optionsWithAPIService,
 			},
-			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, ksmCheckConfig(true, optionsWithAPIService)),
+			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, mustKSMCheckConfig(true, optionsWithAPIService, instanceOptions{})),
+		},
+		{
+			name: "with instance options",
+			fields: fields{
+				owner:                    owner,
+				enable:                   true,
+				runInClusterChecksRunner: true,
+				configConfigMapName:      apicommon.DefaultKubeStateMetricsCoreConf,
+				instanceOpts:             instanceOptions{Namespaces: []string{"default"}},
+			},
+			want: buildDefaultConfigMap(owner.GetNamespace(), apicommon.DefaultKubeStateMetricsCoreConf, mustKSMCheckConfig(true, defaultOptions, instanceOptions{Namespaces: []string{"default"}})),
 		},
 	}
 	for _, tt := range tests {
@@ -123,6 +137,7 @@ instances:
 				owner:                    tt.fields.owner,
 				customConfig:             tt.fields.customConfig,
 				configConfigMapName:      tt.fields.configConfigMapName,
+				instanceOpts:             tt.fields.instanceOpts,
 			}
 			got, err := f.buildKSMCoreConfigMap(tt.fields.collectorOpts)
 			if (err != nil) != tt.wantErr {
@@ -135,3 +150,93 @@ instances:
 		})
 	}
 }
+
+func Test_ksmCheckConfig(t *testing.T) {
+	collectorOpts := collectorOptions{
+		enableVPA:          true,
+		enabledCollectors:  []string{"poddisruptionbudgets", "pods"},
+		disabledCollectors: []string{"secrets", "configmaps", "verticalpodautoscalers"},
+	}
+	instanceOpts := instanceOptions{
+		LabelsAsTags: map[string]map[string]string{
+			"pod": {"app": "app"},
+		},
+		NamespacesExclude: []string{"kube-system"},
+		CustomResource: newCustomResourceStateConfig([]v2alpha1.KubeStateMetricsCoreCustomResource{
+			{
+				Group:   "example.com",
+				Version: "v1",
+				Kind:    "Foo",
+				Metrics: []v2alpha1.KubeStateMetricsCoreCustomResourceMetric{
+					{
+						Name:      "replicas",
+						Type:      v2alpha1.KubeStateMetricsCoreCustomResourceMetricTypeGauge,
+						Path:      []string{"status"},
+						ValueFrom: []string{"replicas"},
+					},
+				},
+			},
+		}),
+	}
+
+	want := `---
+cluster_check: false
+init_config:
+instances:
+  - skip_leader_election: false
+    collectors:
+    - pods
+    - replicationcontrollers
+    - statefulsets
+    - nodes
+    - cronjobs
+    - jobs
+    - replicasets
+    - deployments
+    - services
+    - endpoints
+    - daemonsets
+    - horizontalpodautoscalers
+    - limitranges
+    - resourcequotas
+    - namespaces
+    - persistentvolumeclaims
+    - persistentvolumes
+    - ingresses
+    - poddisruptionbudgets
+    custom_resource:
+      spec:
+        resources:
+        - groupVersionKind:
+            group: example.com
+            kind: Foo
+            version: v1
+          metrics:
+          - each:
+              gauge:
+                path:
+                - status
+                valueFrom:
+                - replicas
+              type: Gauge
+            name: replicas
+          resourcePlural: foos
+    labels_as_tags:
+      pod:
+        app: app
+    namespaces_exclude:
+    - kube-system
+`
+
+	got, err := ksmCheckConfig(false, collectorOpts, instanceOpts)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func mustKSMCheckConfig(clusterCheck bool, collectorOpts collectorOptions, instanceOpts instanceOptions) string {
+	config, err := ksmCheckConfig(clusterCheck, collectorOpts, instanceOpts)
+	if err != nil {
+		panic(err)
+	}
+	return config
+}
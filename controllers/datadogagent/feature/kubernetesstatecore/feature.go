@@ -54,6 +54,11 @@ type ksmFeature struct {
 	collectCRDMetrics        bool
 	collectAPIServiceMetrics bool
 
+	enabledCollectors  []string
+	disabledCollectors []string
+	customResources    []v2alpha1.KubeStateMetricsCoreCustomResource
+	instanceOpts       instanceOptions
+
 	rbacSuffix         string
 	serviceAccountName string
 
@@ -109,6 +114,20 @@ func (f *ksmFeature) Configure(dda *v2alpha1.DatadogAgent) feature.RequiredCompo
 			}
 		}
 
+		ksmConfig := dda.Spec.Features.KubeStateMetricsCore
+		if ksmConfig.Collectors != nil {
+			f.enabledCollectors = ksmConfig.Collectors.Enabled
+			f.disabledCollectors = ksmConfig.Collectors.Disabled
+		}
+		f.customResources = ksmConfig.CustomResources
+		f.instanceOpts = instanceOptions{
+			LabelsAsTags:      ksmConfig.LabelsAsTags,
+			AnnotationsAsTags: ksmConfig.AnnotationsAsTags,
+			Namespaces:        ksmConfig.Namespaces,
+			NamespacesExclude: ksmConfig.NamespacesExcluded,
+			CustomResource:    newCustomResourceStateConfig(ksmConfig.CustomResources),
+		}
+
 		if dda.Spec.Features.KubeStateMetricsCore.Conf != nil {
 			f.customConfig = v2alpha1.ConvertCustomConfig(dda.Spec.Features.KubeStateMetricsCore.Conf)
 			hash, err := comparison.GenerateMD5ForSpec(f.customConfig)
@@ -119,6 +138,16 @@ func (f *ksmFeature) Configure(dda *v2alpha1.DatadogAgent) feature.RequiredCompo
 			}
 			f.customConfigAnnotationValue = hash
 			f.customConfigAnnotationKey = object.GetChecksumAnnotationKey(feature.KubernetesStateCoreIDType)
+		} else if ksmConfig.Collectors != nil || !apiutils.IsEqualStruct(f.instanceOpts, instanceOptions{}) {
+			// Restart the check runners when the generated configuration changes
+			hash, err := comparison.GenerateMD5ForSpec(ksmConfig)
+			if err != nil {
+				f.logger.Error(err, "couldn't generate hash for ksm core config")
+			} else {
+				f.logger.V(2).Info("built ksm core config", "hash", hash)
+			}
+			f.customConfigAnnotationValue = hash
+			f.customConfigAnnotationKey = object.GetChecksumAnnotationKey(feature.KubernetesStateCoreIDType)
 		}
 
 		f.configConfigMapName = apicommonv1.GetConfName(dda, f.customConfig, apicommon.DefaultKubeStateMetricsCoreConf)
@@ -158,9 +187,12 @@ func (f *ksmFeature) ConfigureV1(dda *v1alpha1.DatadogAgent) feature.RequiredCom
 }
 
 type collectorOptions struct {
-	enableVPA        bool
-	enableAPIService bool
-	enableCRD        bool
+	enableVPA          bool
+	enableAPIService   bool
+	enableCRD          bool
+	enabledCollectors  []string
+	disabledCollectors []string
+	customResources    []v2alpha1.KubeStateMetricsCoreCustomResource
 }
 
 // ManageDependencies allows a feature to manage its dependencies.
@@ -170,9 +202,12 @@ func (f *ksmFeature) ManageDependencies(managers feature.ResourceManagers, compo
 	// OR if the default configMap is needed.
 	pInfo := managers.Store().GetPlatformInfo()
 	collectorOpts := collectorOptions{
-		enableVPA:        pInfo.IsResourceSupported("VerticalPodAutoscaler"),
-		enableAPIService: f.collectAPIServiceMetrics,
-		enableCRD:        f.collectCRDMetrics,
+		enableVPA:          pInfo.IsResourceSupported("VerticalPodAutoscaler"),
+		enableAPIService:   f.collectAPIServiceMetrics,
+		enableCRD:          f.collectCRDMetrics,
+		enabledCollectors:  f.enabledCollectors,
+		disabledCollectors: f.disabledCollectors,
+		customResources:    f.customResources,
 	}
 	configCM, err := f.buildKSMCoreConfigMap(collectorOpts)
 	if err != nil {
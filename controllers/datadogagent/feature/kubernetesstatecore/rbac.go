@@ -128,6 +128,14 @@ func getRBACPolicyRules(collectorOpts collectorOptions) []rbacv1.PolicyRule {
 		})
 	}
 
+	// Custom resources collected by the custom resource state metrics
+	for _, cr := range collectorOpts.customResources {
+		rbacRules = append(rbacRules, rbacv1.PolicyRule{
+			APIGroups: []string{cr.Group},
+			Resources: []string{getCustomResourcePlural(cr)},
+		})
+	}
+
 	commonVerbs := []string{
 		rbac.ListVerb,
 		rbac.WatchVerb,
@@ -0,0 +1,37 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package kubernetesstatecore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes/rbac"
+)
+
+func Test_getRBACPolicyRules_customResources(t *testing.T) {
+	rules := getRBACPolicyRules(collectorOptions{
+		customResources: []v2alpha1.KubeStateMetricsCoreCustomResource{
+			{Group: "example.com", Version: "v1", Kind: "Foo"},
+			{Group: "example.com", Version: "v1", Kind: "Bar", Resource: apiutils.NewStringPointer("barlist")},
+		},
+	})
+
+	assert.Contains(t, rules, rbacv1.PolicyRule{
+		APIGroups: []string{"example.com"},
+		Resources: []string{"foos"},
+		Verbs:     []string{rbac.ListVerb, rbac.WatchVerb},
+	})
+	assert.Contains(t, rules, rbacv1.PolicyRule{
+		APIGroups: []string{"example.com"},
+		Resources: []string{"barlist"},
+		Verbs:     []string{rbac.ListVerb, rbac.WatchVerb},
+	})
+}
@@ -16,6 +16,7 @@ import (
 
 	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	apicommonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/component"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/object/volume"
 )
@@ -41,6 +42,7 @@ type logCollectionFeature struct {
 	containerSymlinksPath      string
 	tempStoragePath            string
 	openFilesLimit             int32
+	additionalEndpoints        []v2alpha1.AdditionalEndpoint
 }
 
 // ID returns the ID of the Feature
@@ -69,6 +71,9 @@ func (f *logCollectionFeature) Configure(dda *v2alpha1.DatadogAgent) (reqComp fe
 		if logCollection.OpenFilesLimit != nil {
 			f.openFilesLimit = *logCollection.OpenFilesLimit
 		}
+		if dda.Spec.Global != nil {
+			f.additionalEndpoints = dda.Spec.Global.AdditionalEndpoints
+		}
 
 		reqComp = feature.RequiredComponents{
 			Agent: feature.RequiredComponent{
@@ -168,6 +173,15 @@ func (f *logCollectionFeature) ManageNodeAgent(managers feature.PodTemplateManag
 		})
 	}
 
+	// additional endpoints
+	endpointsEnvVars, err := component.GetAdditionalEndpointsEnvVars(f.additionalEndpoints, v2alpha1.AdditionalEndpointTypeLogs, apicommon.DDLogsConfigAdditionalEndpoints)
+	if err != nil {
+		return err
+	}
+	for _, envVar := range endpointsEnvVars {
+		managers.EnvVar().AddEnvVarToContainer(apicommonv1.CoreAgentContainerName, envVar)
+	}
+
 	return nil
 }
 
@@ -10,6 +10,7 @@ import (
 
 	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	apicommonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
 	v2alpha1test "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1/test"
 	apiutils "github.com/DataDog/datadog-operator/apis/utils"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
@@ -159,6 +160,39 @@ func Test_LogCollectionFeature_Configure(t *testing.T) {
 				},
 			),
 		},
+		{
+			Name: "v2alpha1 additional endpoints",
+			DDAv2: v2alpha1test.NewDatadogAgentBuilder().
+				WithLogCollectionEnabled(true).
+				WithAdditionalEndpoints(v2alpha1.AdditionalEndpoint{
+					Type:      v2alpha1.AdditionalEndpointTypeLogs,
+					URL:       "agent-http-intake.logs.datadoghq.eu:443",
+					APISecret: apicommonv1.SecretConfig{SecretName: "datadog-eu", KeyName: "logs_api_key"},
+				}).
+				BuildWithDefaults(),
+			WantConfigure: true,
+			Agent: test.NewDefaultComponentTest().WithWantFunc(
+				func(t testing.TB, mgrInterface feature.PodTemplateManagers) {
+					wantEnvVars := createEnvVars("true", "false", "true")
+					wantEnvVars = append(wantEnvVars,
+						&corev1.EnvVar{
+							Name: "LOGS_CONFIG_ADDITIONAL_ENDPOINTS_API_KEY_0",
+							ValueFrom: &corev1.EnvVarSource{
+								SecretKeyRef: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "datadog-eu"},
+									Key:                  "logs_api_key",
+								},
+							},
+						},
+						&corev1.EnvVar{
+							Name:  apicommon.DDLogsConfigAdditionalEndpoints,
+							Value: `[{"api_key":"$(LOGS_CONFIG_ADDITIONAL_ENDPOINTS_API_KEY_0)","Host":"agent-http-intake.logs.datadoghq.eu","Port":443,"is_reliable":true}]`,
+						},
+					)
+					assertWants(t, mgrInterface, getWantVolumeMounts(), getWantVolumes(), wantEnvVars)
+				},
+			),
+		},
 	}
 
 	tests.Run(t, buildLogCollectionFeature)
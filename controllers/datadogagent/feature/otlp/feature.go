@@ -12,6 +12,7 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
@@ -24,6 +25,7 @@ import (
 	apicommonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/component"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+	cilium "github.com/DataDog/datadog-operator/pkg/cilium/v1"
 )
 
 var (
@@ -52,11 +54,18 @@ type otlpFeature struct {
 	httpEnabled  bool
 	httpEndpoint string
 
+	logs    *v2alpha1.OTLPLogsConfig
+	metrics *v2alpha1.OTLPMetricsConfig
+	traces  *v2alpha1.OTLPTracesConfig
+
 	usingAPM bool
 
 	forceEnableLocalService bool
 	localServiceName        string
 
+	createKubernetesNetworkPolicy bool
+	createCiliumNetworkPolicy     bool
+
 	owner metav1.Object
 }
 
@@ -83,6 +92,10 @@ func (f *otlpFeature) Configure(dda *v2alpha1.DatadogAgent) (reqComp feature.Req
 		f.httpEndpoint = *otlp.Receiver.Protocols.HTTP.Endpoint
 	}
 
+	f.logs = otlp.Logs
+	f.metrics = otlp.Metrics
+	f.traces = otlp.Traces
+
 	apm := dda.Spec.Features.APM
 	if apm != nil {
 		f.usingAPM = apiutils.BoolValue(apm.Enabled)
@@ -93,6 +106,14 @@ func (f *otlpFeature) Configure(dda *v2alpha1.DatadogAgent) (reqComp feature.Req
 	}
 	f.localServiceName = v2alpha1.GetLocalAgentServiceName(dda)
 
+	if enabled, flavor := v2alpha1.IsNetworkPolicyEnabled(dda); enabled {
+		if flavor == v2alpha1.NetworkPolicyFlavorCilium {
+			f.createCiliumNetworkPolicy = true
+		} else {
+			f.createKubernetesNetworkPolicy = true
+		}
+	}
+
 	if f.grpcEnabled || f.httpEnabled {
 		reqComp = feature.RequiredComponents{
 			Agent: feature.RequiredComponent{
@@ -136,6 +157,14 @@ func (f *otlpFeature) ConfigureV1(dda *v1alpha1.DatadogAgent) (reqComp feature.R
 	}
 	f.localServiceName = v1alpha1.GetLocalAgentServiceName(dda)
 
+	if enabled, flavor := v1alpha1.IsAgentNetworkPolicyEnabled(dda); enabled {
+		if flavor == v1alpha1.NetworkPolicyFlavorCilium {
+			f.createCiliumNetworkPolicy = true
+		} else {
+			f.createKubernetesNetworkPolicy = true
+		}
+	}
+
 	if f.grpcEnabled || f.httpEnabled {
 		reqComp = feature.RequiredComponents{
 			Agent: feature.RequiredComponent{
@@ -157,48 +186,101 @@ func (f *otlpFeature) ConfigureV1(dda *v1alpha1.DatadogAgent) (reqComp feature.R
 // ManageDependencies allows a feature to manage its dependencies.
 // Feature's dependencies should be added in the store.
 func (f *otlpFeature) ManageDependencies(managers feature.ResourceManagers, components feature.RequiredComponents) error {
+	var servicePorts []corev1.ServicePort
 	if f.grpcEnabled {
-		if component.ShouldCreateAgentLocalService(managers.Store().GetVersionInfo(), f.forceEnableLocalService) {
-			port, err := extractPortEndpoint(f.grpcEndpoint)
-			if err != nil {
-				f.logger.Error(err, "failed to extract port from OTLP/gRPC endpoint")
-				return fmt.Errorf("failed to extract port from OTLP/gRPC endpoint: %w", err)
-			}
-			servicePort := []corev1.ServicePort{
-				{
-					Protocol:   corev1.ProtocolTCP,
-					TargetPort: intstr.FromInt(int(port)),
-					Port:       port,
-					Name:       apicommon.OTLPGRPCPortName,
-				},
-			}
-			serviceInternalTrafficPolicy := corev1.ServiceInternalTrafficPolicyLocal
-			if err := managers.ServiceManager().AddService(f.localServiceName, f.owner.GetNamespace(), component.GetAgentLocalServiceSelector(f.owner), servicePort, &serviceInternalTrafficPolicy); err != nil {
-				return err
-			}
+		port, err := extractPortEndpoint(f.grpcEndpoint)
+		if err != nil {
+			f.logger.Error(err, "failed to extract port from OTLP/gRPC endpoint")
+			return fmt.Errorf("failed to extract port from OTLP/gRPC endpoint: %w", err)
 		}
+		servicePorts = append(servicePorts, corev1.ServicePort{
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt(int(port)),
+			Port:       port,
+			Name:       apicommon.OTLPGRPCPortName,
+		})
 	}
 	if f.httpEnabled {
-		if component.ShouldCreateAgentLocalService(managers.Store().GetVersionInfo(), f.forceEnableLocalService) {
-			port, err := extractPortEndpoint(f.httpEndpoint)
-			if err != nil {
-				f.logger.Error(err, "failed to extract port from OTLP/HTTP endpoint")
-				return fmt.Errorf("failed to extract port from OTLP/HTTP endpoint: %w", err)
-			}
-			servicePort := []corev1.ServicePort{
-				{
-					Protocol:   corev1.ProtocolTCP,
-					TargetPort: intstr.FromInt(int(port)),
-					Port:       port,
-					Name:       apicommon.OTLPHTTPPortName,
+		port, err := extractPortEndpoint(f.httpEndpoint)
+		if err != nil {
+			f.logger.Error(err, "failed to extract port from OTLP/HTTP endpoint")
+			return fmt.Errorf("failed to extract port from OTLP/HTTP endpoint: %w", err)
+		}
+		servicePorts = append(servicePorts, corev1.ServicePort{
+			Protocol:   corev1.ProtocolTCP,
+			TargetPort: intstr.FromInt(int(port)),
+			Port:       port,
+			Name:       apicommon.OTLPHTTPPortName,
+		})
+	}
+	if len(servicePorts) == 0 {
+		return nil
+	}
+
+	// agent local service
+	if component.ShouldCreateAgentLocalService(managers.Store().GetVersionInfo(), f.forceEnableLocalService) {
+		serviceInternalTrafficPolicy := corev1.ServiceInternalTrafficPolicyLocal
+		if err := managers.ServiceManager().AddService(f.localServiceName, f.owner.GetNamespace(), component.GetAgentLocalServiceSelector(f.owner), servicePorts, &serviceInternalTrafficPolicy); err != nil {
+			return err
+		}
+	}
+
+	// network policies
+	policyName, podSelector := component.GetNetworkPolicyMetadata(f.owner, v2alpha1.NodeAgentComponentName)
+	if f.createKubernetesNetworkPolicy {
+		protocolTCP := corev1.ProtocolTCP
+		policyPorts := make([]netv1.NetworkPolicyPort, 0, len(servicePorts))
+		for _, servicePort := range servicePorts {
+			policyPorts = append(policyPorts, netv1.NetworkPolicyPort{
+				Port: &intstr.IntOrString{
+					Type:   intstr.Int,
+					IntVal: servicePort.Port,
 				},
-			}
-			serviceInternalTrafficPolicy := corev1.ServiceInternalTrafficPolicyLocal
-			if err := managers.ServiceManager().AddService(f.localServiceName, f.owner.GetNamespace(), nil, servicePort, &serviceInternalTrafficPolicy); err != nil {
-				return err
-			}
+				Protocol: &protocolTCP,
+			})
 		}
+		ingressRules := []netv1.NetworkPolicyIngressRule{
+			{
+				Ports: policyPorts,
+			},
+		}
+		return managers.NetworkPolicyManager().AddKubernetesNetworkPolicy(
+			policyName,
+			f.owner.GetNamespace(),
+			podSelector,
+			nil,
+			ingressRules,
+			nil,
+		)
+	} else if f.createCiliumNetworkPolicy {
+		portProtocols := make([]cilium.PortProtocol, 0, len(servicePorts))
+		for _, servicePort := range servicePorts {
+			portProtocols = append(portProtocols, cilium.PortProtocol{
+				Port:     strconv.Itoa(int(servicePort.Port)),
+				Protocol: cilium.ProtocolTCP,
+			})
+		}
+		policySpecs := []cilium.NetworkPolicySpec{
+			{
+				Description:      "Ingress for OTLP ingest",
+				EndpointSelector: podSelector,
+				Ingress: []cilium.IngressRule{
+					{
+						FromEndpoints: []metav1.LabelSelector{
+							{},
+						},
+						ToPorts: []cilium.PortRule{
+							{
+								Ports: portProtocols,
+							},
+						},
+					},
+				},
+			},
+		}
+		return managers.CiliumPolicyManager().AddCiliumPolicy(policyName, f.owner.GetNamespace(), policySpecs)
 	}
+
 	return nil
 }
 
@@ -293,9 +375,54 @@ func (f *otlpFeature) ManageNodeAgent(managers feature.PodTemplateManagers) erro
 		}
 	}
 
+	for _, envVar := range f.signalsEnvVars() {
+		managers.EnvVar().AddEnvVarToContainer(apicommonv1.CoreAgentContainerName, envVar)
+		if f.usingAPM {
+			managers.EnvVar().AddEnvVarToContainer(apicommonv1.TraceAgentContainerName, envVar)
+		}
+	}
+
 	return nil
 }
 
+// signalsEnvVars returns the env vars configuring the ingestion of the OTLP logs, metrics and traces.
+// Only the settings set in the DatadogAgent are returned, to keep the Agent defaults otherwise.
+func (f *otlpFeature) signalsEnvVars() []*corev1.EnvVar {
+	var envVars []*corev1.EnvVar
+	addBool := func(name string, value *bool) {
+		if value != nil {
+			envVars = append(envVars, &corev1.EnvVar{Name: name, Value: apiutils.BoolToString(value)})
+		}
+	}
+	addString := func(name string, value *string) {
+		if value != nil {
+			envVars = append(envVars, &corev1.EnvVar{Name: name, Value: *value})
+		}
+	}
+
+	if f.logs != nil {
+		addBool(apicommon.DDOTLPLogsEnabled, f.logs.Enabled)
+	}
+	if f.metrics != nil {
+		addBool(apicommon.DDOTLPMetricsEnabled, f.metrics.Enabled)
+		addBool(apicommon.DDOTLPMetricsResourceAttributesAsTags, f.metrics.ResourceAttributesAsTags)
+		addBool(apicommon.DDOTLPMetricsInstrumentationScopeMetadataAsTags, f.metrics.InstrumentationScopeMetadataAsTags)
+		addString(apicommon.DDOTLPMetricsTagCardinality, f.metrics.TagCardinality)
+		if f.metrics.Histograms != nil {
+			addString(apicommon.DDOTLPMetricsHistogramsMode, f.metrics.Histograms.Mode)
+			addBool(apicommon.DDOTLPMetricsHistogramsSendAggregationMetrics, f.metrics.Histograms.SendAggregationMetrics)
+		}
+		if f.metrics.Summaries != nil {
+			addString(apicommon.DDOTLPMetricsSummariesMode, f.metrics.Summaries.Mode)
+		}
+	}
+	if f.traces != nil {
+		addBool(apicommon.DDOTLPTracesEnabled, f.traces.Enabled)
+	}
+
+	return envVars
+}
+
 // ManageClusterChecksRunner allows a feature to configure the ClusterChecksRunner's corev1.PodTemplateSpec
 // It should do nothing if the feature doesn't need to configure it.
 func (f *otlpFeature) ManageClusterChecksRunner(managers feature.PodTemplateManagers) error {
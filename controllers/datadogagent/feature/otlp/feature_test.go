@@ -13,13 +13,21 @@ import (
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
 	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/dependencies"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/fake"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/test"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
 
+	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/version"
 )
 
 func TestOTLPFeature(t *testing.T) {
@@ -207,6 +215,147 @@ func TestOTLPFeature(t *testing.T) {
 				},
 			}),
 		},
+		{
+			Name: "v2alpha1 gRPC enabled, logs, metrics and traces settings, APM",
+			DDAv2: newV2Agent(Settings{
+				EnabledGRPC:  true,
+				EndpointGRPC: "0.0.0.0:4317",
+				APM:          true,
+				Logs:         &v2alpha1.OTLPLogsConfig{Enabled: apiutils.NewBoolPointer(true)},
+				Metrics: &v2alpha1.OTLPMetricsConfig{
+					Enabled:                  apiutils.NewBoolPointer(true),
+					ResourceAttributesAsTags: apiutils.NewBoolPointer(true),
+					TagCardinality:           apiutils.NewStringPointer("orchestrator"),
+					Histograms: &v2alpha1.OTLPHistogramsConfig{
+						Mode:                   apiutils.NewStringPointer("counters"),
+						SendAggregationMetrics: apiutils.NewBoolPointer(true),
+					},
+					Summaries: &v2alpha1.OTLPSummariesConfig{Mode: apiutils.NewStringPointer("noquantiles")},
+				},
+				Traces: &v2alpha1.OTLPTracesConfig{Enabled: apiutils.NewBoolPointer(false)},
+			}),
+			WantConfigure: true,
+			Agent: testExpected(Expected{
+				EnvVars: []*corev1.EnvVar{
+					{
+						Name:  apicommon.DDOTLPgRPCEndpoint,
+						Value: "0.0.0.0:4317",
+					},
+					{
+						Name:  apicommon.DDOTLPLogsEnabled,
+						Value: "true",
+					},
+					{
+						Name:  apicommon.DDOTLPMetricsEnabled,
+						Value: "true",
+					},
+					{
+						Name:  apicommon.DDOTLPMetricsResourceAttributesAsTags,
+						Value: "true",
+					},
+					{
+						Name:  apicommon.DDOTLPMetricsTagCardinality,
+						Value: "orchestrator",
+					},
+					{
+						Name:  apicommon.DDOTLPMetricsHistogramsMode,
+						Value: "counters",
+					},
+					{
+						Name:  apicommon.DDOTLPMetricsHistogramsSendAggregationMetrics,
+						Value: "true",
+					},
+					{
+						Name:  apicommon.DDOTLPMetricsSummariesMode,
+						Value: "noquantiles",
+					},
+					{
+						Name:  apicommon.DDOTLPTracesEnabled,
+						Value: "false",
+					},
+				},
+				CheckTraceAgent: true,
+				Ports: []*corev1.ContainerPort{
+					{
+						Name:          apicommon.OTLPGRPCPortName,
+						ContainerPort: 4317,
+						HostPort:      4317,
+						Protocol:      corev1.ProtocolTCP,
+					},
+				},
+			}),
+		},
+		{
+			Name: "v2alpha1 gRPC and HTTP enabled, local service and network policy",
+			DDAv2: newV2Agent(Settings{
+				EnabledGRPC:   true,
+				EndpointGRPC:  "0.0.0.0:4317",
+				EnabledHTTP:   true,
+				EndpointHTTP:  "0.0.0.0:4318",
+				NetworkPolicy: true,
+			}),
+			StoreOption: &dependencies.StoreOptions{
+				VersionInfo: &version.Info{GitVersion: "v1.22.0"},
+				Logger:      logr.Discard(),
+			},
+			WantConfigure: true,
+			WantDependenciesFunc: func(t testing.TB, store dependencies.StoreClient) {
+				obj, found := store.Get(kubernetes.ServicesKind, "", "datadog-agent")
+				require.True(t, found, "Should have created the local service")
+				service := obj.(*corev1.Service)
+				assert.NotEmpty(t, service.Spec.Selector)
+				require.NotNil(t, service.Spec.InternalTrafficPolicy)
+				assert.Equal(t, corev1.ServiceInternalTrafficPolicyLocal, *service.Spec.InternalTrafficPolicy)
+				assert.Equal(t, []corev1.ServicePort{
+					{
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromInt(4317),
+						Port:       4317,
+						Name:       apicommon.OTLPGRPCPortName,
+					},
+					{
+						Protocol:   corev1.ProtocolTCP,
+						TargetPort: intstr.FromInt(4318),
+						Port:       4318,
+						Name:       apicommon.OTLPHTTPPortName,
+					},
+				}, service.Spec.Ports)
+
+				obj, found = store.Get(kubernetes.NetworkPoliciesKind, "", "datadog-agent")
+				require.True(t, found, "Should have created the network policy")
+				policy := obj.(*netv1.NetworkPolicy)
+				require.Len(t, policy.Spec.Ingress, 1)
+				require.Len(t, policy.Spec.Ingress[0].Ports, 2)
+				assert.Equal(t, int32(4317), policy.Spec.Ingress[0].Ports[0].Port.IntVal)
+				assert.Equal(t, int32(4318), policy.Spec.Ingress[0].Ports[1].Port.IntVal)
+			},
+			Agent: testExpected(Expected{
+				EnvVars: []*corev1.EnvVar{
+					{
+						Name:  apicommon.DDOTLPgRPCEndpoint,
+						Value: "0.0.0.0:4317",
+					},
+					{
+						Name:  apicommon.DDOTLPHTTPEndpoint,
+						Value: "0.0.0.0:4318",
+					},
+				},
+				Ports: []*corev1.ContainerPort{
+					{
+						Name:          apicommon.OTLPGRPCPortName,
+						ContainerPort: 4317,
+						HostPort:      4317,
+						Protocol:      corev1.ProtocolTCP,
+					},
+					{
+						Name:          apicommon.OTLPHTTPPortName,
+						ContainerPort: 4318,
+						HostPort:      4318,
+						Protocol:      corev1.ProtocolTCP,
+					},
+				},
+			}),
+		},
 	}
 
 	tests.Run(t, buildOTLPFeature)
@@ -219,6 +368,12 @@ type Settings struct {
 	EndpointHTTP string
 
 	APM bool
+
+	Logs    *v2alpha1.OTLPLogsConfig
+	Metrics *v2alpha1.OTLPMetricsConfig
+	Traces  *v2alpha1.OTLPTracesConfig
+
+	NetworkPolicy bool
 }
 
 func newV1Agent(set Settings) *v1alpha1.DatadogAgent {
@@ -245,23 +400,35 @@ func newV1Agent(set Settings) *v1alpha1.DatadogAgent {
 
 func newV2Agent(set Settings) *v2alpha1.DatadogAgent {
 	return &v2alpha1.DatadogAgent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "datadog",
+		},
 		Spec: v2alpha1.DatadogAgentSpec{
 			Features: &v2alpha1.DatadogFeatures{
-				OTLP: &v2alpha1.OTLPFeatureConfig{Receiver: v2alpha1.OTLPReceiverConfig{Protocols: v2alpha1.OTLPProtocolsConfig{
-					GRPC: &v2alpha1.OTLPGRPCConfig{
-						Enabled:  &set.EnabledGRPC,
-						Endpoint: &set.EndpointGRPC,
-					},
-					HTTP: &v2alpha1.OTLPHTTPConfig{
-						Enabled:  &set.EnabledHTTP,
-						Endpoint: &set.EndpointHTTP,
-					},
-				}}},
+				OTLP: &v2alpha1.OTLPFeatureConfig{
+					Receiver: v2alpha1.OTLPReceiverConfig{Protocols: v2alpha1.OTLPProtocolsConfig{
+						GRPC: &v2alpha1.OTLPGRPCConfig{
+							Enabled:  &set.EnabledGRPC,
+							Endpoint: &set.EndpointGRPC,
+						},
+						HTTP: &v2alpha1.OTLPHTTPConfig{
+							Enabled:  &set.EnabledHTTP,
+							Endpoint: &set.EndpointHTTP,
+						},
+					}},
+					Logs:    set.Logs,
+					Metrics: set.Metrics,
+					Traces:  set.Traces,
+				},
 				APM: &v2alpha1.APMFeatureConfig{
 					Enabled: apiutils.NewBoolPointer(set.APM),
 				},
 			},
-			Global: &v2alpha1.GlobalConfig{},
+			Global: &v2alpha1.GlobalConfig{
+				NetworkPolicy: &v2alpha1.NetworkPolicyConfig{
+					Create: apiutils.NewBoolPointer(set.NetworkPolicy),
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,131 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+)
+
+const (
+	featureReconcileSucceededReason = "ReconcileSucceeded"
+	featureReconcileFailedReason    = "ReconcileFailed"
+)
+
+// featuresStatus records the errors returned by the enabled features during a reconcile loop,
+// to report them in the status of the DatadogAgent.
+type featuresStatus struct {
+	features           []feature.Feature
+	requiredComponents map[feature.IDType]feature.RequiredComponents
+	errors             map[feature.IDType][]string
+	// steps lists the steps run by each feature
+	steps map[feature.IDType]map[string]bool
+}
+
+func newFeaturesStatus(features []feature.Feature, requiredComponents map[feature.IDType]feature.RequiredComponents) *featuresStatus {
+	return &featuresStatus{
+		features:           features,
+		requiredComponents: requiredComponents,
+		errors:             make(map[feature.IDType][]string),
+		steps:              make(map[feature.IDType]map[string]bool),
+	}
+}
+
+// record records that a step of a feature ran and the error it returned, and returns it.
+// The same error returned by several Agent profiles is only recorded once.
+func (s *featuresStatus) record(id feature.IDType, step string, err error) error {
+	if s.steps[id] == nil {
+		s.steps[id] = make(map[string]bool)
+	}
+	s.steps[id][step] = true
+
+	if err == nil {
+		return nil
+	}
+
+	message := fmt.Sprintf("%s: %v", step, err)
+	for _, msg := range s.errors[id] {
+		if msg == message {
+			return err
+		}
+	}
+	s.errors[id] = append(s.errors[id], message)
+
+	return err
+}
+
+// update sets the status of the enabled features, the features that are not enabled anymore are removed from the status.
+// A feature is only reported healthy once it has been applied to all its required components, otherwise its previous health is kept.
+func (s *featuresStatus) update(status *datadoghqv2alpha1.DatadogAgentStatus, now metav1.Time) {
+	features := make([]datadoghqv2alpha1.FeatureStatus, 0, len(s.features))
+	for _, feat := range s.features {
+		featStatus := datadoghqv2alpha1.FeatureStatus{
+			ID:                 string(feat.ID()),
+			RequiredComponents: requiredComponentNames(s.requiredComponents[feat.ID()]),
+		}
+		// Keep the previous conditions to keep their last transition time
+		for _, previous := range status.Features {
+			if previous.ID == featStatus.ID {
+				featStatus.Conditions = previous.Conditions
+				break
+			}
+		}
+
+		if errs := s.errors[feat.ID()]; len(errs) > 0 {
+			datadoghqv2alpha1.UpdateFeatureStatusConditions(&featStatus, now, datadoghqv2alpha1.FeatureHealthyConditionType, metav1.ConditionFalse, featureReconcileFailedReason, strings.Join(errs, "; "))
+		} else if s.ranRequiredSteps(feat.ID()) {
+			datadoghqv2alpha1.UpdateFeatureStatusConditions(&featStatus, now, datadoghqv2alpha1.FeatureHealthyConditionType, metav1.ConditionTrue, featureReconcileSucceededReason, "Feature reconcile ok")
+		}
+		features = append(features, featStatus)
+	}
+
+	if len(features) == 0 {
+		features = nil
+	}
+	status.Features = features
+}
+
+// ranRequiredSteps returns true if the feature has been applied to its dependencies and all its required components
+func (s *featuresStatus) ranRequiredSteps(id feature.IDType) bool {
+	steps := []string{"ManageDependencies"}
+	for _, name := range requiredComponentNames(s.requiredComponents[id]) {
+		switch name {
+		case datadoghqv2alpha1.NodeAgentComponentName:
+			steps = append(steps, "ManageNodeAgent")
+		case datadoghqv2alpha1.ClusterAgentComponentName:
+			steps = append(steps, "ManageClusterAgent")
+		case datadoghqv2alpha1.ClusterChecksRunnerComponentName:
+			steps = append(steps, "ManageClusterChecksRunner")
+		}
+	}
+
+	for _, step := range steps {
+		if !s.steps[id][step] {
+			return false
+		}
+	}
+	return true
+}
+
+// requiredComponentNames returns the names of the components enabled by a feature
+func requiredComponentNames(requiredComponents feature.RequiredComponents) []datadoghqv2alpha1.ComponentName {
+	var names []datadoghqv2alpha1.ComponentName
+	if requiredComponents.Agent.IsEnabled() {
+		names = append(names, datadoghqv2alpha1.NodeAgentComponentName)
+	}
+	if requiredComponents.ClusterAgent.IsEnabled() {
+		names = append(names, datadoghqv2alpha1.ClusterAgentComponentName)
+	}
+	if requiredComponents.ClusterChecksRunner.IsEnabled() {
+		names = append(names, datadoghqv2alpha1.ClusterChecksRunnerComponentName)
+	}
+	return names
+}
@@ -0,0 +1,147 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+)
+
+// idFeature only implements the ID method of the Feature interface
+type idFeature struct {
+	feature.Feature
+	id feature.IDType
+}
+
+func (f *idFeature) ID() feature.IDType {
+	return f.id
+}
+
+func Test_featuresStatus(t *testing.T) {
+	previousTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	now := metav1.NewTime(time.Now())
+
+	features := []feature.Feature{
+		&idFeature{id: feature.APMIDType},
+		&idFeature{id: feature.NPMIDType},
+	}
+	requiredComponents := map[feature.IDType]feature.RequiredComponents{
+		feature.APMIDType: {
+			Agent:        feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+			ClusterAgent: feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+		},
+		feature.NPMIDType: {
+			Agent: feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+		},
+	}
+
+	status := &datadoghqv2alpha1.DatadogAgentStatus{
+		Features: []datadoghqv2alpha1.FeatureStatus{
+			{
+				ID: string(feature.APMIDType),
+				Conditions: []metav1.Condition{
+					datadoghqv2alpha1.NewDatadogAgentStatusCondition(datadoghqv2alpha1.FeatureHealthyConditionType, metav1.ConditionTrue, previousTime, featureReconcileSucceededReason, "Feature reconcile ok"),
+				},
+			},
+			{
+				ID: string(feature.CSPMIDType),
+			},
+		},
+	}
+
+	featStatus := newFeaturesStatus(features, requiredComponents)
+	assert.NoError(t, featStatus.record(feature.APMIDType, "ManageNodeAgent", nil))
+	err := errors.New("invalid configuration")
+	// The same error returned for several profiles is only recorded once
+	assert.Equal(t, err, featStatus.record(feature.NPMIDType, "ManageNodeAgent", err))
+	assert.Equal(t, err, featStatus.record(feature.NPMIDType, "ManageNodeAgent", err))
+	featStatus.update(status, now)
+
+	require.Len(t, status.Features, 2)
+
+	apm := status.Features[0]
+	assert.Equal(t, string(feature.APMIDType), apm.ID)
+	assert.Equal(t, []datadoghqv2alpha1.ComponentName{datadoghqv2alpha1.NodeAgentComponentName, datadoghqv2alpha1.ClusterAgentComponentName}, apm.RequiredComponents)
+	require.Len(t, apm.Conditions, 1)
+	assert.Equal(t, metav1.ConditionTrue, apm.Conditions[0].Status)
+	assert.Equal(t, previousTime, apm.Conditions[0].LastTransitionTime)
+
+	npm := status.Features[1]
+	assert.Equal(t, string(feature.NPMIDType), npm.ID)
+	assert.Equal(t, []datadoghqv2alpha1.ComponentName{datadoghqv2alpha1.NodeAgentComponentName}, npm.RequiredComponents)
+	require.Len(t, npm.Conditions, 1)
+	assert.Equal(t, datadoghqv2alpha1.FeatureHealthyConditionType, npm.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionFalse, npm.Conditions[0].Status)
+	assert.Equal(t, featureReconcileFailedReason, npm.Conditions[0].Reason)
+	assert.Equal(t, "ManageNodeAgent: invalid configuration", npm.Conditions[0].Message)
+	assert.Equal(t, now, npm.Conditions[0].LastTransitionTime)
+}
+
+func Test_featuresStatus_componentsNotReconciled(t *testing.T) {
+	previousTime := metav1.NewTime(time.Now().Add(-time.Hour))
+	now := metav1.NewTime(time.Now())
+
+	features := []feature.Feature{
+		&idFeature{id: feature.APMIDType},
+		&idFeature{id: feature.NPMIDType},
+		&idFeature{id: feature.OOMKillIDType},
+	}
+	requiredComponents := map[feature.IDType]feature.RequiredComponents{
+		feature.APMIDType: {
+			Agent:        feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+			ClusterAgent: feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+		},
+		feature.NPMIDType: {
+			Agent: feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+		},
+		feature.OOMKillIDType: {
+			Agent: feature.RequiredComponent{IsRequired: apiutils.NewBoolPointer(true)},
+		},
+	}
+
+	status := &datadoghqv2alpha1.DatadogAgentStatus{
+		Features: []datadoghqv2alpha1.FeatureStatus{
+			{
+				ID: string(feature.APMIDType),
+				Conditions: []metav1.Condition{
+					datadoghqv2alpha1.NewDatadogAgentStatusCondition(datadoghqv2alpha1.FeatureHealthyConditionType, metav1.ConditionFalse, previousTime, featureReconcileFailedReason, "ManageNodeAgent: invalid configuration"),
+				},
+			},
+		},
+	}
+
+	featStatus := newFeaturesStatus(features, requiredComponents)
+	for _, id := range []feature.IDType{feature.APMIDType, feature.NPMIDType} {
+		assert.NoError(t, featStatus.record(id, "ManageDependencies", nil))
+		assert.NoError(t, featStatus.record(id, "ManageNodeAgent", nil))
+	}
+	// The Cluster Agent reconcile failed before applying the features, and OOMKill didn't run
+	featStatus.update(status, now)
+
+	require.Len(t, status.Features, 3)
+
+	// The previous health of APM is kept
+	apm := status.Features[0]
+	require.Len(t, apm.Conditions, 1)
+	assert.Equal(t, metav1.ConditionFalse, apm.Conditions[0].Status)
+	assert.Equal(t, previousTime, apm.Conditions[0].LastTransitionTime)
+
+	npm := status.Features[1]
+	require.Len(t, npm.Conditions, 1)
+	assert.Equal(t, metav1.ConditionTrue, npm.Conditions[0].Status)
+
+	oomKill := status.Features[2]
+	assert.Empty(t, oomKill.Conditions)
+}
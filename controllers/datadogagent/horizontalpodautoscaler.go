@@ -0,0 +1,117 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
+	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+const (
+	hpaDefaultMinReplicas                    = 1
+	hpaDefaultTargetCPUUtilizationPercentage = 80
+)
+
+// addHPAV2 adds the HorizontalPodAutoscaler of a v2alpha1 Deployment component to the dependencies store.
+// When the autoscaling is configured, the replicas of the Deployment are unset so that they are managed by the
+// HorizontalPodAutoscaler only. HorizontalPodAutoscalers that are not added to the store are deleted during the store cleanup.
+func addHPAV2(deployment *appsv1.Deployment, componentOverride *datadoghqv2alpha1.DatadogAgentComponentOverride, resourcesManager feature.ResourceManagers) error {
+	if componentOverride == nil || componentOverride.Autoscaling == nil {
+		return nil
+	}
+	config := componentOverride.Autoscaling
+
+	minReplicas := hpaMinReplicas(config)
+	if config.MaxReplicas < minReplicas {
+		return fmt.Errorf("autoscaling.maxReplicas must be greater than or equal to autoscaling.minReplicas for %s", deployment.Name)
+	}
+
+	hpa, err := buildHPAV2(resourcesManager.Store().GetPlatformInfo(), deployment, config)
+	if err != nil {
+		return err
+	}
+
+	// Let the HorizontalPodAutoscaler manage the number of replicas
+	deployment.Spec.Replicas = nil
+
+	return resourcesManager.Store().AddOrUpdate(kubernetes.HorizontalPodAutoscalersKind, hpa)
+}
+
+// buildHPAV2 builds the HorizontalPodAutoscaler of a Deployment, in the autoscaling API version supported by the cluster.
+func buildHPAV2(platformInfo kubernetes.PlatformInfo, deployment *appsv1.Deployment, config *datadoghqv2alpha1.AutoscalingConfig) (client.Object, error) {
+	// Set the defaults of the API server to not update the HorizontalPodAutoscaler at each reconcile
+	spec := autoscalingv2.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: appsv1.SchemeGroupVersion.String(),
+			Kind:       "Deployment",
+			Name:       deployment.Name,
+		},
+		MinReplicas: apiutils.NewInt32Pointer(hpaMinReplicas(config)),
+		MaxReplicas: config.MaxReplicas,
+	}
+
+	targetCPU := config.TargetCPUUtilizationPercentage
+	if targetCPU == nil && len(config.Metrics) == 0 {
+		targetCPU = apiutils.NewInt32Pointer(hpaDefaultTargetCPUUtilizationPercentage)
+	}
+	if targetCPU != nil {
+		spec.Metrics = append(spec.Metrics, autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: targetCPU,
+				},
+			},
+		})
+	}
+	for _, metric := range config.Metrics {
+		spec.Metrics = append(spec.Metrics, *metric.DeepCopy())
+	}
+
+	hpa := platformInfo.CreateHPAObject()
+	hpa.SetName(deployment.Name)
+	hpa.SetNamespace(deployment.Namespace)
+	hpa.SetLabels(map[string]string{
+		apicommon.AgentDeploymentComponentLabelKey: deployment.Labels[apicommon.AgentDeploymentComponentLabelKey],
+	})
+
+	switch obj := hpa.(type) {
+	case *autoscalingv2.HorizontalPodAutoscaler:
+		obj.Spec = spec
+	case *autoscalingv2beta2.HorizontalPodAutoscaler:
+		// autoscaling/v2 has the same schema as autoscaling/v2beta2
+		data, err := json.Marshal(spec)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &obj.Spec); err != nil {
+			return nil, err
+		}
+	}
+
+	return hpa, nil
+}
+
+func hpaMinReplicas(config *datadoghqv2alpha1.AutoscalingConfig) int32 {
+	if config.MinReplicas != nil {
+		return *config.MinReplicas
+	}
+	return hpaDefaultMinReplicas
+}
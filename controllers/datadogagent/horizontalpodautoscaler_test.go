@@ -0,0 +1,185 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	componentccr "github.com/DataDog/datadog-operator/controllers/datadogagent/component/clusterchecksrunner"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/dependencies"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+func Test_addHPAV2(t *testing.T) {
+	checksMetric := autoscalingv2.MetricSpec{
+		Type: autoscalingv2.ExternalMetricSourceType,
+		External: &autoscalingv2.ExternalMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{Name: "datadog.cluster_agent.cluster_checks.configs_dispatched"},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: resource.NewQuantity(20, resource.DecimalSI),
+			},
+		},
+	}
+	cpuMetric := func(target int32) autoscalingv2.MetricSpec {
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: corev1.ResourceCPU,
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: apiutils.NewInt32Pointer(target),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		override        *datadoghqv2alpha1.DatadogAgentComponentOverride
+		wantErr         bool
+		wantHPA         bool
+		wantMinReplicas int32
+		wantMetrics     []autoscalingv2.MetricSpec
+	}{
+		{
+			name:    "no override",
+			wantHPA: false,
+		},
+		{
+			name:     "no autoscaling",
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{Replicas: apiutils.NewInt32Pointer(3)},
+			wantHPA:  false,
+		},
+		{
+			name: "default CPU target",
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				Autoscaling: &datadoghqv2alpha1.AutoscalingConfig{MaxReplicas: 5},
+			},
+			wantHPA:         true,
+			wantMinReplicas: 1,
+			wantMetrics:     []autoscalingv2.MetricSpec{cpuMetric(80)},
+		},
+		{
+			name: "custom metric only",
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				Autoscaling: &datadoghqv2alpha1.AutoscalingConfig{
+					MinReplicas: apiutils.NewInt32Pointer(2),
+					MaxReplicas: 10,
+					Metrics:     []autoscalingv2.MetricSpec{checksMetric},
+				},
+			},
+			wantHPA:         true,
+			wantMinReplicas: 2,
+			wantMetrics:     []autoscalingv2.MetricSpec{checksMetric},
+		},
+		{
+			name: "CPU target and custom metric",
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				Autoscaling: &datadoghqv2alpha1.AutoscalingConfig{
+					MaxReplicas:                    10,
+					TargetCPUUtilizationPercentage: apiutils.NewInt32Pointer(60),
+					Metrics:                        []autoscalingv2.MetricSpec{checksMetric},
+				},
+			},
+			wantHPA:         true,
+			wantMinReplicas: 1,
+			wantMetrics:     []autoscalingv2.MetricSpec{cpuMetric(60), checksMetric},
+		},
+		{
+			name: "maxReplicas lower than minReplicas",
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				Autoscaling: &datadoghqv2alpha1.AutoscalingConfig{
+					MinReplicas: apiutils.NewInt32Pointer(3),
+					MaxReplicas: 2,
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, platformInfo := range []kubernetes.PlatformInfo{platformInfoWithHPAV2(), platformInfoWithHPAV2Beta2()} {
+			t.Run(tt.name, func(t *testing.T) {
+				dda := &datadoghqv2alpha1.DatadogAgent{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: "bar",
+					},
+				}
+				deployment := componentccr.NewDefaultClusterChecksRunnerDeployment(dda)
+
+				store := dependencies.NewStore(nil, &dependencies.StoreOptions{PlatformInfo: platformInfo})
+				err := addHPAV2(deployment, tt.override, feature.NewResourceManagers(store))
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+
+				obj, found := store.Get(kubernetes.HorizontalPodAutoscalersKind, "bar", "foo-cluster-checks-runner")
+				require.Equal(t, tt.wantHPA, found)
+				if !found {
+					assert.NotNil(t, deployment.Spec.Replicas)
+					return
+				}
+				assert.Nil(t, deployment.Spec.Replicas)
+
+				switch hpa := obj.(type) {
+				case *autoscalingv2.HorizontalPodAutoscaler:
+					assert.False(t, platformInfo.UseV2Beta2HPA())
+					assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+					assert.Equal(t, deployment.Name, hpa.Spec.ScaleTargetRef.Name)
+					assert.Equal(t, tt.wantMinReplicas, *hpa.Spec.MinReplicas)
+					assert.Equal(t, tt.override.Autoscaling.MaxReplicas, hpa.Spec.MaxReplicas)
+					assert.Equal(t, tt.wantMetrics, hpa.Spec.Metrics)
+				case *autoscalingv2beta2.HorizontalPodAutoscaler:
+					assert.True(t, platformInfo.UseV2Beta2HPA())
+					assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+					assert.Equal(t, deployment.Name, hpa.Spec.ScaleTargetRef.Name)
+					assert.Equal(t, tt.wantMinReplicas, *hpa.Spec.MinReplicas)
+					assert.Equal(t, tt.override.Autoscaling.MaxReplicas, hpa.Spec.MaxReplicas)
+					assert.Len(t, hpa.Spec.Metrics, len(tt.wantMetrics))
+				default:
+					t.Fatalf("unexpected HorizontalPodAutoscaler type %T", obj)
+				}
+			})
+		}
+	}
+}
+
+func platformInfoWithHPAV2() kubernetes.PlatformInfo {
+	return kubernetes.NewPlatformInfoFromVersionMaps(
+		nil,
+		map[string]string{
+			"HorizontalPodAutoscaler": "autoscaling/v2",
+		},
+		map[string]string{},
+	)
+}
+
+func platformInfoWithHPAV2Beta2() kubernetes.PlatformInfo {
+	return kubernetes.NewPlatformInfoFromVersionMaps(
+		nil,
+		map[string]string{
+			"HorizontalPodAutoscaler": "autoscaling/v1",
+		},
+		map[string]string{
+			"HorizontalPodAutoscaler": "autoscaling/v2beta2",
+		},
+	)
+}
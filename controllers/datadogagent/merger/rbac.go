@@ -23,6 +23,7 @@ type RBACManager interface {
 	AddServiceAccountByComponent(namespace, name, component string) error
 	AddPolicyRules(namespace string, roleName string, saName string, policies []rbacv1.PolicyRule) error
 	AddPolicyRulesByComponent(namespace string, roleName string, saName string, policies []rbacv1.PolicyRule, component string) error
+	AddRole(namespace string, roleName string, policies []rbacv1.PolicyRule) error
 	AddRoleBinding(roleNamespace, roleName, saNamespace, saName string, roleRef rbacv1.RoleRef) error
 	AddClusterPolicyRules(namespace string, roleName string, saName string, policies []rbacv1.PolicyRule) error
 	AddClusterPolicyRulesByComponent(namespace string, roleName string, saName string, policies []rbacv1.PolicyRule, component string) error
@@ -88,15 +89,7 @@ func (m *rbacManagerImpl) DeleteServiceAccountByComponent(component, namespace s
 
 // AddPolicyRules is used to add PolicyRules to a Role. It also creates the RoleBinding.
 func (m *rbacManagerImpl) AddPolicyRules(namespace string, roleName string, saName string, policies []rbacv1.PolicyRule) error {
-	obj, _ := m.store.GetOrCreate(kubernetes.RolesKind, namespace, roleName)
-	role, ok := obj.(*rbacv1.Role)
-	if !ok {
-		return fmt.Errorf("unable to get from the store the ClusterRole %s", roleName)
-	}
-
-	// TODO: can be improve by checking if the policies don't already existe.
-	role.Rules = append(role.Rules, policies...)
-	if err := m.store.AddOrUpdate(kubernetes.RolesKind, role); err != nil {
+	if err := m.AddRole(namespace, roleName, policies); err != nil {
 		return err
 	}
 
@@ -109,6 +102,20 @@ func (m *rbacManagerImpl) AddPolicyRules(namespace string, roleName string, saNa
 	return m.AddRoleBinding(namespace, roleName, namespace, saName, roleRef)
 }
 
+// AddRole is used to add PolicyRules to a Role, without RoleBinding.
+// It allows to bind the Role to ServiceAccounts of other namespaces with AddRoleBinding.
+func (m *rbacManagerImpl) AddRole(namespace string, roleName string, policies []rbacv1.PolicyRule) error {
+	obj, _ := m.store.GetOrCreate(kubernetes.RolesKind, namespace, roleName)
+	role, ok := obj.(*rbacv1.Role)
+	if !ok {
+		return fmt.Errorf("unable to get from the store the Role %s/%s", namespace, roleName)
+	}
+
+	// TODO: can be improve by checking if the policies don't already existe.
+	role.Rules = append(role.Rules, policies...)
+	return m.store.AddOrUpdate(kubernetes.RolesKind, role)
+}
+
 // AddPolicyRulesByComponent is used to add PolicyRules to a Role, create a RoleBinding, and associate them with a component
 func (m *rbacManagerImpl) AddPolicyRulesByComponent(namespace string, roleName string, saName string, policies []rbacv1.PolicyRule, component string) error {
 	m.roleByComponent[component] = append(m.roleByComponent[component], roleName)
@@ -16,6 +16,27 @@ func DaemonSet(daemonSet *v1.DaemonSet, override *v2alpha1.DatadogAgentComponent
 	if override.Name != nil {
 		daemonSet.Name = *override.Name
 	}
+
+	if override.UpdateStrategy != nil {
+		updateStrategy := &daemonSet.Spec.UpdateStrategy
+		if override.UpdateStrategy.Type != "" {
+			updateStrategy.Type = override.UpdateStrategy.Type
+		}
+
+		if updateStrategy.Type == v1.OnDeleteDaemonSetStrategyType {
+			updateStrategy.RollingUpdate = nil
+		} else if rollingUpdate := override.UpdateStrategy.RollingUpdate; rollingUpdate != nil {
+			if updateStrategy.RollingUpdate == nil {
+				updateStrategy.RollingUpdate = &v1.RollingUpdateDaemonSet{}
+			}
+			if rollingUpdate.MaxUnavailable != nil {
+				updateStrategy.RollingUpdate.MaxUnavailable = rollingUpdate.MaxUnavailable
+			}
+			if rollingUpdate.MaxSurge != nil {
+				updateStrategy.RollingUpdate.MaxSurge = rollingUpdate.MaxSurge
+			}
+		}
+	}
 }
 
 // ExtendedDaemonSet overrides an ExtendedDaemonSet according to the given override options
@@ -23,4 +44,73 @@ func ExtendedDaemonSet(eds *edsv1alpha1.ExtendedDaemonSet, override *v2alpha1.Da
 	if override.Name != nil {
 		eds.Name = *override.Name
 	}
+
+	if override.UpdateStrategy != nil {
+		if rollingUpdate := override.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.MaxUnavailable != nil {
+			eds.Spec.Strategy.RollingUpdate.MaxUnavailable = rollingUpdate.MaxUnavailable
+		}
+
+		if override.UpdateStrategy.Canary != nil {
+			if eds.Spec.Strategy.Canary == nil {
+				eds.Spec.Strategy.Canary = &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary{}
+			}
+			extendedDaemonSetCanary(eds.Spec.Strategy.Canary, override.UpdateStrategy.Canary)
+		}
+	}
+}
+
+// extendedDaemonSetCanary overrides the fields of the canary configuration that are set in the override,
+// so that the defaults provided by the operator flags are kept for the other ones.
+func extendedDaemonSetCanary(canary *edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary, override *edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary) {
+	if override.Replicas != nil {
+		canary.Replicas = override.Replicas
+	}
+	if override.Duration != nil {
+		canary.Duration = override.Duration
+	}
+	if override.NodeSelector != nil {
+		canary.NodeSelector = override.NodeSelector
+	}
+	if override.NodeAntiAffinityKeys != nil {
+		canary.NodeAntiAffinityKeys = override.NodeAntiAffinityKeys
+	}
+	if override.NoRestartsDuration != nil {
+		canary.NoRestartsDuration = override.NoRestartsDuration
+	}
+	if override.ValidationMode != "" {
+		canary.ValidationMode = override.ValidationMode
+	}
+
+	if autoPause := override.AutoPause; autoPause != nil {
+		if canary.AutoPause == nil {
+			canary.AutoPause = &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanaryAutoPause{}
+		}
+		if autoPause.Enabled != nil {
+			canary.AutoPause.Enabled = autoPause.Enabled
+		}
+		if autoPause.MaxRestarts != nil {
+			canary.AutoPause.MaxRestarts = autoPause.MaxRestarts
+		}
+		if autoPause.MaxSlowStartDuration != nil {
+			canary.AutoPause.MaxSlowStartDuration = autoPause.MaxSlowStartDuration
+		}
+	}
+
+	if autoFail := override.AutoFail; autoFail != nil {
+		if canary.AutoFail == nil {
+			canary.AutoFail = &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanaryAutoFail{}
+		}
+		if autoFail.Enabled != nil {
+			canary.AutoFail.Enabled = autoFail.Enabled
+		}
+		if autoFail.MaxRestarts != nil {
+			canary.AutoFail.MaxRestarts = autoFail.MaxRestarts
+		}
+		if autoFail.MaxRestartsDuration != nil {
+			canary.AutoFail.MaxRestartsDuration = autoFail.MaxRestartsDuration
+		}
+		if autoFail.CanaryTimeout != nil {
+			canary.AutoFail.CanaryTimeout = autoFail.CanaryTimeout
+		}
+	}
 }
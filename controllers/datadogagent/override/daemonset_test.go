@@ -0,0 +1,128 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package override
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	edsv1alpha1 "github.com/DataDog/extendeddaemonset/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDaemonSet(t *testing.T) {
+	maxUnavailable := intstr.FromString("10%")
+	maxSurge := intstr.FromInt(1)
+
+	tests := []struct {
+		name           string
+		override       v2alpha1.DatadogAgentComponentOverride
+		wantName       string
+		wantUpdateType v1.DaemonSetUpdateStrategyType
+		wantRolling    *v1.RollingUpdateDaemonSet
+	}{
+		{
+			name:           "name only",
+			override:       v2alpha1.DatadogAgentComponentOverride{Name: apiutils.NewStringPointer("new-name")},
+			wantName:       "new-name",
+			wantUpdateType: v1.RollingUpdateDaemonSetStrategyType,
+		},
+		{
+			name: "rolling update",
+			override: v2alpha1.DatadogAgentComponentOverride{
+				UpdateStrategy: &v2alpha1.UpdateStrategy{
+					Type:          v1.RollingUpdateDaemonSetStrategyType,
+					RollingUpdate: &v2alpha1.RollingUpdate{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge},
+				},
+			},
+			wantName:       "current-name",
+			wantUpdateType: v1.RollingUpdateDaemonSetStrategyType,
+			wantRolling:    &v1.RollingUpdateDaemonSet{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge},
+		},
+		{
+			name: "on delete",
+			override: v2alpha1.DatadogAgentComponentOverride{
+				UpdateStrategy: &v2alpha1.UpdateStrategy{Type: v1.OnDeleteDaemonSetStrategyType},
+			},
+			wantName:       "current-name",
+			wantUpdateType: v1.OnDeleteDaemonSetStrategyType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			daemonSet := v1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "current-name",
+				},
+				Spec: v1.DaemonSetSpec{
+					UpdateStrategy: v1.DaemonSetUpdateStrategy{Type: v1.RollingUpdateDaemonSetStrategyType},
+				},
+			}
+
+			DaemonSet(&daemonSet, &tt.override)
+
+			assert.Equal(t, tt.wantName, daemonSet.Name)
+			assert.Equal(t, tt.wantUpdateType, daemonSet.Spec.UpdateStrategy.Type)
+			assert.Equal(t, tt.wantRolling, daemonSet.Spec.UpdateStrategy.RollingUpdate)
+		})
+	}
+}
+
+func TestExtendedDaemonSet(t *testing.T) {
+	defaultMaxUnavailable := intstr.FromInt(1)
+	maxUnavailable := intstr.FromString("10%")
+	canaryReplicas := intstr.FromInt(3)
+
+	eds := edsv1alpha1.ExtendedDaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "current-name",
+		},
+		Spec: edsv1alpha1.ExtendedDaemonSetSpec{
+			Strategy: edsv1alpha1.ExtendedDaemonSetSpecStrategy{
+				RollingUpdate: edsv1alpha1.ExtendedDaemonSetSpecStrategyRollingUpdate{MaxUnavailable: &defaultMaxUnavailable},
+				Canary: &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary{
+					Duration: &metav1.Duration{Duration: 10 * time.Minute},
+					AutoPause: &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanaryAutoPause{
+						Enabled:     apiutils.NewBoolPointer(true),
+						MaxRestarts: apiutils.NewInt32Pointer(2),
+					},
+				},
+			},
+		},
+	}
+
+	override := v2alpha1.DatadogAgentComponentOverride{
+		Name: apiutils.NewStringPointer("new-name"),
+		UpdateStrategy: &v2alpha1.UpdateStrategy{
+			RollingUpdate: &v2alpha1.RollingUpdate{MaxUnavailable: &maxUnavailable},
+			Canary: &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary{
+				Replicas: &canaryReplicas,
+				AutoPause: &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanaryAutoPause{
+					Enabled: apiutils.NewBoolPointer(false),
+				},
+			},
+		},
+	}
+
+	ExtendedDaemonSet(&eds, &override)
+
+	assert.Equal(t, "new-name", eds.Name)
+	assert.Equal(t, &maxUnavailable, eds.Spec.Strategy.RollingUpdate.MaxUnavailable)
+	assert.Equal(t, &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanary{
+		Replicas: &canaryReplicas,
+		Duration: &metav1.Duration{Duration: 10 * time.Minute},
+		AutoPause: &edsv1alpha1.ExtendedDaemonSetSpecStrategyCanaryAutoPause{
+			Enabled:     apiutils.NewBoolPointer(false),
+			MaxRestarts: apiutils.NewInt32Pointer(2),
+		},
+	}, eds.Spec.Strategy.Canary)
+}
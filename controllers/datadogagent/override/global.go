@@ -25,7 +25,8 @@ import (
 )
 
 // ApplyGlobalSettings use to apply global setting to a PodTemplateSpec
-func ApplyGlobalSettings(logger logr.Logger, manager feature.PodTemplateManagers, dda *v2alpha1.DatadogAgent, resourcesManager feature.ResourceManagers, componentName v2alpha1.ComponentName) *corev1.PodTemplateSpec {
+// It returns an error when the additional endpoints can't be configured, the component mustn't be updated in this case.
+func ApplyGlobalSettings(logger logr.Logger, manager feature.PodTemplateManagers, dda *v2alpha1.DatadogAgent, resourcesManager feature.ResourceManagers, componentName v2alpha1.ComponentName) (*corev1.PodTemplateSpec, error) {
 	config := dda.Spec.Global
 
 	// ClusterName sets a unique cluster name for the deployment to easily scope monitoring data in the Datadog app.
@@ -50,6 +51,26 @@ func ApplyGlobalSettings(logger logr.Logger, manager feature.PodTemplateManagers
 		})
 	}
 
+	// AdditionalEndpoints lists additional Datadog intakes the metrics are also sent to.
+	metricsEndpointsEnvVars, err := component.GetAdditionalEndpointsEnvVars(config.AdditionalEndpoints, v2alpha1.AdditionalEndpointTypeMetrics, apicommon.DDAdditionalEndpoints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure the metrics additional endpoints: %w", err)
+	}
+	for _, envVar := range metricsEndpointsEnvVars {
+		manager.EnvVar().AddEnvVar(envVar)
+	}
+
+	// The processes are also sent to the additional processes intakes, whichever feature runs the process-agent.
+	if componentName == v2alpha1.NodeAgentComponentName {
+		processesEndpointsEnvVars, err := component.GetAdditionalEndpointsEnvVars(config.AdditionalEndpoints, v2alpha1.AdditionalEndpointTypeProcesses, apicommon.DDProcessAdditionalEndpoints)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure the processes additional endpoints: %w", err)
+		}
+		for _, envVar := range processesEndpointsEnvVars {
+			manager.EnvVar().AddEnvVarToContainer(apicommonv1.ProcessAgentContainerName, envVar)
+		}
+	}
+
 	// Registry is the image registry to use for all Agent images.
 	if *config.Registry != apicommon.DefaultImageRegistry {
 		image := apicommon.DefaultAgentImageName
@@ -173,6 +194,12 @@ func ApplyGlobalSettings(logger logr.Logger, manager feature.PodTemplateManagers
 		}
 	}
 
+	// ContainerFilters includes or excludes containers from the data collection.
+	// They are applied to every component so that all the Agents collect the same containers.
+	for _, envVar := range component.GetContainerFiltersEnvVars(config.ContainerFilters) {
+		manager.EnvVar().AddEnvVar(envVar)
+	}
+
 	if componentName == v2alpha1.NodeAgentComponentName {
 		// Kubelet contains the kubelet configuration parameters.
 		// The environment variable `DD_KUBERNETES_KUBELET_HOST` defaults to `status.hostIP` if not overriden.
@@ -250,5 +277,5 @@ func ApplyGlobalSettings(logger logr.Logger, manager feature.PodTemplateManagers
 		}
 	}
 
-	return manager.PodTemplateSpec()
+	return manager.PodTemplateSpec(), nil
 }
@@ -0,0 +1,177 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package override
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
+	apicommonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature/fake"
+	mergerfake "github.com/DataDog/datadog-operator/controllers/datadogagent/merger/fake"
+)
+
+func TestApplyGlobalSettingsContainerFilters(t *testing.T) {
+	filters := &v2alpha1.ContainerFiltersConfig{
+		All: &v2alpha1.ContainerFilterConfig{
+			Exclude: []v2alpha1.ContainerFilter{
+				{Type: v2alpha1.ContainerFilterTypeKubeNamespace, Value: "^kube-system$"},
+				{Type: v2alpha1.ContainerFilterTypeImage, Value: "^gcr.io/datadoghq/agent$"},
+			},
+		},
+		Metrics: &v2alpha1.ContainerFilterConfig{
+			Include: []v2alpha1.ContainerFilter{{Type: v2alpha1.ContainerFilterTypeName, Value: "^kube-apiserver$"}},
+		},
+		Logs: &v2alpha1.ContainerFilterConfig{
+			Include: []v2alpha1.ContainerFilter{{Type: v2alpha1.ContainerFilterTypeKubeNamespace, Value: "^payments$"}},
+			Exclude: []v2alpha1.ContainerFilter{{Type: v2alpha1.ContainerFilterTypeName, Value: ".*"}},
+		},
+	}
+
+	wantEnvVars := []*v1.EnvVar{
+		{Name: apicommon.DDContainerExclude, Value: "kube_namespace:^kube-system$ image:^gcr.io/datadoghq/agent$"},
+		{Name: apicommon.DDContainerIncludeMetrics, Value: "name:^kube-apiserver$"},
+		{Name: apicommon.DDContainerIncludeLogs, Value: "kube_namespace:^payments$"},
+		{Name: apicommon.DDContainerExcludeLogs, Value: "name:.*"},
+	}
+
+	for _, componentName := range []v2alpha1.ComponentName{
+		v2alpha1.NodeAgentComponentName,
+		v2alpha1.ClusterAgentComponentName,
+		v2alpha1.ClusterChecksRunnerComponentName,
+	} {
+		t.Run(string(componentName), func(t *testing.T) {
+			dda := &v2alpha1.DatadogAgent{
+				Spec: v2alpha1.DatadogAgentSpec{
+					Global: &v2alpha1.GlobalConfig{
+						Site:             apiutils.NewStringPointer("datadoghq.com"),
+						Registry:         apiutils.NewStringPointer(apicommon.DefaultImageRegistry),
+						LogLevel:         apiutils.NewStringPointer("info"),
+						ContainerFilters: filters,
+					},
+				},
+			}
+			manager := fake.NewPodTemplateManagers(t, v1.PodTemplateSpec{})
+
+			_, err := ApplyGlobalSettings(logr.Discard(), manager, dda, nil, componentName)
+			require.NoError(t, err)
+
+			envVars := manager.EnvVarMgr.EnvVarsByC[mergerfake.AllContainers]
+			for _, envVar := range wantEnvVars {
+				assert.Contains(t, envVars, envVar)
+			}
+			for _, envVar := range envVars {
+				assert.NotEqual(t, apicommon.DDContainerInclude, envVar.Name, "The include list of all the data is empty")
+			}
+		})
+	}
+}
+
+func TestApplyGlobalSettingsAdditionalEndpoints(t *testing.T) {
+	endpoints := []v2alpha1.AdditionalEndpoint{
+		{
+			Type:      v2alpha1.AdditionalEndpointTypeMetrics,
+			URL:       "https://app.datadoghq.eu",
+			APISecret: apicommonv1.SecretConfig{SecretName: "datadog-eu"},
+		},
+		{
+			Type:      v2alpha1.AdditionalEndpointTypeProcesses,
+			URL:       "https://process.datadoghq.eu",
+			APISecret: apicommonv1.SecretConfig{SecretName: "datadog-eu", KeyName: "process_api_key"},
+		},
+		{
+			Type:      v2alpha1.AdditionalEndpointTypeLogs,
+			URL:       "agent-intake.logs.datadoghq.eu:443",
+			APISecret: apicommonv1.SecretConfig{SecretName: "datadog-eu"},
+		},
+	}
+
+	wantMetricsEnvVars := []*v1.EnvVar{
+		{
+			Name: "ADDITIONAL_ENDPOINTS_API_KEY_0",
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "datadog-eu"},
+					Key:                  apicommon.DefaultAPIKeyKey,
+				},
+			},
+		},
+		{
+			Name:  apicommon.DDAdditionalEndpoints,
+			Value: `{"https://app.datadoghq.eu":["$(ADDITIONAL_ENDPOINTS_API_KEY_0)"]}`,
+		},
+	}
+	wantProcessesEnvVars := []*v1.EnvVar{
+		{
+			Name: "PROCESS_ADDITIONAL_ENDPOINTS_API_KEY_0",
+			ValueFrom: &v1.EnvVarSource{
+				SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "datadog-eu"},
+					Key:                  "process_api_key",
+				},
+			},
+		},
+		{
+			Name:  apicommon.DDProcessAdditionalEndpoints,
+			Value: `{"https://process.datadoghq.eu":["$(PROCESS_ADDITIONAL_ENDPOINTS_API_KEY_0)"]}`,
+		},
+	}
+
+	tests := []struct {
+		name                 string
+		componentName        v2alpha1.ComponentName
+		wantProcessesEnvVars []*v1.EnvVar
+	}{
+		{
+			name:                 "node agent",
+			componentName:        v2alpha1.NodeAgentComponentName,
+			wantProcessesEnvVars: wantProcessesEnvVars,
+		},
+		{
+			name:          "cluster agent",
+			componentName: v2alpha1.ClusterAgentComponentName,
+		},
+		{
+			name:          "cluster checks runner",
+			componentName: v2alpha1.ClusterChecksRunnerComponentName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dda := &v2alpha1.DatadogAgent{
+				Spec: v2alpha1.DatadogAgentSpec{
+					Global: &v2alpha1.GlobalConfig{
+						Site:                apiutils.NewStringPointer("datadoghq.com"),
+						Registry:            apiutils.NewStringPointer(apicommon.DefaultImageRegistry),
+						LogLevel:            apiutils.NewStringPointer("info"),
+						AdditionalEndpoints: endpoints,
+					},
+				},
+			}
+			manager := fake.NewPodTemplateManagers(t, v1.PodTemplateSpec{})
+
+			_, err := ApplyGlobalSettings(logr.Discard(), manager, dda, nil, tt.componentName)
+			require.NoError(t, err)
+
+			envVars := manager.EnvVarMgr.EnvVarsByC[mergerfake.AllContainers]
+			for _, envVar := range wantMetricsEnvVars {
+				assert.Contains(t, envVars, envVar)
+			}
+			for _, envVar := range envVars {
+				assert.NotEqual(t, apicommon.DDLogsConfigAdditionalEndpoints, envVar.Name, "The logs additional endpoints are configured by the logs feature")
+			}
+			assert.Equal(t, tt.wantProcessesEnvVars, manager.EnvVarMgr.EnvVarsByC[apicommonv1.ProcessAgentContainerName])
+		})
+	}
+}
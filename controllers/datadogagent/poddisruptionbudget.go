@@ -7,6 +7,7 @@ package datadogagent
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/go-logr/logr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -15,9 +16,13 @@ import (
 
 	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
 	"github.com/DataDog/datadog-operator/controllers/datadogagent/object"
 	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
 	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -279,3 +284,68 @@ func buildPDBV1Beta1(metadata metav1.ObjectMeta, matchLabels map[string]string,
 
 	return pdb
 }
+
+// addPDBV2 adds the PodDisruptionBudget of a v2alpha1 Deployment component to the dependencies store.
+// Unless it is explicitly enabled or configured, a PodDisruptionBudget with minAvailable 1 is only added when
+// the Deployment runs more than one replica, or at least two when it is autoscaled, to not block node drains. PodDisruptionBudgets that are not added to the
+// store are deleted during the store cleanup.
+func addPDBV2(deployment *appsv1.Deployment, componentOverride *datadoghqv2alpha1.DatadogAgentComponentOverride, resourcesManager feature.ResourceManagers) error {
+	var config *datadoghqv2alpha1.PodDisruptionBudgetConfig
+	if componentOverride != nil {
+		config = componentOverride.PodDisruptionBudget
+	}
+
+	var minAvailable, maxUnavailable *intstr.IntOrString
+	if config != nil {
+		if config.Enabled != nil && !*config.Enabled {
+			return nil
+		}
+		if config.MinAvailable != nil && config.MaxUnavailable != nil {
+			return fmt.Errorf("podDisruptionBudget.minAvailable and podDisruptionBudget.maxUnavailable cannot be set together for %s", deployment.Name)
+		}
+		minAvailable, maxUnavailable = config.MinAvailable, config.MaxUnavailable
+	}
+
+	if minAvailable == nil && maxUnavailable == nil {
+		replicas := deployment.Spec.Replicas
+		if componentOverride != nil && componentOverride.Autoscaling != nil {
+			// The replicas are managed by the HorizontalPodAutoscaler
+			replicas = apiutils.NewInt32Pointer(hpaMinReplicas(componentOverride.Autoscaling))
+		}
+		forceEnabled := config != nil && apiutils.BoolValue(config.Enabled)
+		if !forceEnabled && (replicas == nil || *replicas <= 1) {
+			return nil
+		}
+		defaultMinAvailable := intstr.FromInt(pdbMinAvailableInstances)
+		minAvailable = &defaultMinAvailable
+	}
+
+	return resourcesManager.Store().AddOrUpdate(kubernetes.PodDisruptionBudgetsKind, buildPDBV2(resourcesManager.Store().GetPlatformInfo(), deployment, minAvailable, maxUnavailable))
+}
+
+// buildPDBV2 builds the PodDisruptionBudget of a Deployment, in the policy API version supported by the cluster.
+func buildPDBV2(platformInfo kubernetes.PlatformInfo, deployment *appsv1.Deployment, minAvailable, maxUnavailable *intstr.IntOrString) client.Object {
+	pdb := platformInfo.CreatePDBObject()
+	pdb.SetName(deployment.Name)
+	pdb.SetNamespace(deployment.Namespace)
+	pdb.SetLabels(map[string]string{
+		apicommon.AgentDeploymentComponentLabelKey: deployment.Labels[apicommon.AgentDeploymentComponentLabelKey],
+	})
+
+	switch obj := pdb.(type) {
+	case *policyv1.PodDisruptionBudget:
+		obj.Spec = policyv1.PodDisruptionBudgetSpec{
+			Selector:       deployment.Spec.Selector.DeepCopy(),
+			MinAvailable:   minAvailable,
+			MaxUnavailable: maxUnavailable,
+		}
+	case *policyv1beta1.PodDisruptionBudget:
+		obj.Spec = policyv1beta1.PodDisruptionBudgetSpec{
+			Selector:       deployment.Spec.Selector.DeepCopy(),
+			MinAvailable:   minAvailable,
+			MaxUnavailable: maxUnavailable,
+		}
+	}
+
+	return pdb
+}
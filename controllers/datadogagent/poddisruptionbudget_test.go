@@ -0,0 +1,171 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogagent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	datadoghqv2alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v2alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	componentdca "github.com/DataDog/datadog-operator/controllers/datadogagent/component/clusteragent"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/dependencies"
+	"github.com/DataDog/datadog-operator/controllers/datadogagent/feature"
+	"github.com/DataDog/datadog-operator/pkg/kubernetes"
+)
+
+func Test_addPDBV2(t *testing.T) {
+	minAvailable := intstr.FromInt(1)
+	twoMinAvailable := intstr.FromInt(2)
+	maxUnavailable := intstr.FromString("50%")
+
+	tests := []struct {
+		name               string
+		replicas           int32
+		override           *datadoghqv2alpha1.DatadogAgentComponentOverride
+		wantErr            bool
+		wantPDB            bool
+		wantMinAvailable   *intstr.IntOrString
+		wantMaxUnavailable *intstr.IntOrString
+	}{
+		{
+			name:     "single replica, no override",
+			replicas: 1,
+			wantPDB:  false,
+		},
+		{
+			name:             "several replicas, no override",
+			replicas:         2,
+			wantPDB:          true,
+			wantMinAvailable: &minAvailable,
+		},
+		{
+			name:     "single replica, explicitly enabled",
+			replicas: 1,
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				PodDisruptionBudget: &datadoghqv2alpha1.PodDisruptionBudgetConfig{
+					Enabled: apiutils.NewBoolPointer(true),
+				},
+			},
+			wantPDB:          true,
+			wantMinAvailable: &minAvailable,
+		},
+		{
+			name:     "autoscaled with several min replicas",
+			replicas: 1,
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				Autoscaling: &datadoghqv2alpha1.AutoscalingConfig{
+					MinReplicas: apiutils.NewInt32Pointer(2),
+					MaxReplicas: 4,
+				},
+			},
+			wantPDB:          true,
+			wantMinAvailable: &minAvailable,
+		},
+		{
+			name:     "several replicas, autoscaled from a single replica",
+			replicas: 3,
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				Autoscaling: &datadoghqv2alpha1.AutoscalingConfig{MaxReplicas: 4},
+			},
+			wantPDB: false,
+		},
+		{
+			name:     "several replicas, disabled",
+			replicas: 3,
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				PodDisruptionBudget: &datadoghqv2alpha1.PodDisruptionBudgetConfig{
+					Enabled:      apiutils.NewBoolPointer(false),
+					MinAvailable: &twoMinAvailable,
+				},
+			},
+			wantPDB: false,
+		},
+		{
+			name:     "custom minAvailable",
+			replicas: 3,
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				PodDisruptionBudget: &datadoghqv2alpha1.PodDisruptionBudgetConfig{
+					MinAvailable: &twoMinAvailable,
+				},
+			},
+			wantPDB:          true,
+			wantMinAvailable: &twoMinAvailable,
+		},
+		{
+			name:     "custom maxUnavailable",
+			replicas: 1,
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				PodDisruptionBudget: &datadoghqv2alpha1.PodDisruptionBudgetConfig{
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+			wantPDB:            true,
+			wantMaxUnavailable: &maxUnavailable,
+		},
+		{
+			name:     "minAvailable and maxUnavailable",
+			replicas: 3,
+			override: &datadoghqv2alpha1.DatadogAgentComponentOverride{
+				PodDisruptionBudget: &datadoghqv2alpha1.PodDisruptionBudgetConfig{
+					MinAvailable:   &twoMinAvailable,
+					MaxUnavailable: &maxUnavailable,
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		for _, platformInfo := range []kubernetes.PlatformInfo{platformInfoWithPDBV1(), platformInfoWithPDBV1beta1()} {
+			t.Run(tt.name, func(t *testing.T) {
+				dda := &datadoghqv2alpha1.DatadogAgent{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: "bar",
+					},
+				}
+				deployment := componentdca.NewDefaultClusterAgentDeployment(dda)
+				deployment.Spec.Replicas = apiutils.NewInt32Pointer(tt.replicas)
+
+				store := dependencies.NewStore(nil, &dependencies.StoreOptions{PlatformInfo: platformInfo})
+				err := addPDBV2(deployment, tt.override, feature.NewResourceManagers(store))
+				if tt.wantErr {
+					assert.Error(t, err)
+					return
+				}
+				require.NoError(t, err)
+
+				obj, found := store.Get(kubernetes.PodDisruptionBudgetsKind, "bar", "foo-cluster-agent")
+				require.Equal(t, tt.wantPDB, found)
+				if !found {
+					return
+				}
+
+				switch pdb := obj.(type) {
+				case *policyv1.PodDisruptionBudget:
+					assert.False(t, platformInfo.UseV1Beta1PDB())
+					assert.Equal(t, deployment.Spec.Selector, pdb.Spec.Selector)
+					assert.Equal(t, tt.wantMinAvailable, pdb.Spec.MinAvailable)
+					assert.Equal(t, tt.wantMaxUnavailable, pdb.Spec.MaxUnavailable)
+				case *policyv1beta1.PodDisruptionBudget:
+					assert.True(t, platformInfo.UseV1Beta1PDB())
+					assert.Equal(t, deployment.Spec.Selector, pdb.Spec.Selector)
+					assert.Equal(t, tt.wantMinAvailable, pdb.Spec.MinAvailable)
+					assert.Equal(t, tt.wantMaxUnavailable, pdb.Spec.MaxUnavailable)
+				default:
+					t.Fatalf("unexpected PodDisruptionBudget type %T", obj)
+				}
+			})
+		}
+	}
+}
@@ -113,6 +113,7 @@ type DatadogAgentReconciler struct {
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=autoscaling,resources=horizontalpodautoscalers,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch;delete
 
@@ -188,6 +189,8 @@ func (r *DatadogAgentReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.ServiceAccount{}).
 		// We let PlatformInfo supply PDB object based on the current API version
 		Owns(r.PlatformInfo.CreatePDBObject()).
+		// We let PlatformInfo supply HPA object based on the current API version
+		Owns(r.PlatformInfo.CreateHPAObject()).
 		Owns(&networkingv1.NetworkPolicy{})
 
 	// DatadogAgent is namespaced whereas ClusterRole and ClusterRoleBinding are
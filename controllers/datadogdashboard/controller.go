@@ -0,0 +1,317 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/controllers/finalizer"
+	"github.com/DataDog/datadog-operator/controllers/utils"
+	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	defaultRequeuePeriod      = 60 * time.Second
+	defaultErrRequeuePeriod   = 5 * time.Second
+	defaultForceSyncPeriod    = 60 * time.Minute
+	datadogDashboardKind      = "DatadogDashboard"
+	datadogDashboardFinalizer = "finalizer.dashboard.datadoghq.com"
+	// defaultConfigMapKey is the key of the JSON definition in the ConfigMap when no item is set
+	defaultConfigMapKey = "dashboard.json"
+)
+
+// Reconciler reconciles a DatadogDashboard object
+type Reconciler struct {
+	client        client.Client
+	datadogClient *datadogV1.DashboardsApi
+	datadogAuth   context.Context
+	versionInfo   *version.Info
+	log           logr.Logger
+	recorder      record.EventRecorder
+}
+
+// NewReconciler returns a new Reconciler object
+func NewReconciler(client client.Client, ddClient datadogclient.DatadogDashboardClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		client:        client,
+		datadogClient: ddClient.Client,
+		datadogAuth:   ddClient.Auth,
+		versionInfo:   versionInfo,
+		log:           log,
+		recorder:      recorder,
+	}
+}
+
+var _ reconcile.Reconciler = (*Reconciler)(nil)
+
+// Reconcile is similar to reconciler.Reconcile interface, but taking a context
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internalReconcile(ctx, req)
+}
+
+func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("datadogdashboard", req.NamespacedName)
+	logger.Info("Reconciling DatadogDashboard")
+	now := metav1.NewTime(time.Now())
+
+	// Get instance
+	instance := &v1alpha1.DatadogDashboard{}
+	var result ctrl.Result
+	var err error
+	if err = r.client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, err
+	}
+
+	final := finalizer.NewFinalizer(
+		logger,
+		r.client,
+		r.deleteResource(logger, instance),
+		defaultRequeuePeriod,
+		defaultErrRequeuePeriod,
+	)
+	if result, err = final.HandleFinalizer(ctx, instance, instance.Status.ID, datadogDashboardFinalizer); ctrutils.ShouldReturn(result, err) {
+		return result, err
+	}
+
+	status := instance.Status.DeepCopy()
+
+	// Validate the DatadogDashboard spec
+	if err = v1alpha1.IsValidDatadogDashboard(&instance.Spec); err != nil {
+		logger.Error(err, "invalid DatadogDashboard")
+		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusValidateError, "ValidatingDashboard", err)
+		return r.updateStatusIfNeeded(logger, instance, status, result)
+	}
+
+	definition, err := r.getDefinition(ctx, instance)
+	if err != nil {
+		logger.Error(err, "error getting the JSON definition of the DatadogDashboard")
+		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusValidateError, "GettingDashboardDefinition", err)
+		return r.updateStatusIfNeeded(logger, instance, status, ctrl.Result{RequeueAfter: defaultErrRequeuePeriod})
+	}
+
+	// The JSON definition is part of the hash, so that the dashboard is updated when the ConfigMap changes
+	instanceHash, err := comparison.GenerateMD5ForSpec(struct {
+		Spec       v1alpha1.DatadogDashboardSpec
+		Definition string
+	}{instance.Spec, definition})
+	if err != nil {
+		logger.Error(err, "error generating hash")
+		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusUpdateError, "GeneratingDashboardHash", err)
+		return r.updateStatusIfNeeded(logger, instance, status, result)
+	}
+
+	shouldCreate := false
+	shouldUpdate := false
+
+	if status.ID == "" {
+		shouldCreate = true
+	} else if instanceHash != status.CurrentHash {
+		logger.V(1).Info("DatadogDashboard manifest or JSON definition has changed")
+		shouldUpdate = true
+	} else if status.LastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(status.LastForceSyncTime.Time)) <= 0 {
+		// Periodically force a sync with the API dashboard to ensure parity
+		// Get the dashboard to make sure it exists before trying any updates. If it doesn't, set shouldCreate
+		if _, err = getDashboard(r.datadogAuth, r.datadogClient, status.ID); err != nil {
+			logger.Error(err, "error getting dashboard", "Dashboard ID", status.ID)
+			if strings.Contains(err.Error(), ctrutils.NotFoundString) {
+				shouldCreate = true
+			} else {
+				updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusGetError, "GettingDashboard", err)
+				result.RequeueAfter = defaultErrRequeuePeriod
+			}
+		} else {
+			shouldUpdate = true
+		}
+		status.LastForceSyncTime = &now
+	}
+
+	if shouldCreate {
+		err = r.create(logger, instance, definition, status, now, instanceHash)
+	} else if shouldUpdate {
+		err = r.update(logger, instance, definition, status, now, instanceHash)
+	}
+	if err != nil {
+		result.RequeueAfter = defaultErrRequeuePeriod
+	}
+
+	// If reconcile was successful, requeue with period defaultRequeuePeriod
+	if !result.Requeue && result.RequeueAfter == 0 {
+		result.RequeueAfter = defaultRequeuePeriod
+	}
+
+	return r.updateStatusIfNeeded(logger, instance, status, result)
+}
+
+// getDefinition returns the JSON definition of the dashboard, read from the ConfigMap when the spec references one.
+// It returns an empty string for dashboards defined with widgets.
+func (r *Reconciler) getDefinition(ctx context.Context, instance *v1alpha1.DatadogDashboard) (string, error) {
+	dashboardJSON := instance.Spec.DashboardJSON
+	if dashboardJSON == nil {
+		return "", nil
+	}
+	if dashboardJSON.ConfigMap == nil {
+		return dashboardJSON.Inline, nil
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := r.client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: dashboardJSON.ConfigMap.Name}, configMap); err != nil {
+		return "", err
+	}
+
+	key := defaultConfigMapKey
+	if len(dashboardJSON.ConfigMap.Items) > 0 {
+		key = dashboardJSON.ConfigMap.Items[0].Key
+	}
+	definition, found := configMap.Data[key]
+	if !found {
+		return "", fmt.Errorf("key %s not found in ConfigMap %s", key, dashboardJSON.ConfigMap.Name)
+	}
+
+	return definition, nil
+}
+
+// ConfigMapDashboardRequests returns reconcile requests for the DatadogDashboards reading their JSON definition
+// from the given ConfigMap, so that the dashboards are updated when the ConfigMap changes.
+func (r *Reconciler) ConfigMapDashboardRequests(obj client.Object) []reconcile.Request {
+	dashboardList := &v1alpha1.DatadogDashboardList{}
+	if err := r.client.List(context.TODO(), dashboardList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.log.Error(err, "unable to list DatadogDashboards")
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, dashboard := range dashboardList.Items {
+		dashboardJSON := dashboard.Spec.DashboardJSON
+		if dashboardJSON == nil || dashboardJSON.ConfigMap == nil || dashboardJSON.ConfigMap.Name != obj.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: dashboard.Namespace, Name: dashboard.Name},
+		})
+	}
+
+	return requests
+}
+
+func (r *Reconciler) create(logger logr.Logger, instance *v1alpha1.DatadogDashboard, definition string, status *v1alpha1.DatadogDashboardStatus, now metav1.Time, hash string) error {
+	logger.V(1).Info("Dashboard ID is not set; creating dashboard in Datadog")
+
+	// Create the dashboard in Datadog
+	dashboard, err := createDashboard(r.datadogAuth, r.datadogClient, instance, definition)
+	if err != nil {
+		logger.Error(err, "error creating dashboard")
+		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusCreateError, "CreatingDashboard", err)
+		return err
+	}
+
+	// Set condition and status
+	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeCreated, metav1.ConditionTrue, "CreatingDashboard", "DatadogDashboard Created")
+	createdTime := metav1.NewTime(dashboard.GetCreatedAt())
+	status.SyncStatus = v1alpha1.DatadogDashboardSyncStatusOK
+	status.ID = dashboard.GetId()
+	status.URL = dashboard.GetUrl()
+	status.Creator = dashboard.GetAuthorHandle()
+	status.Created = &createdTime
+	status.LastForceSyncTime = &now
+	status.CurrentHash = hash
+
+	logger.Info("Created a new dashboard", "Dashboard ID", status.ID)
+	r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.CreationEvent))
+
+	return nil
+}
+
+func (r *Reconciler) update(logger logr.Logger, instance *v1alpha1.DatadogDashboard, definition string, status *v1alpha1.DatadogDashboardStatus, now metav1.Time, hash string) error {
+	dashboard, err := updateDashboard(r.datadogAuth, r.datadogClient, instance, definition)
+	if err != nil {
+		logger.Error(err, "error updating dashboard", "Dashboard ID", status.ID)
+		updateErrStatus(status, now, v1alpha1.DatadogDashboardSyncStatusUpdateError, "UpdatingDashboard", err)
+		return err
+	}
+	r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.UpdateEvent))
+
+	// Set condition and status
+	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeUpdated, metav1.ConditionTrue, "UpdatingDashboard", "DatadogDashboard Updated")
+	// The URL contains the title of the dashboard, and changes with it
+	if url := dashboard.GetUrl(); url != "" {
+		status.URL = url
+	}
+	status.SyncStatus = v1alpha1.DatadogDashboardSyncStatusOK
+	status.CurrentHash = hash
+
+	logger.Info("Updated dashboard", "Dashboard ID", status.ID)
+	return nil
+}
+
+func updateErrStatus(status *v1alpha1.DatadogDashboardStatus, now metav1.Time, syncStatus v1alpha1.DatadogDashboardSyncStatus, reason string, err error) {
+	condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, reason, err)
+	status.SyncStatus = syncStatus
+}
+
+func (r *Reconciler) updateStatusIfNeeded(logger logr.Logger, instance *v1alpha1.DatadogDashboard, status *v1alpha1.DatadogDashboardStatus, result ctrl.Result) (ctrl.Result, error) {
+	if !apiequality.Semantic.DeepEqual(&instance.Status, status) {
+		instance.Status = *status
+		if err := r.client.Status().Update(context.TODO(), instance); err != nil {
+			if apierrors.IsConflict(err) {
+				logger.Error(err, "unable to update DatadogDashboard status due to update conflict")
+				return ctrl.Result{Requeue: true, RequeueAfter: defaultErrRequeuePeriod}, nil
+			}
+			logger.Error(err, "unable to update DatadogDashboard status")
+			return ctrl.Result{Requeue: true, RequeueAfter: defaultRequeuePeriod}, err
+		}
+	}
+	return result, nil
+}
+
+func (r *Reconciler) deleteResource(logger logr.Logger, instance *v1alpha1.DatadogDashboard) finalizer.ResourceDeleteFunc {
+	return func(ctx context.Context, k8sObj client.Object, datadogID string) error {
+		if datadogID != "" {
+			if err := deleteDashboard(r.datadogAuth, r.datadogClient, datadogID); err != nil && !strings.Contains(err.Error(), ctrutils.NotFoundString) {
+				logger.Error(err, "error deleting dashboard", "Dashboard ID", datadogID)
+				return err
+			}
+			logger.Info("Successfully deleted dashboard", "Dashboard ID", datadogID)
+		}
+		r.recordEvent(instance, buildEventInfo(k8sObj.GetName(), k8sObj.GetNamespace(), datadog.DeletionEvent))
+		return nil
+	}
+}
+
+// buildEventInfo creates a new EventInfo instance.
+func buildEventInfo(name, ns string, eventType datadog.EventType) utils.EventInfo {
+	return utils.BuildEventInfo(name, ns, datadogDashboardKind, eventType)
+}
+
+// recordEvent wraps the manager event recorder.
+func (r *Reconciler) recordEvent(dashboard runtime.Object, info utils.EventInfo) {
+	r.recorder.Event(dashboard, corev1.EventTypeNormal, info.GetReason(), info.GetMessage())
+}
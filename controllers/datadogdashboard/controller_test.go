@@ -0,0 +1,307 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	commonv1 "github.com/DataDog/datadog-operator/apis/datadoghq/common/v1"
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+const (
+	resourceNamespace = "default"
+	resourceName      = "dashboard"
+)
+
+// TestReconciler_Reconcile tests the Reconcile method of the Reconciler
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	testLogger := zap.New(zap.UseDevMode(true))
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogDashboard{}, &v1alpha1.DatadogDashboardList{})
+
+	type mockedFields struct {
+		k8sClient client.Client
+	}
+	tests := []struct {
+		name                 string
+		request              ctrl.Request
+		expectedResult       ctrl.Result
+		mockOn               func(t *testing.T, m *mockedFields)
+		datadogClientHandler http.HandlerFunc
+		wantStatus           func(t *testing.T, status v1alpha1.DatadogDashboardStatus)
+	}{
+		{
+			name:    "Create dashboard when not exists",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultDashboard())
+			},
+			datadogClientHandler: dashboardHandler("abc-def-ghi"),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDashboardStatus) {
+				assert.Equal(t, "abc-def-ghi", status.ID)
+				assert.Equal(t, "/dashboard/abc-def-ghi/service-overview", status.URL)
+				assert.Equal(t, "test@example.com", status.Creator)
+				assert.Equal(t, v1alpha1.DatadogDashboardSyncStatusOK, status.SyncStatus)
+				assert.NotEmpty(t, status.CurrentHash)
+			},
+		},
+		{
+			name:    "Create dashboard from a ConfigMap",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), configMapDashboard())
+				_ = m.k8sClient.Create(context.TODO(), testConfigMap(`{"title": "Service overview", "layout_type": "ordered", "widgets": []}`))
+			},
+			datadogClientHandler: dashboardHandler("abc-def-ghi"),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDashboardStatus) {
+				assert.Equal(t, "abc-def-ghi", status.ID)
+				assert.Equal(t, v1alpha1.DatadogDashboardSyncStatusOK, status.SyncStatus)
+			},
+		},
+		{
+			name:    "Return Error and Requeue result when the ConfigMap is not found",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), configMapDashboard())
+			},
+			datadogClientHandler: dashboardHandler("abc-def-ghi"),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDashboardStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogDashboardSyncStatusValidateError, status.SyncStatus)
+			},
+		},
+		{
+			name:    "Return empty result when dashboard is not found",
+			request: newRequest(),
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			}),
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:    "Return Error and Requeue result when creating dashboard is failed",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultDashboard())
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "invalid data", http.StatusBadRequest)
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDashboardStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogDashboardSyncStatusCreateError, status.SyncStatus)
+			},
+		},
+		{
+			name:    "Update dashboard when the spec has changed",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				db := defaultDashboard()
+				db.Status.ID = "abc-def-ghi"
+				db.Status.CurrentHash = "outdated"
+				_ = m.k8sClient.Create(context.TODO(), db)
+			},
+			datadogClientHandler: dashboardHandler("abc-def-ghi"),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDashboardStatus) {
+				assert.Equal(t, "abc-def-ghi", status.ID)
+				assert.Equal(t, "/dashboard/abc-def-ghi/service-overview", status.URL)
+				assert.NotEqual(t, "outdated", status.CurrentHash)
+			},
+		},
+		{
+			name:    "Invalid dashboard",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				db := defaultDashboard()
+				db.Spec.Widgets = nil
+				_ = m.k8sClient.Create(context.TODO(), db)
+			},
+			datadogClientHandler: dashboardHandler("abc-def-ghi"),
+			expectedResult:       ctrl.Result{},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDashboardStatus) {
+				assert.Empty(t, status.ID)
+				assert.Equal(t, v1alpha1.DatadogDashboardSyncStatusValidateError, status.SyncStatus)
+			},
+		},
+	}
+
+	// Iterate through test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(tt.datadogClientHandler)
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV1.NewDashboardsApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			m := mockedFields{
+				k8sClient: fake.NewClientBuilder().WithScheme(s).Build(),
+			}
+			if tt.mockOn != nil {
+				tt.mockOn(t, &m)
+			}
+			recorder := record.NewFakeRecorder(5)
+			r := &Reconciler{
+				client:        m.k8sClient,
+				datadogClient: client,
+				datadogAuth:   testAuth,
+				recorder:      recorder,
+				log:           testLogger,
+				versionInfo:   &version.Info{},
+			}
+
+			res, _ := r.Reconcile(ctx, tt.request)
+			assert.Equal(t, tt.expectedResult, res)
+
+			if tt.wantStatus != nil {
+				db := &v1alpha1.DatadogDashboard{}
+				assert.NoError(t, m.k8sClient.Get(ctx, tt.request.NamespacedName, db))
+				tt.wantStatus(t, db.Status)
+			}
+		})
+	}
+}
+
+func Test_ConfigMapDashboardRequests(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogDashboard{}, &v1alpha1.DatadogDashboardList{})
+
+	r := &Reconciler{
+		client: fake.NewClientBuilder().WithScheme(s).Build(),
+		log:    zap.New(zap.UseDevMode(true)),
+	}
+
+	other := defaultDashboard()
+	other.Name = "other"
+	assert.NoError(t, r.client.Create(context.TODO(), configMapDashboard()))
+	assert.NoError(t, r.client.Create(context.TODO(), other))
+
+	assert.Equal(t, []ctrl.Request{newRequest()}, r.ConfigMapDashboardRequests(testConfigMap("{}")))
+	otherConfigMap := testConfigMap("{}")
+	otherConfigMap.Name = "other"
+	assert.Empty(t, r.ConfigMapDashboardRequests(otherConfigMap))
+}
+
+func newRequest() ctrl.Request {
+	return ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+	}
+}
+
+func defaultDashboard() *v1alpha1.DatadogDashboard {
+	return &v1alpha1.DatadogDashboard{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogDashboard",
+			APIVersion: fmt.Sprintf("%s/%s", v1alpha1.GroupVersion.Group, v1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+		Spec: v1alpha1.DatadogDashboardSpec{
+			Title: "Service overview",
+			Widgets: []v1alpha1.DatadogDashboardWidget{
+				{
+					Type:     "timeseries",
+					Title:    "CPU",
+					Requests: []v1alpha1.DatadogDashboardWidgetRequest{{Query: "avg:system.cpu.user{*}", DisplayType: "line"}},
+				},
+			},
+		},
+	}
+}
+
+func configMapDashboard() *v1alpha1.DatadogDashboard {
+	db := defaultDashboard()
+	db.Spec.Title = ""
+	db.Spec.Widgets = nil
+	db.Spec.DashboardJSON = &v1alpha1.DatadogDashboardJSON{
+		ConfigMap: &commonv1.ConfigMapConfig{Name: "dashboards"},
+	}
+
+	return db
+}
+
+func testConfigMap(definition string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      "dashboards",
+		},
+		Data: map[string]string{
+			defaultConfigMapKey: definition,
+		},
+	}
+}
+
+func dashboardHandler(id string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		dashboard := datadogV1.NewDashboard(datadogV1.DASHBOARDLAYOUTTYPE_ORDERED, "Service overview", []datadogV1.Widget{})
+		dashboard.SetId(id)
+		dashboard.SetUrl(fmt.Sprintf("/dashboard/%s/service-overview", id))
+		dashboard.SetAuthorHandle("test@example.com")
+		dashboard.SetCreatedAt(time.Date(2023, 5, 1, 22, 0, 0, 0, time.UTC))
+		_ = json.NewEncoder(w).Encode(dashboard)
+	}
+}
+
+func setupTestAuth(apiURL string) context.Context {
+	testAuth := context.WithValue(
+		context.Background(),
+		datadogapi.ContextAPIKeys,
+		map[string]datadogapi.APIKey{
+			"apiKeyAuth": {
+				Key: "DUMMY_API_KEY",
+			},
+			"appKeyAuth": {
+				Key: "DUMMY_APP_KEY",
+			},
+		},
+	)
+	parsedAPIURL, _ := url.Parse(apiURL)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerIndex, 1)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerVariables, map[string]string{
+		"name":     parsedAPIURL.Host,
+		"protocol": parsedAPIURL.Scheme,
+	})
+
+	return testAuth
+}
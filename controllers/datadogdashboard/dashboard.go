@@ -0,0 +1,225 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/controllers/utils"
+)
+
+// readOnlyDashboardFields are the fields of an exported dashboard that are set by Datadog, and are removed
+// from JSON definitions before sending them to the API.
+var readOnlyDashboardFields = []string{"id", "url", "author_handle", "author_name", "created_at", "modified_at"}
+
+// buildDashboard builds the dashboard from the spec, or from its JSON definition when the spec references one.
+// The widgets of the API client are unions of several dozens of widget definitions, so the dashboard is built
+// through its JSON representation rather than through the client models.
+func buildDashboard(crdDashboard *v1alpha1.DatadogDashboard, definition string) (*datadogV1.Dashboard, error) {
+	spec := crdDashboard.Spec
+
+	payload := map[string]interface{}{}
+	if spec.DashboardJSON != nil {
+		if err := json.Unmarshal([]byte(definition), &payload); err != nil {
+			return nil, fmt.Errorf("error parsing the dashboard JSON definition: %w", err)
+		}
+		for _, field := range readOnlyDashboardFields {
+			delete(payload, field)
+		}
+	} else {
+		payload["layout_type"] = string(v1alpha1.DatadogDashboardLayoutTypeOrdered)
+		if spec.LayoutType != "" {
+			payload["layout_type"] = string(spec.LayoutType)
+		}
+		payload["widgets"] = buildWidgets(spec.Widgets)
+		if len(spec.TemplateVariables) > 0 {
+			payload["template_variables"] = buildTemplateVariables(spec.TemplateVariables)
+		}
+	}
+
+	if spec.Title != "" {
+		payload["title"] = spec.Title
+	}
+	if title, _ := payload["title"].(string); title == "" {
+		return nil, fmt.Errorf("the dashboard JSON definition must define a title when spec.Title is not set")
+	}
+	if spec.Description != "" {
+		payload["description"] = spec.Description
+	}
+	payload["tags"] = buildTags(payload["tags"], spec.Tags)
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error building dashboard: %w", err)
+	}
+	dashboard := &datadogV1.Dashboard{}
+	if err = json.Unmarshal(raw, dashboard); err != nil {
+		return nil, fmt.Errorf("error building dashboard: %w", err)
+	}
+
+	return dashboard, nil
+}
+
+func buildWidgets(widgets []v1alpha1.DatadogDashboardWidget) []interface{} {
+	built := make([]interface{}, 0, len(widgets))
+	for _, widget := range widgets {
+		definition := map[string]interface{}{
+			"type": widget.Type,
+		}
+		if widget.Title != "" {
+			definition["title"] = widget.Title
+		}
+		if widget.Content != "" {
+			definition["content"] = widget.Content
+		}
+		if len(widget.Requests) > 0 {
+			requests := make([]interface{}, 0, len(widget.Requests))
+			for _, request := range widget.Requests {
+				r := map[string]interface{}{
+					"q": request.Query,
+				}
+				if request.DisplayType != "" {
+					r["display_type"] = request.DisplayType
+				}
+				if request.Aggregator != "" {
+					r["aggregator"] = request.Aggregator
+				}
+				requests = append(requests, r)
+			}
+			definition["requests"] = requests
+		}
+
+		w := map[string]interface{}{
+			"definition": definition,
+		}
+		if widget.Layout != nil {
+			w["layout"] = map[string]interface{}{
+				"x":      widget.Layout.X,
+				"y":      widget.Layout.Y,
+				"width":  widget.Layout.Width,
+				"height": widget.Layout.Height,
+			}
+		}
+		built = append(built, w)
+	}
+
+	return built
+}
+
+func buildTemplateVariables(variables []v1alpha1.DatadogDashboardTemplateVariable) []interface{} {
+	built := make([]interface{}, 0, len(variables))
+	for _, variable := range variables {
+		v := map[string]interface{}{
+			"name": variable.Name,
+		}
+		if variable.Prefix != "" {
+			v["prefix"] = variable.Prefix
+		}
+		if len(variable.Defaults) > 0 {
+			v["defaults"] = variable.Defaults
+		}
+		built = append(built, v)
+	}
+
+	return built
+}
+
+// buildTags merges the tags of the JSON definition, the tags of the spec, and the required tags.
+func buildTags(definitionTags interface{}, specTags []string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+	add := func(tag string) {
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	if list, ok := definitionTags.([]interface{}); ok {
+		for _, tag := range list {
+			if s, ok := tag.(string); ok {
+				add(s)
+			}
+		}
+	}
+	for _, tag := range specTags {
+		add(tag)
+	}
+	for _, tag := range utils.GetTagsToAdd(tags) {
+		add(tag)
+	}
+
+	return tags
+}
+
+func createDashboard(auth context.Context, client *datadogV1.DashboardsApi, crdDashboard *v1alpha1.DatadogDashboard, definition string) (datadogV1.Dashboard, error) {
+	dashboard, err := buildDashboard(crdDashboard, definition)
+	if err != nil {
+		return datadogV1.Dashboard{}, err
+	}
+	created, _, err := client.CreateDashboard(auth, *dashboard)
+	if err != nil {
+		return datadogV1.Dashboard{}, translateClientError(err, "error creating dashboard")
+	}
+
+	return created, nil
+}
+
+func getDashboard(auth context.Context, client *datadogV1.DashboardsApi, dashboardID string) (datadogV1.Dashboard, error) {
+	dashboard, _, err := client.GetDashboard(auth, dashboardID)
+	if err != nil {
+		return datadogV1.Dashboard{}, translateClientError(err, "error getting dashboard")
+	}
+
+	return dashboard, nil
+}
+
+func updateDashboard(auth context.Context, client *datadogV1.DashboardsApi, crdDashboard *v1alpha1.DatadogDashboard, definition string) (datadogV1.Dashboard, error) {
+	dashboard, err := buildDashboard(crdDashboard, definition)
+	if err != nil {
+		return datadogV1.Dashboard{}, err
+	}
+	updated, _, err := client.UpdateDashboard(auth, crdDashboard.Status.ID, *dashboard)
+	if err != nil {
+		return datadogV1.Dashboard{}, translateClientError(err, "error updating dashboard")
+	}
+
+	return updated, nil
+}
+
+func deleteDashboard(auth context.Context, client *datadogV1.DashboardsApi, dashboardID string) error {
+	if _, _, err := client.DeleteDashboard(auth, dashboardID); err != nil {
+		return translateClientError(err, "error deleting dashboard")
+	}
+
+	return nil
+}
+
+func translateClientError(err error, msg string) error {
+	if msg == "" {
+		msg = "an error occurred"
+	}
+
+	var apiErr datadogapi.GenericOpenAPIError
+	var errURL *url.Error
+	if errors.As(err, &apiErr) {
+		return fmt.Errorf(msg+": %w: %s", err, apiErr.Body())
+	}
+
+	if errors.As(err, &errURL) {
+		return fmt.Errorf(msg+" (url.Error): %s", errURL)
+	}
+
+	return fmt.Errorf(msg+": %w", err)
+}
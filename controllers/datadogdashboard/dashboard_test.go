@@ -0,0 +1,98 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdashboard
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+func Test_buildDashboard(t *testing.T) {
+	db := defaultDashboard()
+	db.Spec.Description = "Overview of the service"
+	db.Spec.LayoutType = v1alpha1.DatadogDashboardLayoutTypeFree
+	db.Spec.Tags = []string{"team:example"}
+	db.Spec.Widgets = append(db.Spec.Widgets, v1alpha1.DatadogDashboardWidget{
+		Type:    "note",
+		Content: "Runbook: https://example.com",
+		Layout:  &v1alpha1.DatadogDashboardWidgetLayout{X: 4, Y: 0, Width: 2, Height: 2},
+	})
+	db.Spec.TemplateVariables = []v1alpha1.DatadogDashboardTemplateVariable{
+		{Name: "env", Prefix: "env", Defaults: []string{"staging"}},
+	}
+
+	dashboard, err := buildDashboard(db, "")
+	require.NoError(t, err)
+	payload := toMap(t, dashboard)
+
+	assert.Equal(t, "Service overview", payload["title"])
+	assert.Equal(t, "Overview of the service", payload["description"])
+	assert.Equal(t, "free", payload["layout_type"])
+	assert.Equal(t, []interface{}{"team:example", "generated:kubernetes"}, payload["tags"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"name": "env", "prefix": "env", "defaults": []interface{}{"staging"}},
+	}, payload["template_variables"])
+
+	widgets := payload["widgets"].([]interface{})
+	require.Len(t, widgets, 2)
+	assert.Equal(t, map[string]interface{}{
+		"type":     "timeseries",
+		"title":    "CPU",
+		"requests": []interface{}{map[string]interface{}{"q": "avg:system.cpu.user{*}", "display_type": "line"}},
+	}, widgets[0].(map[string]interface{})["definition"])
+	assert.Equal(t, map[string]interface{}{"type": "note", "content": "Runbook: https://example.com"}, widgets[1].(map[string]interface{})["definition"])
+	assert.Equal(t, map[string]interface{}{"x": float64(4), "y": float64(0), "width": float64(2), "height": float64(2)}, widgets[1].(map[string]interface{})["layout"])
+}
+
+func Test_buildDashboardFromJSON(t *testing.T) {
+	db := configMapDashboard()
+	db.Spec.Tags = []string{"team:example"}
+	definition := `{
+		"id": "old-id",
+		"url": "/dashboard/old-id/exported",
+		"author_handle": "someone@example.com",
+		"title": "Exported dashboard",
+		"layout_type": "ordered",
+		"tags": ["team:example", "team:other"],
+		"widgets": [{"definition": {"type": "note", "content": "Hello"}}]
+	}`
+
+	dashboard, err := buildDashboard(db, definition)
+	require.NoError(t, err)
+	payload := toMap(t, dashboard)
+
+	assert.Equal(t, "Exported dashboard", payload["title"])
+	assert.Equal(t, []interface{}{"team:example", "team:other", "generated:kubernetes"}, payload["tags"])
+	assert.NotContains(t, payload, "id")
+	assert.NotContains(t, payload, "url")
+	assert.NotContains(t, payload, "author_handle")
+	assert.Len(t, payload["widgets"], 1)
+
+	// The title of the spec overrides the title of the JSON definition
+	db.Spec.Title = "Service overview"
+	dashboard, err = buildDashboard(db, definition)
+	require.NoError(t, err)
+	assert.Equal(t, "Service overview", dashboard.GetTitle())
+
+	// A title is required
+	db.Spec.Title = ""
+	_, err = buildDashboard(db, `{"layout_type": "ordered", "widgets": []}`)
+	assert.EqualError(t, err, "the dashboard JSON definition must define a title when spec.Title is not set")
+}
+
+func toMap(t *testing.T, v interface{}) map[string]interface{} {
+	raw, err := json.Marshal(v)
+	require.NoError(t, err)
+	out := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(raw, &out))
+
+	return out
+}
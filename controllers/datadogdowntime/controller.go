@@ -0,0 +1,405 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilserrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/controllers/finalizer"
+	"github.com/DataDog/datadog-operator/controllers/utils"
+	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	defaultRequeuePeriod     = 60 * time.Second
+	defaultErrRequeuePeriod  = 5 * time.Second
+	defaultForceSyncPeriod   = 60 * time.Minute
+	datadogDowntimeKind      = "DatadogDowntime"
+	datadogDowntimeFinalizer = "finalizer.downtime.datadoghq.com"
+)
+
+// Reconciler reconciles a DatadogDowntime object
+type Reconciler struct {
+	client        client.Client
+	datadogClient *datadogV1.DowntimesApi
+	datadogAuth   context.Context
+	versionInfo   *version.Info
+	log           logr.Logger
+	recorder      record.EventRecorder
+}
+
+// NewReconciler returns a new Reconciler object
+func NewReconciler(client client.Client, ddClient datadogclient.DatadogDowntimeClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		client:        client,
+		datadogClient: ddClient.Client,
+		datadogAuth:   ddClient.Auth,
+		versionInfo:   versionInfo,
+		log:           log,
+		recorder:      recorder,
+	}
+}
+
+var _ reconcile.Reconciler = (*Reconciler)(nil)
+
+// downtimeTarget is a monitor to which a downtime applies. A monitorID of 0 means the downtime
+// is not specific to a monitor.
+type downtimeTarget struct {
+	MonitorID int64  `json:"monitorID,omitempty"`
+	Monitor   string `json:"monitor,omitempty"`
+}
+
+// Reconcile is similar to reconciler.Reconcile interface, but taking a context
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internalReconcile(ctx, req)
+}
+
+func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("datadogdowntime", req.NamespacedName)
+	logger.Info("Reconciling DatadogDowntime")
+	now := metav1.NewTime(time.Now())
+
+	// Get instance
+	instance := &v1alpha1.DatadogDowntime{}
+	var result ctrl.Result
+	var err error
+	if err = r.client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, err
+	}
+
+	final := finalizer.NewFinalizer(
+		logger,
+		r.client,
+		r.deleteResource(logger, instance),
+		defaultRequeuePeriod,
+		defaultErrRequeuePeriod,
+	)
+	if result, err = final.HandleFinalizer(ctx, instance, getDowntimeIDs(instance.Status.Downtimes), datadogDowntimeFinalizer); ctrutils.ShouldReturn(result, err) {
+		return result, err
+	}
+
+	status := instance.Status.DeepCopy()
+
+	// Validate the DatadogDowntime spec
+	if err = v1alpha1.IsValidDatadogDowntime(&instance.Spec); err != nil {
+		logger.Error(err, "invalid DatadogDowntime")
+		updateErrStatus(status, now, v1alpha1.DatadogDowntimeSyncStatusValidateError, "ValidatingDowntime", err)
+		return r.updateStatusIfNeeded(logger, instance, status, result)
+	}
+
+	targets, err := r.getTargets(ctx, instance)
+	if err != nil {
+		logger.Error(err, "error getting the monitors selected by the DatadogDowntime")
+		updateErrStatus(status, now, v1alpha1.DatadogDowntimeSyncStatusValidateError, "SelectingMonitors", err)
+		return r.updateStatusIfNeeded(logger, instance, status, ctrl.Result{RequeueAfter: defaultErrRequeuePeriod})
+	}
+
+	// The selected monitors are part of the hash, so that the downtimes are synced when the selection changes
+	instanceHash, err := comparison.GenerateMD5ForSpec(struct {
+		Spec    v1alpha1.DatadogDowntimeSpec
+		Targets []downtimeTarget
+	}{instance.Spec, targets})
+	if err != nil {
+		logger.Error(err, "error generating hash")
+		updateErrStatus(status, now, v1alpha1.DatadogDowntimeSyncStatusUpdateError, "GeneratingDowntimeHash", err)
+		return r.updateStatusIfNeeded(logger, instance, status, result)
+	}
+
+	shouldSync := false
+	if instanceHash != status.CurrentHash {
+		logger.V(1).Info("DatadogDowntime manifest or selected monitors have changed")
+		shouldSync = true
+	} else if status.LastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(status.LastForceSyncTime.Time)) <= 0 {
+		// Periodically force a sync with the API downtimes to ensure parity
+		shouldSync = true
+	} else if status.StateLastUpdateTime == nil || (defaultRequeuePeriod-now.Sub(status.StateLastUpdateTime.Time)) <= 0 {
+		// Otherwise refresh the state of the downtimes, and recreate the ones that have been removed from Datadog
+		if err = r.updateState(logger, status, now); err != nil && strings.Contains(err.Error(), ctrutils.NotFoundString) {
+			shouldSync = true
+		}
+	}
+
+	if shouldSync {
+		if err = r.sync(logger, instance, targets, status, now, instanceHash); err != nil {
+			result.RequeueAfter = defaultErrRequeuePeriod
+		}
+	}
+
+	updateActiveStatus(status, now)
+
+	// If reconcile was successful, requeue with period defaultRequeuePeriod
+	if !result.Requeue && result.RequeueAfter == 0 {
+		result.RequeueAfter = defaultRequeuePeriod
+	}
+
+	return r.updateStatusIfNeeded(logger, instance, status, result)
+}
+
+// getTargets returns the monitors selected by the DatadogDowntime, sorted by name.
+func (r *Reconciler) getTargets(ctx context.Context, instance *v1alpha1.DatadogDowntime) ([]downtimeTarget, error) {
+	if instance.Spec.MonitorSelector == nil || instance.Spec.MonitorSelector.LabelSelector == nil {
+		return []downtimeTarget{{}}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(instance.Spec.MonitorSelector.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	monitorList := &v1alpha1.DatadogMonitorList{}
+	if err = r.client.List(ctx, monitorList, client.InNamespace(instance.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	targets := []downtimeTarget{}
+	for _, monitor := range monitorList.Items {
+		// Monitors not created yet in Datadog are picked up by a later reconcile
+		if monitor.Status.ID == 0 {
+			continue
+		}
+		targets = append(targets, downtimeTarget{
+			MonitorID: int64(monitor.Status.ID),
+			Monitor:   types.NamespacedName{Namespace: monitor.Namespace, Name: monitor.Name}.String(),
+		})
+	}
+	sort.SliceStable(targets, func(i, j int) bool { return targets[i].Monitor < targets[j].Monitor })
+
+	return targets, nil
+}
+
+// MonitorDowntimeRequests returns reconcile requests for the DatadogDowntimes selecting the given DatadogMonitor,
+// so that a downtime is created when a selected monitor is created in Datadog.
+func (r *Reconciler) MonitorDowntimeRequests(obj client.Object) []reconcile.Request {
+	downtimeList := &v1alpha1.DatadogDowntimeList{}
+	if err := r.client.List(context.TODO(), downtimeList, client.InNamespace(obj.GetNamespace())); err != nil {
+		r.log.Error(err, "unable to list DatadogDowntimes")
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, downtime := range downtimeList.Items {
+		if downtime.Spec.MonitorSelector == nil || downtime.Spec.MonitorSelector.LabelSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(downtime.Spec.MonitorSelector.LabelSelector)
+		if err != nil || !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: downtime.Namespace, Name: downtime.Name},
+		})
+	}
+
+	return requests
+}
+
+// sync creates or updates a downtime in Datadog for every target, and cancels the downtimes of the monitors
+// that are not selected anymore.
+func (r *Reconciler) sync(logger logr.Logger, instance *v1alpha1.DatadogDowntime, targets []downtimeTarget, status *v1alpha1.DatadogDowntimeStatus, now metav1.Time, hash string) error {
+	existing := map[int64]v1alpha1.DatadogDowntimeInstance{}
+	for _, downtime := range status.Downtimes {
+		existing[downtime.MonitorID] = downtime
+	}
+
+	var errs []error
+	created, updated := false, false
+	downtimes := []v1alpha1.DatadogDowntimeInstance{}
+	for _, target := range targets {
+		if current, found := existing[target.MonitorID]; found {
+			delete(existing, target.MonitorID)
+			downtime, err := updateDowntime(r.datadogAuth, r.datadogClient, instance, current.ID, target.MonitorID)
+			if err == nil {
+				updated = true
+				downtimes = append(downtimes, newDowntimeInstance(downtime, target))
+				continue
+			}
+			if !strings.Contains(err.Error(), ctrutils.NotFoundString) {
+				logger.Error(err, "error updating downtime", "Downtime ID", current.ID)
+				errs = append(errs, err)
+				downtimes = append(downtimes, current)
+				continue
+			}
+			// The downtime has been removed from Datadog, create it again
+		}
+
+		downtime, err := createDowntime(r.datadogAuth, r.datadogClient, instance, target.MonitorID)
+		if err != nil {
+			logger.Error(err, "error creating downtime", "Monitor", target.Monitor)
+			errs = append(errs, err)
+			continue
+		}
+		created = true
+		downtimes = append(downtimes, newDowntimeInstance(downtime, target))
+		logger.Info("Created a new downtime", "Downtime ID", downtime.GetId(), "Monitor", target.Monitor)
+	}
+
+	// Cancel the downtimes of the monitors that are not selected anymore
+	for _, downtime := range status.Downtimes {
+		if _, found := existing[downtime.MonitorID]; !found {
+			continue
+		}
+		if err := cancelDowntime(r.datadogAuth, r.datadogClient, downtime.ID); err != nil && !strings.Contains(err.Error(), ctrutils.NotFoundString) {
+			logger.Error(err, "error cancelling downtime", "Downtime ID", downtime.ID)
+			errs = append(errs, err)
+			downtimes = append(downtimes, downtime)
+			continue
+		}
+		logger.Info("Cancelled downtime of monitor not selected anymore", "Downtime ID", downtime.ID, "Monitor", downtime.Monitor)
+	}
+	status.Downtimes = downtimes
+
+	if err := utilserrors.NewAggregate(errs); err != nil {
+		if len(status.Downtimes) == 0 {
+			updateErrStatus(status, now, v1alpha1.DatadogDowntimeSyncStatusCreateError, "CreatingDowntime", err)
+		} else {
+			updateErrStatus(status, now, v1alpha1.DatadogDowntimeSyncStatusUpdateError, "UpdatingDowntime", err)
+		}
+		return err
+	}
+
+	if created {
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeCreated, metav1.ConditionTrue, "CreatingDowntime", "DatadogDowntime Created")
+		r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.CreationEvent))
+	}
+	if updated {
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeUpdated, metav1.ConditionTrue, "UpdatingDowntime", "DatadogDowntime Updated")
+		r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.UpdateEvent))
+	}
+	status.SyncStatus = v1alpha1.DatadogDowntimeSyncStatusOK
+	status.CurrentHash = hash
+	status.LastForceSyncTime = &now
+	status.StateLastUpdateTime = &now
+
+	return nil
+}
+
+// updateState refreshes whether the downtimes are active.
+func (r *Reconciler) updateState(logger logr.Logger, status *v1alpha1.DatadogDowntimeStatus, now metav1.Time) error {
+	for i := range status.Downtimes {
+		downtime, err := getDowntime(r.datadogAuth, r.datadogClient, status.Downtimes[i].ID)
+		if err != nil {
+			logger.Error(err, "error getting downtime", "Downtime ID", status.Downtimes[i].ID)
+			status.SyncStatus = v1alpha1.DatadogDowntimeSyncStatusGetError
+			return err
+		}
+		if downtime.GetCanceled() != 0 {
+			return fmt.Errorf("downtime %d has been cancelled: %s", status.Downtimes[i].ID, ctrutils.NotFoundString)
+		}
+		status.Downtimes[i].Active = downtime.GetActive()
+	}
+	status.SyncStatus = v1alpha1.DatadogDowntimeSyncStatusOK
+	status.StateLastUpdateTime = &now
+
+	return nil
+}
+
+// updateActiveStatus sets status.Active and the Active condition according to the state of the downtimes.
+func updateActiveStatus(status *v1alpha1.DatadogDowntimeStatus, now metav1.Time) {
+	status.Active = false
+	for _, downtime := range status.Downtimes {
+		if downtime.Active {
+			status.Active = true
+			break
+		}
+	}
+
+	if status.Active {
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeActive, metav1.ConditionTrue, "DowntimeActive", "DatadogDowntime is active")
+	} else {
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeActive, metav1.ConditionFalse, "DowntimeInactive", "DatadogDowntime is not active")
+	}
+}
+
+func newDowntimeInstance(downtime datadogV1.Downtime, target downtimeTarget) v1alpha1.DatadogDowntimeInstance {
+	return v1alpha1.DatadogDowntimeInstance{
+		ID:        downtime.GetId(),
+		MonitorID: target.MonitorID,
+		Monitor:   target.Monitor,
+		Active:    downtime.GetActive(),
+	}
+}
+
+func getDowntimeIDs(downtimes []v1alpha1.DatadogDowntimeInstance) string {
+	ids := make([]string, 0, len(downtimes))
+	for _, downtime := range downtimes {
+		ids = append(ids, fmt.Sprint(downtime.ID))
+	}
+
+	return strings.Join(ids, ",")
+}
+
+func updateErrStatus(status *v1alpha1.DatadogDowntimeStatus, now metav1.Time, syncStatus v1alpha1.DatadogDowntimeSyncStatus, reason string, err error) {
+	condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, reason, err)
+	status.SyncStatus = syncStatus
+}
+
+func (r *Reconciler) updateStatusIfNeeded(logger logr.Logger, instance *v1alpha1.DatadogDowntime, status *v1alpha1.DatadogDowntimeStatus, result ctrl.Result) (ctrl.Result, error) {
+	if !apiequality.Semantic.DeepEqual(&instance.Status, status) {
+		instance.Status = *status
+		if err := r.client.Status().Update(context.TODO(), instance); err != nil {
+			if apierrors.IsConflict(err) {
+				logger.Error(err, "unable to update DatadogDowntime status due to update conflict")
+				return ctrl.Result{Requeue: true, RequeueAfter: defaultErrRequeuePeriod}, nil
+			}
+			logger.Error(err, "unable to update DatadogDowntime status")
+			return ctrl.Result{Requeue: true, RequeueAfter: defaultRequeuePeriod}, err
+		}
+	}
+	return result, nil
+}
+
+func (r *Reconciler) deleteResource(logger logr.Logger, instance *v1alpha1.DatadogDowntime) finalizer.ResourceDeleteFunc {
+	return func(ctx context.Context, k8sObj client.Object, datadogID string) error {
+		for _, downtime := range instance.Status.Downtimes {
+			if err := cancelDowntime(r.datadogAuth, r.datadogClient, downtime.ID); err != nil && !strings.Contains(err.Error(), ctrutils.NotFoundString) {
+				logger.Error(err, "error cancelling downtime", "Downtime ID", downtime.ID)
+				return err
+			}
+			logger.Info("Successfully cancelled downtime", "Downtime ID", downtime.ID)
+		}
+		r.recordEvent(instance, buildEventInfo(k8sObj.GetName(), k8sObj.GetNamespace(), datadog.DeletionEvent))
+		return nil
+	}
+}
+
+// buildEventInfo creates a new EventInfo instance.
+func buildEventInfo(name, ns string, eventType datadog.EventType) utils.EventInfo {
+	return utils.BuildEventInfo(name, ns, datadogDowntimeKind, eventType)
+}
+
+// recordEvent wraps the manager event recorder.
+func (r *Reconciler) recordEvent(downtime runtime.Object, info utils.EventInfo) {
+	r.recorder.Event(downtime, corev1.EventTypeNormal, info.GetReason(), info.GetMessage())
+}
@@ -0,0 +1,278 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+const (
+	resourceNamespace = "default"
+	resourceName      = "downtime"
+)
+
+// TestReconciler_Reconcile tests the Reconcile method of the Reconciler
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	testLogger := zap.New(zap.UseDevMode(true))
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogDowntime{}, &v1alpha1.DatadogDowntimeList{}, &v1alpha1.DatadogMonitor{}, &v1alpha1.DatadogMonitorList{})
+
+	type mockedFields struct {
+		k8sClient client.Client
+	}
+	tests := []struct {
+		name                 string
+		request              ctrl.Request
+		expectedResult       ctrl.Result
+		mockOn               func(t *testing.T, m *mockedFields)
+		datadogClientHandler http.HandlerFunc
+		wantStatus           func(t *testing.T, status v1alpha1.DatadogDowntimeStatus)
+	}{
+		{
+			name:    "Create downtime when not exists",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultDowntime())
+			},
+			datadogClientHandler: downtimeHandler(123, true),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, []v1alpha1.DatadogDowntimeInstance{{ID: 123, Active: true}}, status.Downtimes)
+				assert.True(t, status.Active)
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusOK, status.SyncStatus)
+				assert.NotEmpty(t, status.CurrentHash)
+			},
+		},
+		{
+			name:    "Return empty result when downtime is not found",
+			request: newRequest(),
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			}),
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:    "Return Error and Requeue result when creating downtime is failed",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultDowntime())
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "invalid data", http.StatusBadRequest)
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Empty(t, status.Downtimes)
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusCreateError, status.SyncStatus)
+			},
+		},
+		{
+			name:    "Update downtime when exists",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				dt := defaultDowntime()
+				dt.Status.Downtimes = []v1alpha1.DatadogDowntimeInstance{{ID: 123}}
+				_ = m.k8sClient.Create(context.TODO(), dt)
+			},
+			datadogClientHandler: downtimeHandler(123, false),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, []v1alpha1.DatadogDowntimeInstance{{ID: 123}}, status.Downtimes)
+				assert.False(t, status.Active)
+			},
+		},
+		{
+			name:    "Create a downtime per selected monitor",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				dt := defaultDowntime()
+				dt.Spec.MonitorSelector = &v1alpha1.DatadogDowntimeMonitorSelector{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				}
+				_ = m.k8sClient.Create(context.TODO(), dt)
+				_ = m.k8sClient.Create(context.TODO(), testMonitor("monitor-1", map[string]string{"app": "foo"}, 1))
+				_ = m.k8sClient.Create(context.TODO(), testMonitor("monitor-2", map[string]string{"app": "foo"}, 0))
+				_ = m.k8sClient.Create(context.TODO(), testMonitor("monitor-3", map[string]string{"app": "bar"}, 3))
+			},
+			datadogClientHandler: downtimeHandler(123, true),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Equal(t, []v1alpha1.DatadogDowntimeInstance{{ID: 123, MonitorID: 1, Monitor: "default/monitor-1", Active: true}}, status.Downtimes)
+			},
+		},
+		{
+			name:    "Invalid downtime",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				dt := defaultDowntime()
+				dt.Spec.Scope = nil
+				_ = m.k8sClient.Create(context.TODO(), dt)
+			},
+			datadogClientHandler: downtimeHandler(123, true),
+			expectedResult:       ctrl.Result{},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogDowntimeStatus) {
+				assert.Empty(t, status.Downtimes)
+				assert.Equal(t, v1alpha1.DatadogDowntimeSyncStatusValidateError, status.SyncStatus)
+			},
+		},
+	}
+
+	// Iterate through test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(tt.datadogClientHandler)
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV1.NewDowntimesApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			m := mockedFields{
+				k8sClient: fake.NewClientBuilder().WithScheme(s).Build(),
+			}
+			if tt.mockOn != nil {
+				tt.mockOn(t, &m)
+			}
+			recorder := record.NewFakeRecorder(5)
+			r := &Reconciler{
+				client:        m.k8sClient,
+				datadogClient: client,
+				datadogAuth:   testAuth,
+				recorder:      recorder,
+				log:           testLogger,
+				versionInfo:   &version.Info{},
+			}
+
+			res, _ := r.Reconcile(ctx, tt.request)
+			assert.Equal(t, tt.expectedResult, res)
+
+			if tt.wantStatus != nil {
+				dt := &v1alpha1.DatadogDowntime{}
+				assert.NoError(t, m.k8sClient.Get(ctx, tt.request.NamespacedName, dt))
+				tt.wantStatus(t, dt.Status)
+			}
+		})
+	}
+}
+
+func Test_MonitorDowntimeRequests(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogDowntime{}, &v1alpha1.DatadogDowntimeList{})
+
+	r := &Reconciler{
+		client: fake.NewClientBuilder().WithScheme(s).Build(),
+		log:    zap.New(zap.UseDevMode(true)),
+	}
+
+	selecting := defaultDowntime()
+	selecting.Spec.MonitorSelector = &v1alpha1.DatadogDowntimeMonitorSelector{
+		LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+	}
+	other := defaultDowntime()
+	other.Name = "other"
+	assert.NoError(t, r.client.Create(context.TODO(), selecting))
+	assert.NoError(t, r.client.Create(context.TODO(), other))
+
+	assert.Equal(t, []ctrl.Request{newRequest()}, r.MonitorDowntimeRequests(testMonitor("monitor-1", map[string]string{"app": "foo"}, 1)))
+	assert.Empty(t, r.MonitorDowntimeRequests(testMonitor("monitor-2", map[string]string{"app": "bar"}, 2)))
+}
+
+func newRequest() ctrl.Request {
+	return ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+	}
+}
+
+func defaultDowntime() *v1alpha1.DatadogDowntime {
+	return &v1alpha1.DatadogDowntime{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogDowntime",
+			APIVersion: fmt.Sprintf("%s/%s", v1alpha1.GroupVersion.Group, v1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+		Spec: v1alpha1.DatadogDowntimeSpec{
+			Scope:   []string{"env:staging"},
+			Message: "Test downtime",
+		},
+	}
+}
+
+func testMonitor(name string, labels map[string]string, id int) *v1alpha1.DatadogMonitor {
+	return &v1alpha1.DatadogMonitor{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      name,
+			Labels:    labels,
+		},
+		Status: v1alpha1.DatadogMonitorStatus{
+			ID: id,
+		},
+	}
+}
+
+func downtimeHandler(id int64, active bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		downtime := datadogV1.NewDowntime()
+		downtime.SetId(id)
+		downtime.SetActive(active)
+		downtime.SetScope([]string{"env:staging"})
+		_ = json.NewEncoder(w).Encode(downtime)
+	}
+}
+
+func setupTestAuth(apiURL string) context.Context {
+	testAuth := context.WithValue(
+		context.Background(),
+		datadogapi.ContextAPIKeys,
+		map[string]datadogapi.APIKey{
+			"apiKeyAuth": {
+				Key: "DUMMY_API_KEY",
+			},
+			"appKeyAuth": {
+				Key: "DUMMY_APP_KEY",
+			},
+		},
+	)
+	parsedAPIURL, _ := url.Parse(apiURL)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerIndex, 1)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerVariables, map[string]string{
+		"name":     parsedAPIURL.Host,
+		"protocol": parsedAPIURL.Scheme,
+	})
+
+	return testAuth
+}
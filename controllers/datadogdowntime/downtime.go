@@ -0,0 +1,124 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+// buildDowntime converts a DatadogDowntime into a Datadog downtime. If monitorID is not 0, the downtime
+// only applies to this monitor.
+func buildDowntime(crdDowntime *v1alpha1.DatadogDowntime, monitorID int64) *datadogV1.Downtime {
+	spec := crdDowntime.Spec
+
+	downtime := datadogV1.NewDowntime()
+	downtime.SetScope(spec.Scope)
+
+	if monitorID != 0 {
+		downtime.SetMonitorId(monitorID)
+	} else if spec.MonitorSelector != nil && len(spec.MonitorSelector.MonitorTags) > 0 {
+		downtime.SetMonitorTags(spec.MonitorSelector.MonitorTags)
+	}
+
+	if spec.Start != nil {
+		downtime.SetStart(spec.Start.Unix())
+	}
+	if spec.End != nil {
+		downtime.SetEnd(spec.End.Unix())
+	}
+	if spec.Timezone != "" {
+		downtime.SetTimezone(spec.Timezone)
+	}
+	if spec.Message != "" {
+		downtime.SetMessage(spec.Message)
+	}
+	if spec.MuteFirstRecoveryNotification != nil {
+		downtime.SetMuteFirstRecoveryNotification(*spec.MuteFirstRecoveryNotification)
+	}
+
+	if spec.Recurrence != nil {
+		recurrence := datadogV1.NewDowntimeRecurrence()
+		recurrence.SetType(string(spec.Recurrence.Type))
+		if spec.Recurrence.Period > 0 {
+			recurrence.SetPeriod(spec.Recurrence.Period)
+		}
+		if len(spec.Recurrence.WeekDays) > 0 {
+			recurrence.SetWeekDays(spec.Recurrence.WeekDays)
+		}
+		if spec.Recurrence.UntilDate != nil {
+			recurrence.SetUntilDate(spec.Recurrence.UntilDate.Unix())
+		}
+		if spec.Recurrence.UntilOccurrences != nil {
+			recurrence.SetUntilOccurrences(*spec.Recurrence.UntilOccurrences)
+		}
+		downtime.SetRecurrence(*recurrence)
+	}
+
+	return downtime
+}
+
+func createDowntime(auth context.Context, client *datadogV1.DowntimesApi, crdDowntime *v1alpha1.DatadogDowntime, monitorID int64) (datadogV1.Downtime, error) {
+	downtime := buildDowntime(crdDowntime, monitorID)
+	created, _, err := client.CreateDowntime(auth, *downtime)
+	if err != nil {
+		return datadogV1.Downtime{}, translateClientError(err, "error creating downtime")
+	}
+
+	return created, nil
+}
+
+func getDowntime(auth context.Context, client *datadogV1.DowntimesApi, downtimeID int64) (datadogV1.Downtime, error) {
+	downtime, _, err := client.GetDowntime(auth, downtimeID)
+	if err != nil {
+		return datadogV1.Downtime{}, translateClientError(err, "error getting downtime")
+	}
+
+	return downtime, nil
+}
+
+func updateDowntime(auth context.Context, client *datadogV1.DowntimesApi, crdDowntime *v1alpha1.DatadogDowntime, downtimeID, monitorID int64) (datadogV1.Downtime, error) {
+	downtime := buildDowntime(crdDowntime, monitorID)
+	updated, _, err := client.UpdateDowntime(auth, downtimeID, *downtime)
+	if err != nil {
+		return datadogV1.Downtime{}, translateClientError(err, "error updating downtime")
+	}
+
+	return updated, nil
+}
+
+func cancelDowntime(auth context.Context, client *datadogV1.DowntimesApi, downtimeID int64) error {
+	if _, err := client.CancelDowntime(auth, downtimeID); err != nil {
+		return translateClientError(err, "error cancelling downtime")
+	}
+
+	return nil
+}
+
+func translateClientError(err error, msg string) error {
+	if msg == "" {
+		msg = "an error occurred"
+	}
+
+	var apiErr datadogapi.GenericOpenAPIError
+	var errURL *url.Error
+	if errors.As(err, &apiErr) {
+		return fmt.Errorf(msg+": %w: %s", err, apiErr.Body())
+	}
+
+	if errors.As(err, &errURL) {
+		return fmt.Errorf(msg+" (url.Error): %s", errURL)
+	}
+
+	return fmt.Errorf(msg+": %w", err)
+}
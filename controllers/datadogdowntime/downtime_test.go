@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogdowntime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+)
+
+func Test_buildDowntime(t *testing.T) {
+	start := metav1.NewTime(time.Date(2023, 5, 1, 22, 0, 0, 0, time.UTC))
+	end := metav1.NewTime(time.Date(2023, 5, 2, 2, 0, 0, 0, time.UTC))
+
+	dt := defaultDowntime()
+	dt.Spec.Start = &start
+	dt.Spec.End = &end
+	dt.Spec.Timezone = "UTC"
+	dt.Spec.MuteFirstRecoveryNotification = apiutils.NewBoolPointer(true)
+	dt.Spec.MonitorSelector = &v1alpha1.DatadogDowntimeMonitorSelector{
+		MonitorTags: []string{"service:foo"},
+	}
+	dt.Spec.Recurrence = &v1alpha1.DatadogDowntimeRecurrence{
+		Type:             v1alpha1.DatadogDowntimeRecurrenceTypeWeeks,
+		Period:           1,
+		WeekDays:         []string{"Sat", "Sun"},
+		UntilOccurrences: apiutils.NewInt32Pointer(4),
+	}
+
+	downtime := buildDowntime(dt, 0)
+	assert.Equal(t, dt.Spec.Scope, downtime.GetScope())
+	assert.Equal(t, dt.Spec.Message, downtime.GetMessage())
+	assert.Equal(t, start.Unix(), downtime.GetStart())
+	assert.Equal(t, end.Unix(), downtime.GetEnd())
+	assert.Equal(t, "UTC", downtime.GetTimezone())
+	assert.True(t, downtime.GetMuteFirstRecoveryNotification())
+	assert.Equal(t, []string{"service:foo"}, downtime.GetMonitorTags())
+	assert.False(t, downtime.HasMonitorId())
+
+	recurrence := downtime.GetRecurrence()
+	assert.Equal(t, "weeks", recurrence.GetType())
+	assert.Equal(t, int32(1), recurrence.GetPeriod())
+	assert.Equal(t, []string{"Sat", "Sun"}, recurrence.GetWeekDays())
+	assert.Equal(t, int32(4), recurrence.GetUntilOccurrences())
+	assert.False(t, recurrence.HasUntilDate())
+
+	// A downtime specific to a monitor doesn't use the monitor tags
+	downtime = buildDowntime(dt, 12345)
+	assert.Equal(t, int64(12345), downtime.GetMonitorId())
+	assert.Empty(t, downtime.GetMonitorTags())
+}
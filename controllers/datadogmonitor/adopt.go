@@ -0,0 +1,101 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+// findMonitorToAdopt returns the ID of the existing monitor the DatadogMonitor should adopt,
+// or 0 if a new monitor should be created.
+func (r *Reconciler) findMonitorToAdopt(logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor) (int64, error) {
+	options := dm.Spec.ControllerOptions
+	if options.AdoptMonitorID != nil {
+		return *options.AdoptMonitorID, nil
+	}
+	if !apiutils.BoolValue(options.AdoptByNameAndTags) {
+		return 0, nil
+	}
+
+	monitors, err := listMonitorsByName(r.datadogAuth, r.datadogClient, dm.Spec.Name)
+	if err != nil {
+		return 0, err
+	}
+
+	var matches []int64
+	for _, m := range monitors {
+		if m.GetName() == dm.Spec.Name && hasMonitorTags(m.GetTags(), dm.Spec.Tags) {
+			matches = append(matches, m.GetId())
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		logger.Info("No existing monitor matches the DatadogMonitor name and tags, a new monitor will be created")
+		return 0, nil
+	case 1:
+		return matches[0], nil
+	default:
+		return 0, fmt.Errorf("unable to choose the monitor to adopt: %d monitors match the name %q and tags of the DatadogMonitor: %v", len(matches), dm.Spec.Name, matches)
+	}
+}
+
+// hasMonitorTags returns true if the monitor tags include all the DatadogMonitor tags. The tag added by the
+// controller is ignored, as monitors created outside of Kubernetes don't have it.
+func hasMonitorTags(monitorTags, tags []string) bool {
+	found := make(map[string]bool, len(monitorTags))
+	for _, tag := range monitorTags {
+		found[tag] = true
+	}
+	for _, tag := range tags {
+		if tag != requiredTag && !found[tag] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// adopt takes over an existing monitor: the monitor is overwritten with the DatadogMonitor spec, unless the drift
+// policy is observe, and then managed as if it had been created by the controller.
+func (r *Reconciler) adopt(logger logr.Logger, datadogMonitor *datadoghqv1alpha1.DatadogMonitor, status *datadoghqv1alpha1.DatadogMonitorStatus, now metav1.Time, instanceSpecHash string, monitorID int64) error {
+	m, err := getMonitor(r.datadogAuth, r.datadogClient, int(monitorID))
+	if err != nil {
+		return err
+	}
+
+	adopted := datadogMonitor.DeepCopy()
+	adopted.Status.ID = int(monitorID)
+	if driftPolicy(datadogMonitor) == datadoghqv1alpha1.DatadogDriftPolicyObserve {
+		// The monitor is only observed: report its differences with the spec instead of overwriting it
+		if err = r.checkDrift(logger, adopted, m, status, now, instanceSpecHash); err != nil {
+			return err
+		}
+	} else if err = r.update(logger, adopted, status, now, instanceSpecHash); err != nil {
+		return err
+	}
+
+	status.ID = int(monitorID)
+	creator := m.GetCreator()
+	status.Creator = creator.GetEmail()
+	createdTime := metav1.NewTime(m.GetCreated())
+	status.Created = &createdTime
+	status.Primary = true
+
+	// Set Created Condition
+	condition.UpdateDatadogMonitorConditions(status, now, datadoghqv1alpha1.DatadogMonitorConditionTypeCreated, corev1.ConditionTrue, "DatadogMonitor adopted an existing monitor")
+	logger.Info("Adopted an existing monitor", "Monitor Namespace", datadogMonitor.Namespace, "Monitor Name", datadogMonitor.Name, "Monitor ID", monitorID)
+
+	return nil
+}
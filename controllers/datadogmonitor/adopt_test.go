@@ -0,0 +1,136 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+)
+
+func Test_findMonitorToAdopt(t *testing.T) {
+	otherName := genericMonitor(3)
+	otherName.SetName("Test monitor (copy)")
+	otherTags := genericMonitor(4)
+	otherTags.SetTags([]string{"env:prod"})
+
+	tests := []struct {
+		name      string
+		options   datadoghqv1alpha1.DatadogMonitorControllerOptions
+		monitors  []datadogV1.Monitor
+		wantID    int64
+		wantError bool
+	}{
+		{
+			name: "no adoption",
+		},
+		{
+			name:    "adopt by ID",
+			options: datadoghqv1alpha1.DatadogMonitorControllerOptions{AdoptMonitorID: apiutils.NewInt64Pointer(12345)},
+			wantID:  12345,
+		},
+		{
+			name:     "adopt by name and tags",
+			options:  datadoghqv1alpha1.DatadogMonitorControllerOptions{AdoptByNameAndTags: apiutils.NewBoolPointer(true)},
+			monitors: []datadogV1.Monitor{genericMonitor(2), otherName, otherTags},
+			wantID:   2,
+		},
+		{
+			name:     "no monitor matching name and tags",
+			options:  datadoghqv1alpha1.DatadogMonitorControllerOptions{AdoptByNameAndTags: apiutils.NewBoolPointer(true)},
+			monitors: []datadogV1.Monitor{otherName, otherTags},
+		},
+		{
+			name:      "several monitors matching name and tags",
+			options:   datadoghqv1alpha1.DatadogMonitorControllerOptions{AdoptByNameAndTags: apiutils.NewBoolPointer(true)},
+			monitors:  []datadogV1.Monitor{genericMonitor(2), genericMonitor(5)},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(tt.monitors)
+			}))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			r := &Reconciler{
+				datadogClient: datadogV1.NewMonitorsApi(datadogapi.NewAPIClient(testConfig)),
+				datadogAuth:   setupTestAuth(httpServer.URL),
+				log:           testLogger,
+			}
+
+			dm := genericDatadogMonitor()
+			dm.Spec.Name = "Test monitor"
+			dm.Spec.Tags = []string{"env:staging", "generated:kubernetes"}
+			dm.Spec.ControllerOptions = tt.options
+
+			id, err := r.findMonitorToAdopt(testLogger, dm)
+			if tt.wantError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
+
+func Test_adopt(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(genericMonitor(12345))
+	}))
+	defer httpServer.Close()
+
+	testConfig := datadogapi.NewConfiguration()
+	testConfig.HTTPClient = httpServer.Client()
+	r := &Reconciler{
+		datadogClient: datadogV1.NewMonitorsApi(datadogapi.NewAPIClient(testConfig)),
+		datadogAuth:   setupTestAuth(httpServer.URL),
+		log:           testLogger,
+		recorder:      record.NewFakeRecorder(5),
+	}
+
+	dm := genericDatadogMonitor()
+	status := &datadoghqv1alpha1.DatadogMonitorStatus{}
+	now := metav1.NewTime(time.Now())
+
+	assert.NoError(t, r.adopt(testLogger, dm, status, now, "hash", 12345))
+	assert.Equal(t, 12345, status.ID)
+	assert.True(t, status.Primary)
+	assert.NotNil(t, status.Created)
+	assert.Equal(t, "hash", status.CurrentHash)
+	assert.Equal(t, datadoghqv1alpha1.MonitorStateSyncStatusOK, status.MonitorStateSyncStatus)
+	// The DatadogMonitor itself isn't modified
+	assert.Equal(t, 0, dm.Status.ID)
+
+	found := false
+	for _, c := range status.Conditions {
+		if c.Type == datadoghqv1alpha1.DatadogMonitorConditionTypeCreated {
+			found = true
+			assert.Equal(t, corev1.ConditionTrue, c.Status)
+		}
+	}
+	assert.True(t, found)
+}
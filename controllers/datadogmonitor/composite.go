@@ -0,0 +1,134 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+// monitorRefRegexp matches a reference to another DatadogMonitor in a composite monitor query.
+// References are written `{{monitor:namespace/name}}`, or `{{monitor:name}}` for a DatadogMonitor
+// in the same namespace as the composite monitor.
+var monitorRefRegexp = regexp.MustCompile(`{{\s*monitor:\s*([^{}\s]+)\s*}}`)
+
+// getMonitorReferences returns the DatadogMonitors referenced in the query of a composite monitor.
+func getMonitorReferences(dm *datadoghqv1alpha1.DatadogMonitor) []types.NamespacedName {
+	if dm.Spec.Type != datadoghqv1alpha1.DatadogMonitorTypeComposite {
+		return nil
+	}
+
+	refs := []types.NamespacedName{}
+	found := map[types.NamespacedName]bool{}
+	for _, match := range monitorRefRegexp.FindAllStringSubmatch(dm.Spec.Query, -1) {
+		ref := parseMonitorReference(match[1], dm.Namespace)
+		if !found[ref] {
+			found[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
+
+// parseMonitorReference converts a `namespace/name` or `name` reference into a NamespacedName.
+func parseMonitorReference(ref, defaultNamespace string) types.NamespacedName {
+	if ns, name, ok := strings.Cut(ref, "/"); ok {
+		return types.NamespacedName{Namespace: ns, Name: name}
+	}
+
+	return types.NamespacedName{Namespace: defaultNamespace, Name: ref}
+}
+
+// resolveCompositeQuery replaces the DatadogMonitor references in a composite monitor query with
+// the IDs of the monitors created in Datadog. It also returns the references that can't be resolved yet,
+// either because the DatadogMonitor doesn't exist or because its monitor hasn't been created.
+func (r *Reconciler) resolveCompositeQuery(ctx context.Context, dm *datadoghqv1alpha1.DatadogMonitor) (string, []string, error) {
+	refs := getMonitorReferences(dm)
+	if len(refs) == 0 {
+		return dm.Spec.Query, nil, nil
+	}
+
+	ids := map[types.NamespacedName]int{}
+	pending := []string{}
+	for _, ref := range refs {
+		if ref.Namespace == dm.Namespace && ref.Name == dm.Name {
+			return "", nil, fmt.Errorf("composite monitor cannot reference itself")
+		}
+
+		referenced := &datadoghqv1alpha1.DatadogMonitor{}
+		if err := r.client.Get(ctx, ref, referenced); err != nil {
+			if apierrors.IsNotFound(err) {
+				pending = append(pending, ref.String())
+				continue
+			}
+			return "", nil, err
+		}
+		if referenced.Status.ID == 0 {
+			pending = append(pending, ref.String())
+			continue
+		}
+		ids[ref] = referenced.Status.ID
+	}
+
+	if len(pending) > 0 {
+		return "", pending, nil
+	}
+
+	query := monitorRefRegexp.ReplaceAllStringFunc(dm.Spec.Query, func(match string) string {
+		ref := parseMonitorReference(monitorRefRegexp.FindStringSubmatch(match)[1], dm.Namespace)
+		return strconv.Itoa(ids[ref])
+	})
+
+	return query, nil, nil
+}
+
+// withResolvedQuery returns the DatadogMonitor to send to the Datadog API, using the resolved query.
+func withResolvedQuery(dm *datadoghqv1alpha1.DatadogMonitor, query string) *datadoghqv1alpha1.DatadogMonitor {
+	if query == dm.Spec.Query {
+		return dm
+	}
+	resolved := dm.DeepCopy()
+	resolved.Spec.Query = query
+
+	return resolved
+}
+
+// CompositeMonitorRequests returns reconcile requests for the composite DatadogMonitors referencing the given DatadogMonitor,
+// so that composite monitors are updated when a referenced monitor is created or recreated with a new ID.
+func (r *Reconciler) CompositeMonitorRequests(obj client.Object) []reconcile.Request {
+	monitorList := &datadoghqv1alpha1.DatadogMonitorList{}
+	if err := r.client.List(context.TODO(), monitorList); err != nil {
+		r.log.Error(err, "unable to list DatadogMonitors")
+		return nil
+	}
+
+	changed := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	requests := []reconcile.Request{}
+	for i := range monitorList.Items {
+		composite := &monitorList.Items[i]
+		for _, ref := range getMonitorReferences(composite) {
+			if ref == changed {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Namespace: composite.Namespace, Name: composite.Name},
+				})
+				break
+			}
+		}
+	}
+
+	return requests
+}
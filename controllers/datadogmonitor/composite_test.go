@@ -0,0 +1,160 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+func Test_getMonitorReferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		dmType   datadoghqv1alpha1.DatadogMonitorType
+		wantRefs []types.NamespacedName
+	}{
+		{
+			name:     "not a composite monitor",
+			query:    "{{monitor:foo-1}} && {{monitor:foo-2}}",
+			dmType:   datadoghqv1alpha1.DatadogMonitorTypeMetric,
+			wantRefs: nil,
+		},
+		{
+			name:     "composite monitor using monitor IDs",
+			query:    "12345 && 67890",
+			dmType:   datadoghqv1alpha1.DatadogMonitorTypeComposite,
+			wantRefs: []types.NamespacedName{},
+		},
+		{
+			name:   "composite monitor with references",
+			query:  "{{monitor:foo-1}} && ({{ monitor:other/foo-2 }} || {{monitor:foo-1}})",
+			dmType: datadoghqv1alpha1.DatadogMonitorTypeComposite,
+			wantRefs: []types.NamespacedName{
+				{Namespace: resourcesNamespace, Name: "foo-1"},
+				{Namespace: "other", Name: "foo-2"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := testCompositeMonitor()
+			dm.Spec.Type = tt.dmType
+			dm.Spec.Query = tt.query
+
+			assert.Equal(t, tt.wantRefs, getMonitorReferences(dm))
+		})
+	}
+}
+
+func Test_resolveCompositeQuery(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(datadoghqv1alpha1.GroupVersion, &datadoghqv1alpha1.DatadogMonitor{}, &datadoghqv1alpha1.DatadogMonitorList{})
+
+	tests := []struct {
+		name        string
+		query       string
+		existing    map[types.NamespacedName]int
+		wantQuery   string
+		wantPending []string
+		wantErr     bool
+	}{
+		{
+			name:      "no references",
+			query:     "12345 && 67890",
+			wantQuery: "12345 && 67890",
+		},
+		{
+			name:  "all references resolved",
+			query: "{{monitor:foo-1}} && !{{monitor:other/foo-2}}",
+			existing: map[types.NamespacedName]int{
+				{Namespace: resourcesNamespace, Name: "foo-1"}: 12345,
+				{Namespace: "other", Name: "foo-2"}:            67890,
+			},
+			wantQuery: "12345 && !67890",
+		},
+		{
+			name:  "referenced monitor not created in Datadog yet",
+			query: "{{monitor:foo-1}} && {{monitor:other/foo-2}}",
+			existing: map[types.NamespacedName]int{
+				{Namespace: resourcesNamespace, Name: "foo-1"}: 12345,
+				{Namespace: "other", Name: "foo-2"}:            0,
+			},
+			wantPending: []string{"other/foo-2"},
+		},
+		{
+			name:        "referenced monitor does not exist",
+			query:       "{{monitor:foo-1}} && {{monitor:other/foo-2}}",
+			wantPending: []string{"bar/foo-1", "other/foo-2"},
+		},
+		{
+			name:    "composite monitor references itself",
+			query:   "{{monitor:foo}} && {{monitor:foo-1}}",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{
+				client: fake.NewClientBuilder().WithScheme(s).Build(),
+				log:    testLogger,
+			}
+			for nsName, id := range tt.existing {
+				dm := genericDatadogMonitor()
+				dm.Namespace = nsName.Namespace
+				dm.Name = nsName.Name
+				dm.Status.ID = id
+				assert.NoError(t, r.client.Create(context.TODO(), dm))
+			}
+
+			dm := testCompositeMonitor()
+			dm.Spec.Query = tt.query
+			query, pending, err := r.resolveCompositeQuery(context.TODO(), dm)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPending, pending)
+			if len(tt.wantPending) == 0 {
+				assert.Equal(t, tt.wantQuery, query)
+			}
+		})
+	}
+}
+
+func Test_CompositeMonitorRequests(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(datadoghqv1alpha1.GroupVersion, &datadoghqv1alpha1.DatadogMonitor{}, &datadoghqv1alpha1.DatadogMonitorList{})
+
+	r := &Reconciler{
+		client: fake.NewClientBuilder().WithScheme(s).Build(),
+		log:    testLogger,
+	}
+
+	referenced := genericDatadogMonitor()
+	referenced.Name = "foo-1"
+	composite := testCompositeMonitor()
+	other := genericDatadogMonitor()
+	other.Name = "foo-3"
+	for _, obj := range []client.Object{referenced, composite, other} {
+		assert.NoError(t, r.client.Create(context.TODO(), obj))
+	}
+
+	assert.Equal(t, []reconcile.Request{newRequest(resourcesNamespace, resourcesName)}, r.CompositeMonitorRequests(referenced))
+	assert.Empty(t, r.CompositeMonitorRequests(other))
+}
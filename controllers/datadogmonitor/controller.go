@@ -40,6 +40,8 @@ const (
 	defaultErrRequeuePeriod = 5 * time.Second
 	defaultForceSyncPeriod  = 60 * time.Minute
 	maxTriggeredStateGroups = 10
+	// maxTriggeredStateGroupsLimit is the upper bound of spec.ControllerOptions.MaxTriggeredStateGroups
+	maxTriggeredStateGroupsLimit = 100
 )
 
 var supportedMonitorTypes = map[string]bool{
@@ -54,6 +56,7 @@ var supportedMonitorTypes = map[string]bool{
 	string(datadogV1.MONITORTYPE_SLO_ALERT):             true,
 	string(datadogV1.MONITORTYPE_EVENT_V2_ALERT):        true,
 	string(datadogV1.MONITORTYPE_AUDIT_ALERT):           true,
+	string(datadogV1.MONITORTYPE_COMPOSITE):             true,
 }
 
 const requiredTag = "generated:kubernetes"
@@ -122,7 +125,22 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 		return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
 	}
 
-	instanceSpecHash, err := comparison.GenerateMD5ForSpec(&instance.Spec)
+	// Resolve references to other DatadogMonitors in composite monitor queries
+	query, pendingRefs, err := r.resolveCompositeQuery(ctx, instance)
+	if err != nil {
+		logger.Error(err, "error resolving composite monitor query")
+
+		return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
+	}
+	if len(pendingRefs) > 0 {
+		err = fmt.Errorf("waiting for referenced DatadogMonitors to be created: %s", strings.Join(pendingRefs, ", "))
+		logger.Info("Composite monitor references DatadogMonitors that are not created yet", "references", pendingRefs)
+
+		return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, ctrl.Result{RequeueAfter: defaultRequeuePeriod})
+	}
+
+	// The hash is computed on the resolved query so that a composite monitor is updated when a referenced monitor changes ID
+	instanceSpecHash, err := comparison.GenerateMD5ForSpec(&withResolvedQuery(instance, query).Spec)
 	if err != nil {
 		logger.Error(err, "error generating hash")
 
@@ -131,20 +149,22 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 
 	statusSpecHash := instance.Status.CurrentHash
 
+	policy := driftPolicy(instance)
 	shouldCreate := false
 	shouldUpdate := false
+	shouldCheckDrift := false
+	var m datadogV1.Monitor
 
 	// Check if we need to create the monitor, update the monitor definition, or update monitor state
 	if instance.Status.ID == 0 {
 		shouldCreate = true
 	} else {
-		var m datadogV1.Monitor
-		if instanceSpecHash != statusSpecHash {
+		if instanceSpecHash != statusSpecHash && policy != datadoghqv1alpha1.DatadogDriftPolicyObserve {
 			// Custom resource manifest has changed, need to update the API
 			logger.V(1).Info("DatadogMonitor manifest has changed")
 			shouldUpdate = true
-		} else if instance.Status.MonitorLastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(instance.Status.MonitorLastForceSyncTime.Time)) <= 0 {
-			// Periodically force a sync with the API monitor to ensure parity
+		} else if instanceSpecHash != statusSpecHash || instance.Status.MonitorLastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(instance.Status.MonitorLastForceSyncTime.Time)) <= 0 {
+			// Periodically force a sync with the API monitor to ensure parity, or only compare them depending on the drift policy
 			// Get monitor to make sure it exists before trying any updates. If it doesn't, set shouldCreate
 			m, err = r.get(instance, newStatus)
 			if err != nil {
@@ -152,8 +172,10 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 				if strings.Contains(err.Error(), ctrutils.NotFoundString) {
 					shouldCreate = true
 				}
-			} else {
+			} else if policy == datadoghqv1alpha1.DatadogDriftPolicyEnforce {
 				shouldUpdate = true
+			} else {
+				shouldCheckDrift = true
 			}
 		} else if instance.Status.MonitorStateLastUpdateTime == nil || (defaultRequeuePeriod-now.Sub(instance.Status.MonitorStateLastUpdateTime.Time)) <= 0 {
 			// If other conditions aren't met, and we have passed the defaultRequeuePeriod, then update monitor state
@@ -165,7 +187,8 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 					shouldCreate = true
 				}
 			}
-			updateMonitorState(m, now, newStatus)
+			updateMonitorState(m, now, newStatus, maxTriggeredGroups(instance))
+			newStatus.DowntimeStatus = r.getDowntimeStatus(ctx, logger, instance)
 		}
 	}
 
@@ -173,13 +196,28 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 	if shouldCreate {
 		if isSupportedMonitorType(instance.Spec.Type) {
 			logger.V(1).Info("Creating monitor in Datadog")
-			// Make sure required tags are present
-			if !apiutils.BoolValue(instance.Spec.ControllerOptions.DisableRequiredTags) {
+			// Make sure required tags are present, the spec of observed monitors isn't changed
+			if !apiutils.BoolValue(instance.Spec.ControllerOptions.DisableRequiredTags) && policy != datadoghqv1alpha1.DatadogDriftPolicyObserve {
 				if result, err = r.checkRequiredTags(logger, instance); err != nil || result.Requeue {
 					return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
 				}
 			}
-			if err = r.create(logger, instance, newStatus, now, instanceSpecHash); err != nil {
+			var adoptID int64
+			if instance.Status.ID == 0 {
+				if adoptID, err = r.findMonitorToAdopt(logger, instance); err != nil {
+					logger.Error(err, "error finding monitor to adopt")
+
+					return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
+				}
+			}
+			if adoptID != 0 {
+				if err = r.adopt(logger, withResolvedQuery(instance, query), newStatus, now, instanceSpecHash, adoptID); err != nil {
+					logger.Error(err, "error adopting monitor", "Monitor ID", adoptID)
+				}
+			} else if policy == datadoghqv1alpha1.DatadogDriftPolicyObserve {
+				err = fmt.Errorf("monitors are not created with the %s drift policy, set spec.controllerOptions.adoptMonitorID or spec.controllerOptions.adoptByNameAndTags to observe an existing monitor", policy)
+				logger.Error(err, "error creating monitor")
+			} else if err = r.create(logger, withResolvedQuery(instance, query), newStatus, now, instanceSpecHash); err != nil {
 				logger.Error(err, "error creating monitor")
 			}
 		} else {
@@ -194,9 +232,14 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 				return r.updateStatusIfNeeded(logger, instance, now, newStatus, err, result)
 			}
 		}
-		if err = r.update(logger, instance, newStatus, now, instanceSpecHash); err != nil {
+		if err = r.update(logger, withResolvedQuery(instance, query), newStatus, now, instanceSpecHash); err != nil {
 			logger.Error(err, "error updating monitor", "Monitor ID", instance.Status.ID)
 		}
+	} else if shouldCheckDrift {
+		logger.V(1).Info("Checking monitor drift in Datadog", "Drift policy", policy)
+		if err = r.checkDrift(logger, withResolvedQuery(instance, query), m, newStatus, now, instanceSpecHash); err != nil {
+			logger.Error(err, "error checking monitor drift", "Monitor ID", instance.Status.ID)
+		}
 	}
 
 	// If reconcile was successful, requeue with period defaultRequeuePeriod
@@ -257,6 +300,8 @@ func (r *Reconciler) update(logger logr.Logger, datadogMonitor *datadoghqv1alpha
 
 	// Set Updated Condition
 	condition.UpdateDatadogMonitorConditions(status, now, datadoghqv1alpha1.DatadogMonitorConditionTypeUpdated, corev1.ConditionTrue, "DatadogMonitor Updated")
+	// The monitor was overwritten with the spec
+	condition.UpdateDatadogMonitorConditions(status, now, datadoghqv1alpha1.DatadogMonitorConditionTypeDrifted, corev1.ConditionFalse, "Monitor matches the DatadogMonitor spec")
 	status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusOK
 	status.MonitorLastForceSyncTime = &now
 	status.CurrentHash = instanceSpecHash
@@ -275,8 +320,8 @@ func (r *Reconciler) get(datadogMonitor *datadoghqv1alpha1.DatadogMonitor, statu
 	return m, nil
 }
 
-func updateMonitorState(m datadogV1.Monitor, now metav1.Time, status *datadoghqv1alpha1.DatadogMonitorStatus) {
-	convertStateToStatus(m, status, now)
+func updateMonitorState(m datadogV1.Monitor, now metav1.Time, status *datadoghqv1alpha1.DatadogMonitorStatus, maxGroups int) {
+	convertStateToStatus(m, status, now, maxGroups)
 	status.MonitorStateLastUpdateTime = &now
 	status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusOK
 }
@@ -348,10 +393,11 @@ func getRequiredTags() []string {
 	return []string{requiredTag}
 }
 
-// convertStateToStatus updates status.MonitorState, status.TriggeredState, and status.DowntimeStatus according to the current state of the monitor
-func convertStateToStatus(monitor datadogV1.Monitor, newStatus *datadoghqv1alpha1.DatadogMonitorStatus, now metav1.Time) {
+// convertStateToStatus updates status.MonitorState, status.TriggeredState and status.GroupStates according to the current state of the monitor
+func convertStateToStatus(monitor datadogV1.Monitor, newStatus *datadoghqv1alpha1.DatadogMonitorStatus, now metav1.Time, maxGroups int) {
 	// If monitor group is in Alert, Warn or No Data, then add its info to the TriggeredState
 	triggeredStates := []datadoghqv1alpha1.DatadogMonitorTriggeredState{}
+	groupStates := datadoghqv1alpha1.DatadogMonitorGroupStates{}
 	monitorState, exists := monitor.GetStateOk()
 	if exists {
 		monitorGroups, exists := monitorState.GetGroupsOk()
@@ -359,6 +405,7 @@ func convertStateToStatus(monitor datadogV1.Monitor, newStatus *datadoghqv1alpha
 			var groupStatus datadogV1.MonitorOverallStates
 			for group, monitorStateGroup := range *monitorGroups {
 				groupStatus = monitorStateGroup.GetStatus()
+				countGroupState(&groupStates, datadoghqv1alpha1.DatadogMonitorState(groupStatus))
 				if isTriggered(string(groupStatus)) {
 					triggeredStates = append(triggeredStates, datadoghqv1alpha1.DatadogMonitorTriggeredState{
 						MonitorGroup:       group,
@@ -369,12 +416,24 @@ func convertStateToStatus(monitor datadogV1.Monitor, newStatus *datadoghqv1alpha
 			}
 		}
 	}
-	sort.SliceStable(triggeredStates, func(i, j int) bool { return triggeredStates[i].MonitorGroup < triggeredStates[j].MonitorGroup })
-	if len(triggeredStates) > maxTriggeredStateGroups {
-		// Cap the size of Status.TrigggeredState
-		triggeredStates = triggeredStates[0:maxTriggeredStateGroups]
+	// Sort the most severe groups first, then by name: the order must not depend on the transition times so that
+	// a flapping monitor doesn't reorder the groups at each sync.
+	sort.SliceStable(triggeredStates, func(i, j int) bool {
+		if si, sj := stateSeverity(triggeredStates[i].State), stateSeverity(triggeredStates[j].State); si != sj {
+			return si > sj
+		}
+		return triggeredStates[i].MonitorGroup < triggeredStates[j].MonitorGroup
+	})
+	if len(triggeredStates) > maxGroups {
+		// Cap the size of Status.TrigggeredState, the other groups are only counted in Status.GroupStates
+		triggeredStates = triggeredStates[0:maxGroups]
+		groupStates.Truncated = true
 	}
 	newStatus.TriggeredState = triggeredStates
+	newStatus.GroupStates = nil
+	if groupStates.TotalCount > 0 {
+		newStatus.GroupStates = &groupStates
+	}
 
 	oldMonitorState := newStatus.MonitorState
 	newStatus.MonitorState = datadoghqv1alpha1.DatadogMonitorState(monitor.GetOverallState())
@@ -382,8 +441,52 @@ func convertStateToStatus(monitor datadogV1.Monitor, newStatus *datadoghqv1alpha
 	if newStatus.MonitorState != oldMonitorState {
 		newStatus.MonitorStateLastTransitionTime = &now
 	}
-	// TODO Updating this requires having the API client also return any matching downtime objects
-	newStatus.DowntimeStatus = datadoghqv1alpha1.DatadogMonitorDowntimeStatus{}
+}
+
+func countGroupState(groupStates *datadoghqv1alpha1.DatadogMonitorGroupStates, state datadoghqv1alpha1.DatadogMonitorState) {
+	groupStates.TotalCount++
+	switch state {
+	case datadoghqv1alpha1.DatadogMonitorStateOK:
+		groupStates.OKCount++
+	case datadoghqv1alpha1.DatadogMonitorStateAlert:
+		groupStates.AlertCount++
+	case datadoghqv1alpha1.DatadogMonitorStateWarn:
+		groupStates.WarnCount++
+	case datadoghqv1alpha1.DatadogMonitorStateNoData:
+		groupStates.NoDataCount++
+	}
+	if isTriggered(string(state)) {
+		groupStates.TriggeredCount++
+	}
+}
+
+// stateSeverity ranks the triggered states, the higher the more severe
+func stateSeverity(state datadoghqv1alpha1.DatadogMonitorState) int {
+	switch state {
+	case datadoghqv1alpha1.DatadogMonitorStateAlert:
+		return 3
+	case datadoghqv1alpha1.DatadogMonitorStateWarn:
+		return 2
+	case datadoghqv1alpha1.DatadogMonitorStateNoData:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// maxTriggeredGroups returns the maximum number of groups to list in Status.TriggeredState
+func maxTriggeredGroups(dm *datadoghqv1alpha1.DatadogMonitor) int {
+	if dm.Spec.ControllerOptions.MaxTriggeredStateGroups == nil {
+		return maxTriggeredStateGroups
+	}
+	maxGroups := int(*dm.Spec.ControllerOptions.MaxTriggeredStateGroups)
+	if maxGroups < 0 {
+		return 0
+	}
+	if maxGroups > maxTriggeredStateGroupsLimit {
+		return maxTriggeredStateGroupsLimit
+	}
+	return maxGroups
 }
 
 func isSupportedMonitorType(monitorType datadoghqv1alpha1.DatadogMonitorType) bool {
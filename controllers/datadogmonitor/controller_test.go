@@ -44,7 +44,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 	logf.SetLogger(zap.New(zap.UseDevMode(true)))
 
 	s := scheme.Scheme
-	s.AddKnownTypes(datadoghqv1alpha1.GroupVersion, &datadoghqv1alpha1.DatadogMonitor{})
+	s.AddKnownTypes(datadoghqv1alpha1.GroupVersion, &datadoghqv1alpha1.DatadogMonitor{}, &datadoghqv1alpha1.DatadogDowntime{}, &datadoghqv1alpha1.DatadogDowntimeList{})
 
 	type args struct {
 		request              reconcile.Request
@@ -124,6 +124,27 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 				return nil
 			},
 		},
+		{
+			name: "DatadogMonitor observed, required tags not added",
+			args: args{
+				request: newRequest(resourcesNamespace, resourcesName),
+				firstAction: func(c client.Client) {
+					dm := genericDatadogMonitor()
+					dm.Spec.ControllerOptions.DriftPolicy = datadoghqv1alpha1.DatadogDriftPolicyObserve
+					_ = c.Create(context.TODO(), dm)
+				},
+				firstReconcileCount: 2,
+			},
+			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
+			wantFunc: func(c client.Client) error {
+				dm := &datadoghqv1alpha1.DatadogMonitor{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, dm); err != nil {
+					return err
+				}
+				assert.NotContains(t, dm.Spec.Tags, "generated:kubernetes")
+				return nil
+			},
+		},
 		{
 			name: "DatadogMonitor exists, needs update",
 			args: args{
@@ -399,26 +420,62 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 			},
 		},
 		{
-			name: "DatadogMonitor of unsupported type (composite)",
+			name: "DatadogMonitor composite, referenced monitors not created",
 			args: args{
 				request: newRequest(resourcesNamespace, resourcesName),
 				firstAction: func(c client.Client) {
-					_ = c.Create(context.TODO(), &datadoghqv1alpha1.DatadogMonitor{
-						TypeMeta: metav1.TypeMeta{
-							Kind:       "DatadogMonitor",
-							APIVersion: fmt.Sprintf("%s/%s", datadoghqv1alpha1.GroupVersion.Group, datadoghqv1alpha1.GroupVersion.Version),
-						},
-						ObjectMeta: metav1.ObjectMeta{
-							Namespace: resourcesNamespace,
-							Name:      resourcesName,
-						},
-						Spec: datadoghqv1alpha1.DatadogMonitorSpec{
-							Query:   "avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.1",
-							Type:    datadoghqv1alpha1.DatadogMonitorTypeComposite,
-							Name:    "test monitor",
-							Message: "something is wrong",
-						},
-					})
+					_ = c.Create(context.TODO(), testCompositeMonitor())
+				},
+				firstReconcileCount: 2,
+			},
+			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
+			wantErr:    false,
+			wantFunc: func(c client.Client) error {
+				dm := &datadoghqv1alpha1.DatadogMonitor{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, dm); err != nil {
+					return err
+				}
+				assert.Equal(t, dm.Status.Conditions[0].Type, datadoghqv1alpha1.DatadogMonitorConditionTypeError)
+				assert.Contains(t, dm.Status.Conditions[0].Message, "waiting for referenced DatadogMonitors")
+				assert.Equal(t, 0, dm.Status.ID)
+				return nil
+			},
+		},
+		{
+			name: "DatadogMonitor composite, referenced monitors created",
+			args: args{
+				request: newRequest(resourcesNamespace, resourcesName),
+				firstAction: func(c client.Client) {
+					for _, name := range []string{"foo-1", "foo-2"} {
+						dm := genericDatadogMonitor()
+						dm.Name = name
+						dm.Status.ID = 12345
+						_ = c.Create(context.TODO(), dm)
+					}
+					_ = c.Create(context.TODO(), testCompositeMonitor())
+				},
+				firstReconcileCount: 10,
+			},
+			wantResult: reconcile.Result{RequeueAfter: defaultRequeuePeriod},
+			wantErr:    false,
+			wantFunc: func(c client.Client) error {
+				dm := &datadoghqv1alpha1.DatadogMonitor{}
+				if err := c.Get(context.TODO(), types.NamespacedName{Name: resourcesName, Namespace: resourcesNamespace}, dm); err != nil {
+					return err
+				}
+				assert.NotContains(t, dm.Status.Conditions[0].Message, "error")
+				assert.True(t, dm.Status.Primary)
+				return nil
+			},
+		},
+		{
+			name: "DatadogMonitor composite, references itself",
+			args: args{
+				request: newRequest(resourcesNamespace, resourcesName),
+				firstAction: func(c client.Client) {
+					dm := testCompositeMonitor()
+					dm.Spec.Query = "{{monitor:foo}} && {{monitor:foo-1}}"
+					_ = c.Create(context.TODO(), dm)
 				},
 				firstReconcileCount: 2,
 			},
@@ -430,6 +487,7 @@ func TestReconcileDatadogMonitor_Reconcile(t *testing.T) {
 					return err
 				}
 				assert.Equal(t, dm.Status.Conditions[0].Type, datadoghqv1alpha1.DatadogMonitorConditionTypeError)
+				assert.Contains(t, dm.Status.Conditions[0].Message, "cannot reference itself")
 				return nil
 			},
 		},
@@ -518,10 +576,12 @@ func Test_convertStateToStatus(t *testing.T) {
 	okState := datadogV1.MONITOROVERALLSTATES_OK
 	alertState := datadogV1.MONITOROVERALLSTATES_ALERT
 	noDataState := datadogV1.MONITOROVERALLSTATES_NO_DATA
+	warnState := datadogV1.MONITOROVERALLSTATES_WARN
 
 	tests := []struct {
 		name       string
 		monitor    func() datadogV1.Monitor
+		maxGroups  int
 		status     *datadoghqv1alpha1.DatadogMonitorStatus
 		wantStatus *datadoghqv1alpha1.DatadogMonitorStatus
 	}{
@@ -555,6 +615,7 @@ func Test_convertStateToStatus(t *testing.T) {
 			wantStatus: &datadoghqv1alpha1.DatadogMonitorStatus{
 				TriggeredState: []datadoghqv1alpha1.DatadogMonitorTriggeredState{},
 				MonitorState:   datadoghqv1alpha1.DatadogMonitorStateOK,
+				GroupStates:    &datadoghqv1alpha1.DatadogMonitorGroupStates{TotalCount: 3, OKCount: 3},
 			},
 		},
 		{
@@ -593,6 +654,7 @@ func Test_convertStateToStatus(t *testing.T) {
 					},
 				},
 				MonitorState: datadoghqv1alpha1.DatadogMonitorStateAlert,
+				GroupStates:  &datadoghqv1alpha1.DatadogMonitorGroupStates{TotalCount: 3, OKCount: 2, AlertCount: 1, TriggeredCount: 1},
 			},
 		},
 		{
@@ -633,6 +695,7 @@ func Test_convertStateToStatus(t *testing.T) {
 					},
 				},
 				MonitorState: datadoghqv1alpha1.DatadogMonitorStateAlert,
+				GroupStates:  &datadoghqv1alpha1.DatadogMonitorGroupStates{TotalCount: 3, OKCount: 2, AlertCount: 1, TriggeredCount: 1},
 			},
 		},
 		{
@@ -671,6 +734,7 @@ func Test_convertStateToStatus(t *testing.T) {
 					},
 				},
 				MonitorState: datadoghqv1alpha1.DatadogMonitorStateNoData,
+				GroupStates:  &datadoghqv1alpha1.DatadogMonitorGroupStates{TotalCount: 3, OKCount: 2, NoDataCount: 1, TriggeredCount: 1},
 			},
 		},
 		{
@@ -714,16 +778,75 @@ func Test_convertStateToStatus(t *testing.T) {
 					},
 				},
 				MonitorState: datadoghqv1alpha1.DatadogMonitorStateAlert,
+				GroupStates:  &datadoghqv1alpha1.DatadogMonitorGroupStates{TotalCount: 3, OKCount: 1, AlertCount: 1, NoDataCount: 1, TriggeredCount: 2},
+			},
+		},
+		{
+			name: "12 groups, 11 triggered, capped to the most severe groups",
+			monitor: func() datadogV1.Monitor {
+				m := genericMonitor(12345)
+
+				msg := make(map[string]datadogV1.MonitorStateGroup)
+				msg["groupA"] = datadogV1.MonitorStateGroup{Status: &okState}
+				for i := 0; i < 5; i++ {
+					msg[fmt.Sprintf("nodata-%d", i)] = datadogV1.MonitorStateGroup{Status: &noDataState, LastNodataTs: &triggerTs}
+				}
+				for i := 0; i < 4; i++ {
+					msg[fmt.Sprintf("warn-%d", i)] = datadogV1.MonitorStateGroup{Status: &warnState, LastTriggeredTs: &triggerTs}
+				}
+				for i := 0; i < 2; i++ {
+					msg[fmt.Sprintf("alert-%d", i)] = datadogV1.MonitorStateGroup{Status: &alertState, LastTriggeredTs: &secondTriggerTs}
+				}
+
+				m.State = &datadogV1.MonitorState{
+					Groups: msg,
+				}
+				m.OverallState = &alertState
+
+				return m
+			},
+			maxGroups: 4,
+			status:    &datadoghqv1alpha1.DatadogMonitorStatus{},
+			wantStatus: &datadoghqv1alpha1.DatadogMonitorStatus{
+				TriggeredState: []datadoghqv1alpha1.DatadogMonitorTriggeredState{
+					{
+						MonitorGroup:       "alert-0",
+						State:              datadoghqv1alpha1.DatadogMonitorStateAlert,
+						LastTransitionTime: metav1.Unix(secondTriggerTs, 0),
+					},
+					{
+						MonitorGroup:       "alert-1",
+						State:              datadoghqv1alpha1.DatadogMonitorStateAlert,
+						LastTransitionTime: metav1.Unix(secondTriggerTs, 0),
+					},
+					{
+						MonitorGroup:       "warn-0",
+						State:              datadoghqv1alpha1.DatadogMonitorStateWarn,
+						LastTransitionTime: metav1.Unix(triggerTs, 0),
+					},
+					{
+						MonitorGroup:       "warn-1",
+						State:              datadoghqv1alpha1.DatadogMonitorStateWarn,
+						LastTransitionTime: metav1.Unix(triggerTs, 0),
+					},
+				},
+				MonitorState: datadoghqv1alpha1.DatadogMonitorStateAlert,
+				GroupStates:  &datadoghqv1alpha1.DatadogMonitorGroupStates{TotalCount: 12, OKCount: 1, AlertCount: 2, WarnCount: 4, NoDataCount: 5, TriggeredCount: 11, Truncated: true},
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			convertStateToStatus(tt.monitor(), tt.status, now)
+			maxGroups := tt.maxGroups
+			if maxGroups == 0 {
+				maxGroups = maxTriggeredStateGroups
+			}
+			convertStateToStatus(tt.monitor(), tt.status, now, maxGroups)
 
 			assert.Equal(t, tt.wantStatus.TriggeredState, tt.status.TriggeredState)
 			assert.Equal(t, tt.wantStatus.MonitorState, tt.status.MonitorState)
+			assert.Equal(t, tt.wantStatus.GroupStates, tt.status.GroupStates)
 		})
 	}
 }
@@ -942,3 +1065,22 @@ func testAuditMonitor() *datadoghqv1alpha1.DatadogMonitor {
 		},
 	}
 }
+
+func testCompositeMonitor() *datadoghqv1alpha1.DatadogMonitor {
+	return &datadoghqv1alpha1.DatadogMonitor{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogMonitor",
+			APIVersion: fmt.Sprintf("%s/%s", datadoghqv1alpha1.GroupVersion.Group, datadoghqv1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourcesNamespace,
+			Name:      resourcesName,
+		},
+		Spec: datadoghqv1alpha1.DatadogMonitorSpec{
+			Query:   "{{monitor:foo-1}} && {{monitor:bar/foo-2}}",
+			Type:    datadoghqv1alpha1.DatadogMonitorTypeComposite,
+			Name:    "test composite monitor",
+			Message: "something is wrong",
+		},
+	}
+}
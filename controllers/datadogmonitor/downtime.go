@@ -0,0 +1,64 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+// getDowntimeStatus returns the downtime status of a DatadogMonitor, based on the active downtimes
+// managed by the DatadogDowntimes of its namespace.
+func (r *Reconciler) getDowntimeStatus(ctx context.Context, logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor) datadoghqv1alpha1.DatadogMonitorDowntimeStatus {
+	downtimeList := &datadoghqv1alpha1.DatadogDowntimeList{}
+	if err := r.client.List(ctx, downtimeList, client.InNamespace(dm.Namespace)); err != nil {
+		logger.V(1).Info("unable to list DatadogDowntimes", "error", err.Error())
+		return datadoghqv1alpha1.DatadogMonitorDowntimeStatus{}
+	}
+
+	for i := range downtimeList.Items {
+		downtime := &downtimeList.Items[i]
+		for _, instance := range downtime.Status.Downtimes {
+			if instance.Active && isCoveredByDowntime(dm, downtime, instance) {
+				return datadoghqv1alpha1.DatadogMonitorDowntimeStatus{
+					IsDowntimed: true,
+					DowntimeID:  int(instance.ID),
+				}
+			}
+		}
+	}
+
+	return datadoghqv1alpha1.DatadogMonitorDowntimeStatus{}
+}
+
+// isCoveredByDowntime returns true if the downtime created in Datadog applies to the monitor.
+func isCoveredByDowntime(dm *datadoghqv1alpha1.DatadogMonitor, downtime *datadoghqv1alpha1.DatadogDowntime, instance datadoghqv1alpha1.DatadogDowntimeInstance) bool {
+	if instance.MonitorID != 0 {
+		return dm.Status.ID != 0 && instance.MonitorID == int64(dm.Status.ID)
+	}
+
+	selector := downtime.Spec.MonitorSelector
+	if selector == nil || len(selector.MonitorTags) == 0 {
+		// The downtime applies to all monitors
+		return true
+	}
+
+	tags := map[string]bool{requiredTag: true}
+	for _, tag := range dm.Spec.Tags {
+		tags[tag] = true
+	}
+	for _, tag := range selector.MonitorTags {
+		if !tags[tag] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,114 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+func Test_getDowntimeStatus(t *testing.T) {
+	s := scheme.Scheme
+	s.AddKnownTypes(datadoghqv1alpha1.GroupVersion, &datadoghqv1alpha1.DatadogDowntime{}, &datadoghqv1alpha1.DatadogDowntimeList{})
+
+	tests := []struct {
+		name       string
+		downtimes  []datadoghqv1alpha1.DatadogDowntime
+		wantStatus datadoghqv1alpha1.DatadogMonitorDowntimeStatus
+	}{
+		{
+			name:       "no downtime",
+			wantStatus: datadoghqv1alpha1.DatadogMonitorDowntimeStatus{},
+		},
+		{
+			name: "active downtime for the monitor ID",
+			downtimes: []datadoghqv1alpha1.DatadogDowntime{
+				testDowntime("dt-1", nil, datadoghqv1alpha1.DatadogDowntimeInstance{ID: 10, MonitorID: 12345, Active: true}),
+			},
+			wantStatus: datadoghqv1alpha1.DatadogMonitorDowntimeStatus{IsDowntimed: true, DowntimeID: 10},
+		},
+		{
+			name: "inactive downtime for the monitor ID",
+			downtimes: []datadoghqv1alpha1.DatadogDowntime{
+				testDowntime("dt-1", nil, datadoghqv1alpha1.DatadogDowntimeInstance{ID: 10, MonitorID: 12345}),
+			},
+			wantStatus: datadoghqv1alpha1.DatadogMonitorDowntimeStatus{},
+		},
+		{
+			name: "active downtime for another monitor",
+			downtimes: []datadoghqv1alpha1.DatadogDowntime{
+				testDowntime("dt-1", nil, datadoghqv1alpha1.DatadogDowntimeInstance{ID: 10, MonitorID: 67890, Active: true}),
+			},
+			wantStatus: datadoghqv1alpha1.DatadogMonitorDowntimeStatus{},
+		},
+		{
+			name: "active downtime for all monitors",
+			downtimes: []datadoghqv1alpha1.DatadogDowntime{
+				testDowntime("dt-1", nil, datadoghqv1alpha1.DatadogDowntimeInstance{ID: 11, Active: true}),
+			},
+			wantStatus: datadoghqv1alpha1.DatadogMonitorDowntimeStatus{IsDowntimed: true, DowntimeID: 11},
+		},
+		{
+			name: "active downtime matching the monitor tags",
+			downtimes: []datadoghqv1alpha1.DatadogDowntime{
+				testDowntime("dt-1", []string{"env:prod", "generated:kubernetes"}, datadoghqv1alpha1.DatadogDowntimeInstance{ID: 12, Active: true}),
+			},
+			wantStatus: datadoghqv1alpha1.DatadogMonitorDowntimeStatus{IsDowntimed: true, DowntimeID: 12},
+		},
+		{
+			name: "active downtime not matching the monitor tags",
+			downtimes: []datadoghqv1alpha1.DatadogDowntime{
+				testDowntime("dt-1", []string{"env:prod", "team:foo"}, datadoghqv1alpha1.DatadogDowntimeInstance{ID: 12, Active: true}),
+			},
+			wantStatus: datadoghqv1alpha1.DatadogMonitorDowntimeStatus{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Reconciler{
+				client: fake.NewClientBuilder().WithScheme(s).Build(),
+				log:    testLogger,
+			}
+			for i := range tt.downtimes {
+				assert.NoError(t, r.client.Create(context.TODO(), &tt.downtimes[i]))
+			}
+
+			dm := genericDatadogMonitor()
+			dm.Spec.Tags = []string{"env:prod"}
+			dm.Status.ID = 12345
+
+			assert.Equal(t, tt.wantStatus, r.getDowntimeStatus(context.TODO(), testLogger, dm))
+		})
+	}
+}
+
+func testDowntime(name string, monitorTags []string, instances ...datadoghqv1alpha1.DatadogDowntimeInstance) datadoghqv1alpha1.DatadogDowntime {
+	downtime := datadoghqv1alpha1.DatadogDowntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourcesNamespace,
+			Name:      name,
+		},
+		Spec: datadoghqv1alpha1.DatadogDowntimeSpec{
+			Scope: []string{"*"},
+		},
+		Status: datadoghqv1alpha1.DatadogDowntimeStatus{
+			Downtimes: instances,
+		},
+	}
+	if monitorTags != nil {
+		downtime.Spec.MonitorSelector = &datadoghqv1alpha1.DatadogDowntimeMonitorSelector{MonitorTags: monitorTags}
+	}
+
+	return downtime
+}
@@ -0,0 +1,69 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+const driftDetectedReason = "DriftDetected"
+
+func driftPolicy(dm *datadoghqv1alpha1.DatadogMonitor) datadoghqv1alpha1.DatadogDriftPolicy {
+	return dm.Spec.ControllerOptions.DriftPolicy.OrDefault()
+}
+
+// checkDrift compares the monitor in Datadog with the DatadogMonitor spec and reports the differences in the Drifted
+// condition, without updating the monitor. A warning event is recorded when the differences change.
+func (r *Reconciler) checkDrift(logger logr.Logger, datadogMonitor *datadoghqv1alpha1.DatadogMonitor, m datadogV1.Monitor, status *datadoghqv1alpha1.DatadogMonitorStatus, now metav1.Time, instanceSpecHash string) error {
+	_, desired := buildMonitor(logger, datadogMonitor)
+	// The required tag is only added to the monitors created or updated by the controller, not to the observed ones
+	if !utils.ContainsString(m.GetTags(), requiredTag) {
+		desired.SetTags(utils.RemoveString(append([]string{}, desired.GetTags()...), requiredTag))
+	}
+	drift, err := comparison.FindDrift(desired, m)
+	if err != nil {
+		return err
+	}
+
+	status.MonitorStateSyncStatus = datadoghqv1alpha1.MonitorStateSyncStatusOK
+	status.MonitorLastForceSyncTime = &now
+	status.CurrentHash = instanceSpecHash
+
+	if len(drift) == 0 {
+		condition.UpdateDatadogMonitorConditions(status, now, datadoghqv1alpha1.DatadogMonitorConditionTypeDrifted, corev1.ConditionFalse, "Monitor matches the DatadogMonitor spec")
+		return nil
+	}
+
+	msg := fmt.Sprintf("Monitor differs from the DatadogMonitor spec: %s", strings.Join(drift, ", "))
+	if !hasDriftCondition(status, msg) {
+		logger.Info("Monitor drifted from the DatadogMonitor spec", "Monitor ID", m.GetId(), "fields", drift)
+		r.recorder.Event(datadogMonitor, corev1.EventTypeWarning, driftDetectedReason, msg)
+	}
+	condition.UpdateDatadogMonitorConditions(status, now, datadoghqv1alpha1.DatadogMonitorConditionTypeDrifted, corev1.ConditionTrue, msg)
+
+	return nil
+}
+
+// hasDriftCondition returns true if the Drifted condition already reports the differences described by msg
+func hasDriftCondition(status *datadoghqv1alpha1.DatadogMonitorStatus, msg string) bool {
+	for _, c := range status.Conditions {
+		if c.Type == datadoghqv1alpha1.DatadogMonitorConditionTypeDrifted {
+			return c.Status == corev1.ConditionTrue && c.Message == msg
+		}
+	}
+	return false
+}
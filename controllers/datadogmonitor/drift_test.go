@@ -0,0 +1,149 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogmonitor
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	datadogV1 "github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	datadoghqv1alpha1 "github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+func Test_checkDrift(t *testing.T) {
+	recorder := record.NewFakeRecorder(5)
+	r := &Reconciler{
+		log:      testLogger,
+		recorder: recorder,
+	}
+	now := metav1.NewTime(time.Now())
+
+	m := genericMonitor(12345)
+	dm := genericDatadogMonitor()
+	dm.Spec = BuildDatadogMonitorSpec(m)
+	dm.Status.ID = 12345
+	status := dm.Status.DeepCopy()
+
+	// No drift
+	assert.NoError(t, r.checkDrift(testLogger, dm, m, status, now, "hash"))
+	assert.Nil(t, getDriftCondition(status))
+	assert.Equal(t, "hash", status.CurrentHash)
+	assert.Equal(t, &now, status.MonitorLastForceSyncTime)
+	assert.Empty(t, recorder.Events)
+
+	// The monitor was edited in Datadog
+	m.SetMessage("Edited in the UI")
+	m.SetTags([]string{"env:staging"})
+	assert.NoError(t, r.checkDrift(testLogger, dm, m, status, now, "hash"))
+	driftCondition := getDriftCondition(status)
+	assert.NotNil(t, driftCondition)
+	assert.Equal(t, corev1.ConditionTrue, driftCondition.Status)
+	assert.Equal(t, "Monitor differs from the DatadogMonitor spec: message, tags", driftCondition.Message)
+	assert.Len(t, recorder.Events, 1)
+	assert.Equal(t, "Warning DriftDetected Monitor differs from the DatadogMonitor spec: message, tags", <-recorder.Events)
+
+	// The same drift is only reported once
+	assert.NoError(t, r.checkDrift(testLogger, dm, m, status, now, "hash"))
+	assert.Empty(t, recorder.Events)
+
+	// The monitor was reverted in Datadog
+	assert.NoError(t, r.checkDrift(testLogger, dm, genericMonitor(12345), status, now, "hash"))
+	assert.Equal(t, corev1.ConditionFalse, getDriftCondition(status).Status)
+}
+
+func Test_adopt_observe(t *testing.T) {
+	updated := false
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			updated = true
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(genericMonitor(12345))
+	}))
+	defer httpServer.Close()
+
+	testConfig := datadogapi.NewConfiguration()
+	testConfig.HTTPClient = httpServer.Client()
+	r := &Reconciler{
+		datadogClient: datadogV1.NewMonitorsApi(datadogapi.NewAPIClient(testConfig)),
+		datadogAuth:   setupTestAuth(httpServer.URL),
+		log:           testLogger,
+		recorder:      record.NewFakeRecorder(5),
+	}
+
+	dm := genericDatadogMonitor()
+	dm.Spec.ControllerOptions.DriftPolicy = datadoghqv1alpha1.DatadogDriftPolicyObserve
+	status := &datadoghqv1alpha1.DatadogMonitorStatus{}
+	now := metav1.NewTime(time.Now())
+
+	assert.NoError(t, r.adopt(testLogger, dm, status, now, "hash", 12345))
+	assert.False(t, updated)
+	assert.Equal(t, 12345, status.ID)
+	driftCondition := getDriftCondition(status)
+	assert.NotNil(t, driftCondition)
+	assert.Equal(t, corev1.ConditionTrue, driftCondition.Status)
+}
+
+func Test_adopt_observeWithoutRequiredTag(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(genericMonitor(12345))
+	}))
+	defer httpServer.Close()
+
+	testConfig := datadogapi.NewConfiguration()
+	testConfig.HTTPClient = httpServer.Client()
+	recorder := record.NewFakeRecorder(5)
+	r := &Reconciler{
+		datadogClient: datadogV1.NewMonitorsApi(datadogapi.NewAPIClient(testConfig)),
+		datadogAuth:   setupTestAuth(httpServer.URL),
+		log:           testLogger,
+		recorder:      recorder,
+	}
+
+	// The monitor created in the UI doesn't have the required tag of the DatadogMonitor spec
+	dm := genericDatadogMonitor()
+	dm.Spec = BuildDatadogMonitorSpec(genericMonitor(12345))
+	dm.Spec.Tags = append(dm.Spec.Tags, requiredTag)
+	dm.Spec.ControllerOptions.DriftPolicy = datadoghqv1alpha1.DatadogDriftPolicyObserve
+	now := metav1.NewTime(time.Now())
+	// The drift of the tags was reported before
+	status := &datadoghqv1alpha1.DatadogMonitorStatus{
+		Conditions: []datadoghqv1alpha1.DatadogMonitorCondition{
+			{
+				Type:    datadoghqv1alpha1.DatadogMonitorConditionTypeDrifted,
+				Status:  corev1.ConditionTrue,
+				Message: "Monitor differs from the DatadogMonitor spec: tags",
+			},
+		},
+	}
+
+	assert.NoError(t, r.adopt(testLogger, dm, status, now, "hash", 12345))
+	driftCondition := getDriftCondition(status)
+	assert.NotNil(t, driftCondition)
+	assert.Equal(t, corev1.ConditionFalse, driftCondition.Status)
+	assert.Empty(t, recorder.Events)
+	assert.Contains(t, dm.Spec.Tags, requiredTag)
+}
+
+func getDriftCondition(status *datadoghqv1alpha1.DatadogMonitorStatus) *datadoghqv1alpha1.DatadogMonitorCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == datadoghqv1alpha1.DatadogMonitorConditionTypeDrifted {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
@@ -52,6 +52,11 @@ func (r *Reconciler) handleFinalizer(logger logr.Logger, dm *datadoghqv1alpha1.D
 }
 
 func (r *Reconciler) finalizeDatadogMonitor(logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor) {
+	if driftPolicy(dm) == datadoghqv1alpha1.DatadogDriftPolicyObserve {
+		logger.Info("Not deleting the monitor of an observed DatadogMonitor", "Monitor ID", fmt.Sprint(dm.Status.ID))
+
+		return
+	}
 	if dm.Status.Primary {
 		err := deleteMonitor(r.datadogAuth, r.datadogClient, dm.Status.ID)
 		if err != nil {
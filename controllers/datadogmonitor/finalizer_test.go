@@ -69,6 +69,29 @@ func Test_handleFinalizer(t *testing.T) {
 			},
 			finalizerShouldExist: false,
 		},
+		{
+			name: "an observed DatadogMonitor is deleted without deleting its monitor",
+			dm: &datadoghqv1alpha1.DatadogMonitor{
+				TypeMeta: metav1.TypeMeta{
+					Kind: "DatadogMonitor",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              "observed monitor",
+					DeletionTimestamp: &metaNow,
+					Finalizers:        []string{datadogMonitorFinalizer},
+				},
+				Spec: datadoghqv1alpha1.DatadogMonitorSpec{
+					ControllerOptions: datadoghqv1alpha1.DatadogMonitorControllerOptions{
+						DriftPolicy: datadoghqv1alpha1.DatadogDriftPolicyObserve,
+					},
+				},
+				Status: datadoghqv1alpha1.DatadogMonitorStatus{
+					ID:      12345,
+					Primary: true,
+				},
+			},
+			finalizerShouldExist: false,
+		},
 	}
 
 	for _, test := range testCases {
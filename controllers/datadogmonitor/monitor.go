@@ -7,6 +7,7 @@ package datadogmonitor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -145,6 +146,62 @@ func buildMonitor(logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor) (*da
 		o.SetTimeoutH(*options.TimeoutH)
 	}
 
+	if options.EnableSamples != nil {
+		o.SetEnableSamples(*options.EnableSamples)
+	}
+
+	if options.GroupRetentionDuration != nil {
+		o.SetGroupRetentionDuration(*options.GroupRetentionDuration)
+	}
+
+	if options.GroupbySimpleMonitor != nil {
+		o.SetGroupbySimpleMonitor(*options.GroupbySimpleMonitor)
+	}
+
+	if options.MinFailureDuration != nil {
+		o.SetMinFailureDuration(*options.MinFailureDuration)
+	}
+
+	if options.MinLocationFailed != nil {
+		o.SetMinLocationFailed(*options.MinLocationFailed)
+	}
+
+	if options.NewHostDelay != nil {
+		o.SetNewHostDelay(*options.NewHostDelay)
+	}
+
+	if options.NotificationPresetName != nil {
+		o.SetNotificationPresetName(datadogV1.MonitorOptionsNotificationPresets(*options.NotificationPresetName))
+	}
+
+	if len(options.NotifyBy) > 0 {
+		o.SetNotifyBy(options.NotifyBy)
+	}
+
+	if options.OnMissingData != nil {
+		o.SetOnMissingData(datadogV1.OnMissingDataOption(*options.OnMissingData))
+	}
+
+	if options.RenotifyOccurrences != nil {
+		o.SetRenotifyOccurrences(*options.RenotifyOccurrences)
+	}
+
+	if len(options.RenotifyStatuses) > 0 {
+		statuses := make([]datadogV1.MonitorRenotifyStatusType, 0, len(options.RenotifyStatuses))
+		for _, status := range options.RenotifyStatuses {
+			statuses = append(statuses, datadogV1.MonitorRenotifyStatusType(status))
+		}
+		o.SetRenotifyStatuses(statuses)
+	}
+
+	if options.SchedulingOptions != nil {
+		o.SetSchedulingOptions(buildSchedulingOptions(options.SchedulingOptions))
+	}
+
+	if len(options.Variables) > 0 {
+		o.SetVariables(buildVariables(options.Variables))
+	}
+
 	m := datadogV1.NewMonitor(query, monitorType)
 	{
 		m.SetName(name)
@@ -172,6 +229,267 @@ func buildMonitor(logger logr.Logger, dm *datadoghqv1alpha1.DatadogMonitor) (*da
 	return m, u
 }
 
+// BuildDatadogMonitorSpec is the inverse of buildMonitor: it returns the DatadogMonitorSpec equivalent to a monitor.
+// It is used to import existing monitors into DatadogMonitors. The returned spec references the monitor values.
+func BuildDatadogMonitorSpec(m datadogV1.Monitor) datadoghqv1alpha1.DatadogMonitorSpec {
+	spec := datadoghqv1alpha1.DatadogMonitorSpec{
+		Name:            m.GetName(),
+		Message:         m.GetMessage(),
+		Priority:        m.GetPriority(),
+		Query:           m.GetQuery(),
+		RestrictedRoles: m.GetRestrictedRoles(),
+		Tags:            m.GetTags(),
+		Type:            datadoghqv1alpha1.DatadogMonitorType(m.GetType()),
+	}
+
+	o, ok := m.GetOptionsOk()
+	if !ok {
+		return spec
+	}
+	options := &spec.Options
+
+	if t, ok := o.GetThresholdsOk(); ok {
+		thresholds := datadoghqv1alpha1.DatadogMonitorOptionsThresholds{
+			OK:               formatThreshold(t.GetOkOk()),
+			Warning:          formatThreshold(t.GetWarningOk()),
+			Unknown:          formatThreshold(t.GetUnknownOk()),
+			Critical:         formatThreshold(t.GetCriticalOk()),
+			WarningRecovery:  formatThreshold(t.GetWarningRecoveryOk()),
+			CriticalRecovery: formatThreshold(t.GetCriticalRecoveryOk()),
+		}
+		if thresholds != (datadoghqv1alpha1.DatadogMonitorOptionsThresholds{}) {
+			options.Thresholds = &thresholds
+		}
+	}
+
+	if w, ok := o.GetThresholdWindowsOk(); ok {
+		thresholdWindows := datadoghqv1alpha1.DatadogMonitorOptionsThresholdWindows{}
+		thresholdWindows.RecoveryWindow, _ = w.GetRecoveryWindowOk()
+		thresholdWindows.TriggerWindow, _ = w.GetTriggerWindowOk()
+		if thresholdWindows != (datadoghqv1alpha1.DatadogMonitorOptionsThresholdWindows{}) {
+			options.ThresholdWindows = &thresholdWindows
+		}
+	}
+
+	options.EscalationMessage, _ = o.GetEscalationMessageOk()
+	options.EvaluationDelay, _ = o.GetEvaluationDelayOk()
+	options.IncludeTags, _ = o.GetIncludeTagsOk()
+	options.Locked, _ = o.GetLockedOk()
+	options.NewGroupDelay, _ = o.GetNewGroupDelayOk()
+	options.EnableLogsSample, _ = o.GetEnableLogsSampleOk()
+	options.NoDataTimeframe, _ = o.GetNoDataTimeframeOk()
+	options.NotifyAudit, _ = o.GetNotifyAuditOk()
+	options.NotifyNoData, _ = o.GetNotifyNoDataOk()
+	options.RequireFullWindow, _ = o.GetRequireFullWindowOk()
+	options.RenotifyInterval, _ = o.GetRenotifyIntervalOk()
+	options.TimeoutH, _ = o.GetTimeoutHOk()
+	options.EnableSamples, _ = o.GetEnableSamplesOk()
+	options.GroupRetentionDuration, _ = o.GetGroupRetentionDurationOk()
+	options.GroupbySimpleMonitor, _ = o.GetGroupbySimpleMonitorOk()
+	options.MinFailureDuration, _ = o.GetMinFailureDurationOk()
+	options.MinLocationFailed, _ = o.GetMinLocationFailedOk()
+	options.NewHostDelay, _ = o.GetNewHostDelayOk()
+	options.NotifyBy = o.GetNotifyBy()
+	options.RenotifyOccurrences, _ = o.GetRenotifyOccurrencesOk()
+
+	if preset, ok := o.GetNotificationPresetNameOk(); ok {
+		notificationPreset := datadoghqv1alpha1.DatadogMonitorNotificationPreset(*preset)
+		options.NotificationPresetName = &notificationPreset
+	}
+
+	if onMissingData, ok := o.GetOnMissingDataOk(); ok {
+		missingData := datadoghqv1alpha1.DatadogMonitorOnMissingData(*onMissingData)
+		options.OnMissingData = &missingData
+	}
+
+	for _, status := range o.GetRenotifyStatuses() {
+		options.RenotifyStatuses = append(options.RenotifyStatuses, datadoghqv1alpha1.DatadogMonitorRenotifyStatus(status))
+	}
+
+	if s, ok := o.GetSchedulingOptionsOk(); ok {
+		options.SchedulingOptions = buildDatadogSchedulingOptions(*s)
+	}
+
+	for _, variable := range o.GetVariables() {
+		if variable.MonitorFormulaAndFunctionEventQueryDefinition != nil {
+			options.Variables = append(options.Variables, buildDatadogVariable(*variable.MonitorFormulaAndFunctionEventQueryDefinition))
+		}
+	}
+
+	return spec
+}
+
+// customScheduleProperty is the scheduling options field of the custom schedule. It isn't modeled by the
+// API client yet, so it's sent and read as an additional property.
+const customScheduleProperty = "custom_schedule"
+
+type customSchedule struct {
+	Recurrences []customScheduleRecurrence `json:"recurrences"`
+}
+
+type customScheduleRecurrence struct {
+	RRule    string  `json:"rrule"`
+	Start    *string `json:"start,omitempty"`
+	Timezone string  `json:"timezone"`
+}
+
+func buildSchedulingOptions(options *datadoghqv1alpha1.DatadogMonitorOptionsSchedulingOptions) datadogV1.MonitorOptionsSchedulingOptions {
+	s := datadogV1.MonitorOptionsSchedulingOptions{}
+
+	if w := options.EvaluationWindow; w != nil {
+		window := datadogV1.MonitorOptionsSchedulingOptionsEvaluationWindow{}
+		if w.DayStarts != nil {
+			window.SetDayStarts(*w.DayStarts)
+		}
+		if w.HourStarts != nil {
+			window.SetHourStarts(*w.HourStarts)
+		}
+		if w.MonthStarts != nil {
+			window.SetMonthStarts(*w.MonthStarts)
+		}
+		s.SetEvaluationWindow(window)
+	}
+
+	if options.CustomSchedule != nil {
+		schedule := customSchedule{Recurrences: make([]customScheduleRecurrence, 0, len(options.CustomSchedule.Recurrences))}
+		for _, r := range options.CustomSchedule.Recurrences {
+			schedule.Recurrences = append(schedule.Recurrences, customScheduleRecurrence{
+				RRule:    r.RRule,
+				Start:    r.Start,
+				Timezone: r.Timezone,
+			})
+		}
+		s.AdditionalProperties = map[string]interface{}{customScheduleProperty: schedule}
+	}
+
+	return s
+}
+
+func buildDatadogSchedulingOptions(s datadogV1.MonitorOptionsSchedulingOptions) *datadoghqv1alpha1.DatadogMonitorOptionsSchedulingOptions {
+	options := &datadoghqv1alpha1.DatadogMonitorOptionsSchedulingOptions{}
+
+	if w, ok := s.GetEvaluationWindowOk(); ok {
+		window := datadoghqv1alpha1.DatadogMonitorOptionsSchedulingOptionsEvaluationWindow{}
+		window.DayStarts, _ = w.GetDayStartsOk()
+		window.HourStarts, _ = w.GetHourStartsOk()
+		window.MonthStarts, _ = w.GetMonthStartsOk()
+		options.EvaluationWindow = &window
+	}
+
+	if raw, ok := s.AdditionalProperties[customScheduleProperty]; ok {
+		// The custom schedule is either the value built by buildSchedulingOptions or the raw JSON object
+		// returned by the API: round-trip it through JSON to handle both.
+		schedule := customSchedule{}
+		if b, err := json.Marshal(raw); err == nil && json.Unmarshal(b, &schedule) == nil {
+			options.CustomSchedule = &datadoghqv1alpha1.DatadogMonitorOptionsCustomSchedule{
+				Recurrences: make([]datadoghqv1alpha1.DatadogMonitorOptionsCustomScheduleRecurrence, 0, len(schedule.Recurrences)),
+			}
+			for _, r := range schedule.Recurrences {
+				options.CustomSchedule.Recurrences = append(options.CustomSchedule.Recurrences, datadoghqv1alpha1.DatadogMonitorOptionsCustomScheduleRecurrence{
+					RRule:    r.RRule,
+					Start:    r.Start,
+					Timezone: r.Timezone,
+				})
+			}
+		}
+	}
+
+	if options.EvaluationWindow == nil && options.CustomSchedule == nil {
+		return nil
+	}
+
+	return options
+}
+
+func buildVariables(variables []datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinition) []datadogV1.MonitorFormulaAndFunctionQueryDefinition {
+	queries := make([]datadogV1.MonitorFormulaAndFunctionQueryDefinition, 0, len(variables))
+	for _, v := range variables {
+		compute := datadogV1.NewMonitorFormulaAndFunctionEventQueryDefinitionCompute(datadogV1.MonitorFormulaAndFunctionEventAggregation(v.Compute.Aggregation))
+		if v.Compute.Interval != nil {
+			compute.SetInterval(*v.Compute.Interval)
+		}
+		if v.Compute.Metric != nil {
+			compute.SetMetric(*v.Compute.Metric)
+		}
+
+		query := datadogV1.NewMonitorFormulaAndFunctionEventQueryDefinition(*compute, datadogV1.MonitorFormulaAndFunctionEventsDataSource(v.DataSource), v.Name)
+		if v.Search != nil {
+			query.SetSearch(*datadogV1.NewMonitorFormulaAndFunctionEventQueryDefinitionSearch(v.Search.Query))
+		}
+		if len(v.Indexes) > 0 {
+			query.SetIndexes(v.Indexes)
+		}
+		if len(v.GroupBy) > 0 {
+			groupBys := make([]datadogV1.MonitorFormulaAndFunctionEventQueryGroupBy, 0, len(v.GroupBy))
+			for _, g := range v.GroupBy {
+				groupBy := datadogV1.NewMonitorFormulaAndFunctionEventQueryGroupBy(g.Facet)
+				if g.Limit != nil {
+					groupBy.SetLimit(*g.Limit)
+				}
+				if g.Sort != nil {
+					groupBySort := datadogV1.NewMonitorFormulaAndFunctionEventQueryGroupBySort(datadogV1.MonitorFormulaAndFunctionEventAggregation(g.Sort.Aggregation))
+					if g.Sort.Metric != nil {
+						groupBySort.SetMetric(*g.Sort.Metric)
+					}
+					if g.Sort.Order != nil {
+						groupBySort.SetOrder(datadogV1.QuerySortOrder(*g.Sort.Order))
+					}
+					groupBy.SetSort(*groupBySort)
+				}
+				groupBys = append(groupBys, *groupBy)
+			}
+			query.SetGroupBy(groupBys)
+		}
+
+		queries = append(queries, datadogV1.MonitorFormulaAndFunctionEventQueryDefinitionAsMonitorFormulaAndFunctionQueryDefinition(query))
+	}
+
+	return queries
+}
+
+func buildDatadogVariable(q datadogV1.MonitorFormulaAndFunctionEventQueryDefinition) datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinition {
+	variable := datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinition{
+		Name:       q.GetName(),
+		DataSource: string(q.GetDataSource()),
+		Indexes:    q.GetIndexes(),
+	}
+
+	compute := q.GetCompute()
+	variable.Compute.Aggregation = string(compute.GetAggregation())
+	variable.Compute.Interval, _ = compute.GetIntervalOk()
+	variable.Compute.Metric, _ = compute.GetMetricOk()
+
+	if search, ok := q.GetSearchOk(); ok {
+		variable.Search = &datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch{Query: search.GetQuery()}
+	}
+
+	for _, g := range q.GetGroupBy() {
+		groupBy := datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBy{Facet: g.GetFacet()}
+		groupBy.Limit, _ = g.GetLimitOk()
+		if s, ok := g.GetSortOk(); ok {
+			groupBy.Sort = &datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBySort{
+				Aggregation: string(s.GetAggregation()),
+			}
+			groupBy.Sort.Metric, _ = s.GetMetricOk()
+			if order, ok := s.GetOrderOk(); ok {
+				o := string(*order)
+				groupBy.Sort.Order = &o
+			}
+		}
+		variable.GroupBy = append(variable.GroupBy, groupBy)
+	}
+
+	return variable
+}
+
+func formatThreshold(t *float64, ok bool) *string {
+	if !ok || t == nil {
+		return nil
+	}
+	formatted := strconv.FormatFloat(*t, 'f', -1, 64)
+	return &formatted
+}
+
 func getMonitor(auth context.Context, client *datadogV1.MonitorsApi, monitorID int) (datadogV1.Monitor, error) {
 	groupStates := "all"
 	optionalParams := datadogV1.GetMonitorOptionalParameters{
@@ -185,6 +503,15 @@ func getMonitor(auth context.Context, client *datadogV1.MonitorsApi, monitorID i
 	return m, nil
 }
 
+func listMonitorsByName(auth context.Context, client *datadogV1.MonitorsApi, name string) ([]datadogV1.Monitor, error) {
+	monitors, _, err := client.ListMonitors(auth, *datadogV1.NewListMonitorsOptionalParameters().WithName(name))
+	if err != nil {
+		return nil, translateClientError(err, "error listing monitors")
+	}
+
+	return monitors, nil
+}
+
 func validateMonitor(auth context.Context, logger logr.Logger, client *datadogV1.MonitorsApi, dm *datadoghqv1alpha1.DatadogMonitor) error {
 	m, _ := buildMonitor(logger, dm)
 	if _, _, err := client.ValidateMonitor(auth, *m); err != nil {
@@ -7,6 +7,7 @@ package datadogmonitor
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
@@ -137,6 +138,149 @@ func Test_buildMonitor(t *testing.T) {
 	assert.Equal(t, "kube_namespace:test", (monitorUR.GetTags())[2], "tags are not properly sorted")
 }
 
+func Test_buildMonitorOptions(t *testing.T) {
+	valTrue := true
+	groupRetentionDuration := "2d"
+	renotifyOccurrences := int64(3)
+	onMissingData := datadoghqv1alpha1.DatadogMonitorOnMissingDataShowAndNotifyNoData
+	preset := datadoghqv1alpha1.DatadogMonitorNotificationPresetHideHandles
+	dayStarts := "04:00"
+	monthStarts := int32(1)
+	metric := "@duration"
+	order := "desc"
+	limit := int64(10)
+
+	dm := genericDatadogMonitor()
+	dm.Spec.Query = "formula(\"query1\").last(\"1d\") > 100"
+	dm.Spec.Options = datadoghqv1alpha1.DatadogMonitorOptions{
+		EnableSamples:          &valTrue,
+		GroupRetentionDuration: &groupRetentionDuration,
+		GroupbySimpleMonitor:   &valTrue,
+		NotificationPresetName: &preset,
+		NotifyBy:               []string{"cluster"},
+		OnMissingData:          &onMissingData,
+		RenotifyOccurrences:    &renotifyOccurrences,
+		RenotifyStatuses:       []datadoghqv1alpha1.DatadogMonitorRenotifyStatus{"alert", "no data"},
+		SchedulingOptions: &datadoghqv1alpha1.DatadogMonitorOptionsSchedulingOptions{
+			EvaluationWindow: &datadoghqv1alpha1.DatadogMonitorOptionsSchedulingOptionsEvaluationWindow{
+				DayStarts:   &dayStarts,
+				MonthStarts: &monthStarts,
+			},
+			CustomSchedule: &datadoghqv1alpha1.DatadogMonitorOptionsCustomSchedule{
+				Recurrences: []datadoghqv1alpha1.DatadogMonitorOptionsCustomScheduleRecurrence{
+					{RRule: "FREQ=DAILY;INTERVAL=1", Timezone: "America/New_York"},
+				},
+			},
+		},
+		Variables: []datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinition{
+			{
+				Name:       "query1",
+				DataSource: "logs",
+				Compute: datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionCompute{
+					Aggregation: "avg",
+					Metric:      &metric,
+				},
+				Search:  &datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryDefinitionSearch{Query: "service:foo"},
+				Indexes: []string{"main"},
+				GroupBy: []datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBy{
+					{
+						Facet: "@http.status_code",
+						Limit: &limit,
+						Sort: &datadoghqv1alpha1.DatadogMonitorFormulaAndFunctionEventQueryGroupBySort{
+							Aggregation: "avg",
+							Metric:      &metric,
+							Order:       &order,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	monitor, monitorUR := buildMonitor(testLogger, dm)
+	for _, o := range []*datadogV1.MonitorOptions{monitor.Options, monitorUR.Options} {
+		assert.True(t, o.GetEnableSamples())
+		assert.Equal(t, groupRetentionDuration, o.GetGroupRetentionDuration())
+		assert.True(t, o.GetGroupbySimpleMonitor())
+		assert.Equal(t, datadogV1.MONITOROPTIONSNOTIFICATIONPRESETS_HIDE_HANDLES, o.GetNotificationPresetName())
+		assert.Equal(t, []string{"cluster"}, o.GetNotifyBy())
+		assert.Equal(t, datadogV1.ONMISSINGDATAOPTION_SHOW_AND_NOTIFY_NO_DATA, o.GetOnMissingData())
+		assert.Equal(t, renotifyOccurrences, o.GetRenotifyOccurrences())
+		assert.Equal(t, []datadogV1.MonitorRenotifyStatusType{datadogV1.MONITORRENOTIFYSTATUSTYPE_ALERT, datadogV1.MONITORRENOTIFYSTATUSTYPE_NO_DATA}, o.GetRenotifyStatuses())
+
+		schedulingOptions := o.GetSchedulingOptions()
+		window := schedulingOptions.GetEvaluationWindow()
+		assert.Equal(t, dayStarts, window.GetDayStarts())
+		assert.Equal(t, monthStarts, window.GetMonthStarts())
+		assert.False(t, window.HasHourStarts())
+
+		variables := o.GetVariables()
+		assert.Len(t, variables, 1)
+		query := variables[0].MonitorFormulaAndFunctionEventQueryDefinition
+		assert.Equal(t, "query1", query.GetName())
+		assert.Equal(t, datadogV1.MONITORFORMULAANDFUNCTIONEVENTSDATASOURCE_LOGS, query.GetDataSource())
+		assert.Equal(t, []string{"main"}, query.GetIndexes())
+		assert.Equal(t, "service:foo", query.Search.GetQuery())
+		assert.Equal(t, "@http.status_code", query.GetGroupBy()[0].GetFacet())
+	}
+
+	// The custom schedule isn't modeled by the API client, it's sent as an additional property
+	payload, err := json.Marshal(monitor.Options.SchedulingOptions)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"evaluation_window": {"day_starts": "04:00", "month_starts": 1},
+		"custom_schedule": {"recurrences": [{"rrule": "FREQ=DAILY;INTERVAL=1", "timezone": "America/New_York"}]}
+	}`, string(payload))
+
+	// The options are converted back once the monitor is returned by the API
+	apiMonitor := datadogV1.Monitor{}
+	payload, err = json.Marshal(monitor)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(payload, &apiMonitor))
+	assert.Equal(t, dm.Spec.Options, BuildDatadogMonitorSpec(apiMonitor).Options)
+}
+
+func Test_BuildDatadogMonitorSpec(t *testing.T) {
+	evalDelay := int64(100)
+	valTrue := true
+	renotifyInterval := int64(1440)
+	critThreshold := "0.05"
+	warnThreshold := "0.02"
+	triggerWindow := "last_15m"
+
+	dm := &datadoghqv1alpha1.DatadogMonitor{
+		Spec: datadoghqv1alpha1.DatadogMonitorSpec{
+			Query:    "avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.05",
+			Type:     "metric alert",
+			Name:     "Test monitor",
+			Message:  "Something went wrong",
+			Priority: 3,
+			Tags:     []string{"env:staging", "kube_namespace:test"},
+			Options: datadoghqv1alpha1.DatadogMonitorOptions{
+				EvaluationDelay:  &evalDelay,
+				IncludeTags:      &valTrue,
+				RenotifyInterval: &renotifyInterval,
+				Thresholds: &datadoghqv1alpha1.DatadogMonitorOptionsThresholds{
+					Critical: &critThreshold,
+					Warning:  &warnThreshold,
+				},
+				ThresholdWindows: &datadoghqv1alpha1.DatadogMonitorOptionsThresholdWindows{
+					TriggerWindow: &triggerWindow,
+				},
+			},
+		},
+	}
+
+	monitor, _ := buildMonitor(testLogger, dm)
+	assert.Equal(t, dm.Spec, BuildDatadogMonitorSpec(*monitor))
+
+	// Monitors without options
+	monitor = datadogV1.NewMonitor("avg(last_10m):avg:system.disk.in_use{*} by {host} > 0.05", datadogV1.MONITORTYPE_METRIC_ALERT)
+	spec := BuildDatadogMonitorSpec(*monitor)
+	assert.Equal(t, datadoghqv1alpha1.DatadogMonitorOptions{}, spec.Options)
+	assert.Equal(t, datadoghqv1alpha1.DatadogMonitorTypeMetric, spec.Type)
+}
+
 func Test_getMonitor(t *testing.T) {
 	mID := 12345
 	expectedMonitor := genericMonitor(mID)
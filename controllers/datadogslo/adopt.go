@@ -0,0 +1,57 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslo
+
+import (
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+// sloToAdopt returns the ID of the existing SLO the DatadogSLO should adopt, or an empty string if a new SLO
+// should be created. An SLO is only adopted by a DatadogSLO that doesn't manage one yet.
+func sloToAdopt(instance *v1alpha1.DatadogSLO) string {
+	if instance.Status.ID != "" || instance.Spec.ControllerOptions == nil || instance.Spec.ControllerOptions.AdoptSLOID == nil {
+		return ""
+	}
+	return *instance.Spec.ControllerOptions.AdoptSLOID
+}
+
+// adopt takes over an existing SLO: the SLO is overwritten with the DatadogSLO spec, unless the drift
+// policy is observe, and then managed as if it had been created by the controller.
+func (r *Reconciler) adopt(logger logr.Logger, instance *v1alpha1.DatadogSLO, status *v1alpha1.DatadogSLOStatus, now metav1.Time, hash string, sloID string) error {
+	slo, err := getSLO(r.datadogAuth, r.datadogClient, sloID)
+	if err != nil {
+		logger.Error(err, "error getting SLO to adopt", "SLO ID", sloID)
+		updateErrStatus(status, now, v1alpha1.DatadogSLOSyncStatusCreateError, "AdoptingSLO", err)
+		return err
+	}
+
+	adopted := instance.DeepCopy()
+	adopted.Status.ID = sloID
+	if driftPolicy(instance) == v1alpha1.DatadogDriftPolicyObserve {
+		// The SLO is only observed: report its differences with the spec instead of overwriting it
+		if err = r.checkDrift(logger, adopted, slo, status, now, hash); err != nil {
+			return err
+		}
+	} else if err = r.update(logger, adopted, status, now, hash); err != nil {
+		return err
+	}
+
+	status.ID = sloID
+	creator := slo.GetCreator()
+	status.Creator = creator.GetEmail()
+	createdTime := metav1.Unix(slo.GetCreatedAt(), 0)
+	status.Created = &createdTime
+	status.LastForceSyncTime = &now
+
+	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeCreated, metav1.ConditionTrue, "AdoptingSLO", "DatadogSLO adopted an existing SLO")
+	logger.Info("Adopted an existing SLO", "SLO ID", sloID)
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+func Test_sloToAdopt(t *testing.T) {
+	slo := defaultSLO()
+	assert.Equal(t, "", sloToAdopt(slo))
+
+	slo.Spec.ControllerOptions = &v1alpha1.DatadogSLOControllerOptions{AdoptSLOID: apiutils.NewStringPointer("SLO123")}
+	assert.Equal(t, "SLO123", sloToAdopt(slo))
+
+	// The DatadogSLO already manages an SLO
+	slo.Status.ID = "SLO456"
+	assert.Equal(t, "", sloToAdopt(slo))
+}
+
+func Test_adopt(t *testing.T) {
+	tests := []struct {
+		name          string
+		driftPolicy   v1alpha1.DatadogDriftPolicy
+		wantUpdate    bool
+		wantCondition condition.Type
+	}{
+		{
+			name:          "adopt and overwrite the SLO",
+			driftPolicy:   v1alpha1.DatadogDriftPolicyEnforce,
+			wantUpdate:    true,
+			wantCondition: condition.DatadogConditionTypeUpdated,
+		},
+		{
+			name:          "adopt and observe the SLO",
+			driftPolicy:   v1alpha1.DatadogDriftPolicyObserve,
+			wantCondition: condition.DatadogConditionTypeDrifted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodPut {
+					updated = true
+					_ = json.NewEncoder(w).Encode(defaultDatadogSLOResponse())
+					return
+				}
+				_ = json.NewEncoder(w).Encode(adoptedSLOResponse())
+			}))
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			r := &Reconciler{
+				datadogClient: datadogV1.NewServiceLevelObjectivesApi(datadogapi.NewAPIClient(testConfig)),
+				datadogAuth:   setupTestAuth(httpServer.URL),
+				recorder:      record.NewFakeRecorder(5),
+				log:           zap.New(zap.UseDevMode(true)),
+			}
+
+			slo := defaultSLO()
+			slo.Spec.ControllerOptions = &v1alpha1.DatadogSLOControllerOptions{
+				AdoptSLOID:  apiutils.NewStringPointer("SLO123"),
+				DriftPolicy: tt.driftPolicy,
+			}
+			status := &v1alpha1.DatadogSLOStatus{}
+			now := metav1.NewTime(time.Now())
+
+			assert.NoError(t, r.adopt(r.log, slo, status, now, "hash", "SLO123"))
+			assert.Equal(t, tt.wantUpdate, updated)
+			assert.Equal(t, "SLO123", status.ID)
+			assert.Equal(t, "email@example.com", status.Creator)
+			assert.Equal(t, "hash", status.CurrentHash)
+			assert.NotNil(t, meta.FindStatusCondition(status.Conditions, string(condition.DatadogConditionTypeCreated)))
+			assert.NotNil(t, meta.FindStatusCondition(status.Conditions, string(tt.wantCondition)))
+		})
+	}
+}
+
+func adoptedSLOResponse() datadogV1.SLOResponse {
+	listed := defaultDatadogSLOResponse().Data[0]
+	sloType := datadogV1.SLOTYPE_METRIC
+	return datadogV1.SLOResponse{
+		Data: &datadogV1.SLOResponseData{
+			CreatedAt:  listed.CreatedAt,
+			Creator:    listed.Creator,
+			Id:         listed.Id,
+			Name:       &listed.Name,
+			Query:      listed.Query,
+			Tags:       listed.Tags,
+			Thresholds: listed.Thresholds,
+			Type:       &sloType,
+		},
+	}
+}
@@ -7,6 +7,7 @@ package datadogslo
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -40,11 +42,21 @@ const (
 	defaultRequeuePeriod    = 60 * time.Second
 	defaultErrRequeuePeriod = 5 * time.Second
 	defaultForceSyncPeriod  = 60 * time.Minute
-	datadogSLOKind          = "DatadogSLO"
-	datadogSLOFinalizer     = "finalizer.slo.datadoghq.com"
+	// defaultStateRefreshPeriod is the default period between two refreshes of the SLI value and error budget
+	defaultStateRefreshPeriod = 5 * time.Minute
+	datadogSLOKind            = "DatadogSLO"
+	datadogSLOFinalizer       = "finalizer.slo.datadoghq.com"
 )
 
+// ReconcilerOptions provides options read from command line
+type ReconcilerOptions struct {
+	// StateRefreshPeriod is the period between two refreshes of the SLO state (SLI value and error budget).
+	// defaultStateRefreshPeriod is used if not set.
+	StateRefreshPeriod time.Duration
+}
+
 type Reconciler struct {
+	options       ReconcilerOptions
 	client        client.Client
 	datadogClient *datadogV1.ServiceLevelObjectivesApi
 	datadogAuth   context.Context
@@ -53,8 +65,9 @@ type Reconciler struct {
 	recorder      record.EventRecorder
 }
 
-func NewReconciler(client client.Client, ddClient datadogclient.DatadogSLOClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+func NewReconciler(options ReconcilerOptions, client client.Client, ddClient datadogclient.DatadogSLOClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder) *Reconciler {
 	return &Reconciler{
+		options:       options,
 		client:        client,
 		datadogClient: ddClient.Client,
 		datadogAuth:   ddClient.Auth,
@@ -115,31 +128,47 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 		return r.updateStatusIfNeeded(logger, instance, status, result)
 	}
 
+	policy := driftPolicy(instance)
 	shouldCreate := false
 	shouldUpdate := false
+	shouldCheckDrift := false
+	var slo *datadogV1.SLOResponseData
 
 	if instance.Status.ID == "" {
 		shouldCreate = true
 	} else {
-		if instanceSpecHash != statusSpecHash {
+		if instanceSpecHash != statusSpecHash && policy != v1alpha1.DatadogDriftPolicyObserve {
 			shouldUpdate = true
-		} else if instance.Status.LastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(instance.Status.LastForceSyncTime.Time)) <= 0 {
-			// Periodically force a sync with the API SLO to ensure parity
+		} else if instanceSpecHash != statusSpecHash || instance.Status.LastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(instance.Status.LastForceSyncTime.Time)) <= 0 {
+			// Periodically force a sync with the API SLO to ensure parity, or only compare them depending on the drift policy
 			// Get SLO to make sure it exists before trying any updates. If it doesn't, set shouldCreate
-			_, err = r.get(instance)
+			slo, err = r.get(instance)
 			if err != nil {
 				logger.Error(err, "error getting SLO", "SLO ID", instance.Status.ID)
 				if strings.Contains(err.Error(), ctrutils.NotFoundString) {
 					shouldCreate = true
 				}
-			} else {
+			} else if policy == v1alpha1.DatadogDriftPolicyEnforce {
 				shouldUpdate = true
+			} else {
+				shouldCheckDrift = true
 			}
 			status.LastForceSyncTime = &now
 		}
 	}
 
-	if shouldCreate {
+	adoptID := sloToAdopt(instance)
+	if shouldCreate && adoptID != "" {
+		err = r.adopt(logger, instance, status, now, instanceSpecHash, adoptID)
+		if err != nil {
+			result.RequeueAfter = defaultErrRequeuePeriod
+		}
+	} else if shouldCreate && policy == v1alpha1.DatadogDriftPolicyObserve {
+		err = fmt.Errorf("SLOs are not created with the %s drift policy, set spec.controllerOptions.adoptSLOID to observe an existing SLO", policy)
+		logger.Error(err, "error creating SLO")
+		updateErrStatus(status, now, v1alpha1.DatadogSLOSyncStatusCreateError, "CreatingSLO", err)
+		result.RequeueAfter = defaultErrRequeuePeriod
+	} else if shouldCreate {
 		// Check that required tags are present
 		if result, err = r.checkRequiredTags(logger, instance); err != nil || result.Requeue {
 			return r.updateStatusIfNeeded(logger, instance, status, result)
@@ -148,6 +177,11 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 		if err != nil {
 			result.RequeueAfter = defaultErrRequeuePeriod
 		}
+	} else if shouldCheckDrift {
+		err = r.checkDrift(logger, instance, slo, status, now, instanceSpecHash)
+		if err != nil {
+			result.RequeueAfter = defaultErrRequeuePeriod
+		}
 	} else if shouldUpdate {
 		// Check that required tags are present
 		if result, err = r.checkRequiredTags(logger, instance); err != nil || result.Requeue {
@@ -159,6 +193,11 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 		}
 	}
 
+	// Periodically refresh the SLI value and the error budget
+	if err == nil && status.ID != "" && (status.StateLastUpdateTime == nil || (r.stateRefreshPeriod()-now.Sub(status.StateLastUpdateTime.Time)) <= 0) {
+		r.updateSLOState(logger, instance, status, now)
+	}
+
 	// If reconcile was successful, requeue with period defaultRequeuePeriod
 	if !result.Requeue && result.RequeueAfter == 0 {
 		result.RequeueAfter = defaultRequeuePeriod
@@ -167,6 +206,13 @@ func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Reques
 	return r.updateStatusIfNeeded(logger, instance, status, result)
 }
 
+func (r *Reconciler) stateRefreshPeriod() time.Duration {
+	if r.options.StateRefreshPeriod > 0 {
+		return r.options.StateRefreshPeriod
+	}
+	return defaultStateRefreshPeriod
+}
+
 func (r *Reconciler) checkRequiredTags(logger logr.Logger, instance *v1alpha1.DatadogSLO) (ctrl.Result, error) {
 	if instance.Spec.ControllerOptions != nil && apiutils.BoolValue(instance.Spec.ControllerOptions.DisableRequiredTags) {
 		return ctrl.Result{}, nil
@@ -193,27 +239,51 @@ func (r *Reconciler) checkRequiredTags(logger logr.Logger, instance *v1alpha1.Da
 	return ctrl.Result{}, nil
 }
 
-// TODO implement when /search endpoint has been updated
-// func updateSLOState(logger logr.Logger, instance *v1alpha1.DatadogSLO, sloHistory *datadogV1.SLOHistoryResponseData, status *v1alpha1.DatadogSLOStatus, now metav1.Time, err error) {
-// 	if err != nil {
-// 		status.SLIValue = nil
-// 		status.ErrorBudgetRemaining = nil
-// 		return
-// 	}
-// 	rawSLIVal := sloHistory.Overall.SliValue.Get()
-// 	if rawSLIVal == nil && len(sloHistory.Overall.Errors) > 0 {
-// 		logger.Info("Problem with Datadog SLO", "error message", sloHistory.Overall.Errors[0].ErrorMessage, "SLO ID", instance.Status.ID)
-// 		status.Message = fmt.Sprintf("SLO Error: %s", sloHistory.Overall.Errors[0].ErrorMessage)
-// 		return
-// 	}
-// 	sliVal := fmt.Sprintf("%.2f", *rawSLIVal)
-// 	status.SLIValue = &sliVal
-// 	// There should be only one element in map
-// 	for _, v := range sloHistory.Overall.ErrorBudgetRemaining {
-// 		ebr := fmt.Sprintf("%.2f", v)
-// 		status.ErrorBudgetRemaining = &ebr
-// 	}
-// }
+// updateSLOState fetches the SLO history over the SLO timeframe and reports the SLI value and the
+// remaining error budget in the status. The ErrorBudgetExhausted condition is set accordingly.
+func (r *Reconciler) updateSLOState(logger logr.Logger, instance *v1alpha1.DatadogSLO, status *v1alpha1.DatadogSLOStatus, now metav1.Time) {
+	sloHistory, err := getSLOHistory(r.datadogAuth, r.datadogClient, status.ID, now.Add(-timeframeDuration(instance.Spec.PrimaryTimeframe())), now.Time)
+	if err != nil {
+		logger.Error(err, "error getting SLO history", "SLO ID", status.ID)
+		condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, "GettingSLOHistory", err)
+		return
+	}
+	status.StateLastUpdateTime = &now
+
+	overall := sloHistory.GetOverall()
+	rawSLIVal, ok := overall.GetSliValueOk()
+	if !ok || rawSLIVal == nil {
+		if len(overall.Errors) > 0 {
+			logger.Info("Problem with Datadog SLO", "error message", overall.Errors[0].ErrorMessage, "SLO ID", status.ID)
+			condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, metav1.ConditionTrue, "GettingSLOHistory", fmt.Sprintf("SLO Error: %s", overall.Errors[0].ErrorMessage))
+		}
+		status.SLIValue = nil
+		status.ErrorBudgetRemaining = nil
+		return
+	}
+	sliVal := fmt.Sprintf("%.2f", *rawSLIVal)
+	status.SLIValue = &sliVal
+
+	remaining, found := overall.ErrorBudgetRemaining[string(instance.Spec.PrimaryTimeframe())]
+	if !found {
+		// The error budget is keyed by timeframe; there should be only one element in the map
+		for _, v := range overall.ErrorBudgetRemaining {
+			remaining, found = v, true
+		}
+	}
+	if !found {
+		status.ErrorBudgetRemaining = nil
+		return
+	}
+	ebr := fmt.Sprintf("%.2f", remaining)
+	status.ErrorBudgetRemaining = &ebr
+
+	if remaining <= 0 {
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeErrorBudgetExhausted, metav1.ConditionTrue, "ErrorBudgetExhausted", fmt.Sprintf("Error budget exhausted: %s%% remaining", ebr))
+	} else {
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeErrorBudgetExhausted, metav1.ConditionFalse, "ErrorBudgetRemaining", fmt.Sprintf("%s%% of the error budget remaining", ebr))
+	}
+}
 
 func updateErrStatus(status *v1alpha1.DatadogSLOStatus, now metav1.Time, syncStatus v1alpha1.DatadogSLOSyncStatus, reason string, err error) {
 	condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, reason, err)
@@ -252,7 +322,7 @@ func (r *Reconciler) create(logger logr.Logger, instance *v1alpha1.DatadogSLO, s
 	createdTime := metav1.Unix(createdSLO.GetCreatedAt(), 0)
 
 	status.SyncStatus = v1alpha1.DatadogSLOSyncStatusOK
-	status.ID = createdSLO.GetId()
+	status.ID = getSLOID(createdSLO)
 	status.Creator = creator.GetEmail()
 	status.Created = &createdTime
 	status.CurrentHash = hash
@@ -277,6 +347,10 @@ func (r *Reconciler) update(logger logr.Logger, instance *v1alpha1.DatadogSLO, s
 
 	// Set condition and status
 	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeUpdated, metav1.ConditionTrue, "UpdatingSLO", "DatadogSLO Updated")
+	if meta.FindStatusCondition(status.Conditions, string(condition.DatadogConditionTypeDrifted)) != nil {
+		// The SLO was overwritten with the spec
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeDrifted, metav1.ConditionFalse, "UpdatingSLO", "SLO matches the DatadogSLO spec")
+	}
 	status.SyncStatus = v1alpha1.DatadogSLOSyncStatusOK
 	status.CurrentHash = hash
 
@@ -286,6 +360,10 @@ func (r *Reconciler) update(logger logr.Logger, instance *v1alpha1.DatadogSLO, s
 
 func (r *Reconciler) deleteResource(logger logr.Logger, instance *v1alpha1.DatadogSLO) finalizer.ResourceDeleteFunc {
 	return func(ctx context.Context, k8sObj client.Object, datadogID string) error {
+		if driftPolicy(instance) == v1alpha1.DatadogDriftPolicyObserve {
+			logger.Info("Not deleting the SLO of an observed DatadogSLO", "ID", datadogID)
+			return nil
+		}
 		if datadogID != "" {
 			kind := k8sObj.GetObjectKind().GroupVersionKind().Kind
 			if err := deleteSLO(r.datadogAuth, r.datadogClient, datadogID); err != nil {
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -31,6 +32,8 @@ import (
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
 )
 
 const (
@@ -100,6 +103,32 @@ func TestReconciler_Reconcile(t *testing.T) {
 			}),
 			expectedResult: ctrl.Result{Requeue: false, RequeueAfter: defaultErrRequeuePeriod},
 		},
+		{
+			name: "Adopt and observe an existing SLO",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: resourceNamespace,
+					Name:      resourceName,
+				},
+			},
+			mockOn: func(t *testing.T, m *mockedFields) {
+				slo := defaultSLO()
+				slo.Spec.ControllerOptions = &v1alpha1.DatadogSLOControllerOptions{
+					AdoptSLOID:  apiutils.NewStringPointer("SLO123"),
+					DriftPolicy: v1alpha1.DatadogDriftPolicyObserve,
+				}
+				_ = m.k8sClient.Create(context.TODO(), slo)
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					http.Error(w, "observed SLOs are not modified", http.StatusBadRequest)
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_ = json.NewEncoder(w).Encode(adoptedSLOResponse())
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+		},
 		{
 			name: "Update SLO when exists",
 			request: ctrl.Request{
@@ -155,6 +184,85 @@ func TestReconciler_Reconcile(t *testing.T) {
 	}
 }
 
+func Test_updateSLOState(t *testing.T) {
+	testLogger := zap.New(zap.UseDevMode(true))
+	now := metav1.NewTime(time.Now())
+
+	tests := []struct {
+		name                 string
+		datadogClientHandler http.HandlerFunc
+		wantSLIValue         *string
+		wantErrorBudget      *string
+		wantConditionType    string
+		wantConditionStatus  metav1.ConditionStatus
+	}{
+		{
+			name:                 "Error budget remaining",
+			datadogClientHandler: sloHistoryHandler(`{"data":{"overall":{"sli_value":99.75,"error_budget_remaining":{"30d":75.0}}}}`),
+			wantSLIValue:         apiutils.NewStringPointer("99.75"),
+			wantErrorBudget:      apiutils.NewStringPointer("75.00"),
+			wantConditionType:    string(condition.DatadogConditionTypeErrorBudgetExhausted),
+			wantConditionStatus:  metav1.ConditionFalse,
+		},
+		{
+			name:                 "Error budget exhausted",
+			datadogClientHandler: sloHistoryHandler(`{"data":{"overall":{"sli_value":98.5,"error_budget_remaining":{"30d":-50.0}}}}`),
+			wantSLIValue:         apiutils.NewStringPointer("98.50"),
+			wantErrorBudget:      apiutils.NewStringPointer("-50.00"),
+			wantConditionType:    string(condition.DatadogConditionTypeErrorBudgetExhausted),
+			wantConditionStatus:  metav1.ConditionTrue,
+		},
+		{
+			name:                 "SLO history error",
+			datadogClientHandler: sloHistoryHandler(`{"data":{"overall":{"sli_value":null,"errors":[{"error_message":"no data","error_type":"query_error"}]}}}`),
+			wantConditionType:    string(condition.DatadogConditionTypeError),
+			wantConditionStatus:  metav1.ConditionTrue,
+		},
+		{
+			name: "Failed to get SLO history",
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "internal error", http.StatusInternalServerError)
+			}),
+			wantConditionType:   string(condition.DatadogConditionTypeError),
+			wantConditionStatus: metav1.ConditionTrue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(tt.datadogClientHandler)
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			r := &Reconciler{
+				datadogClient: datadogV1.NewServiceLevelObjectivesApi(apiClient),
+				datadogAuth:   setupTestAuth(httpServer.URL),
+				log:           testLogger,
+			}
+
+			instance := defaultSLO()
+			status := &v1alpha1.DatadogSLOStatus{ID: "SLO123"}
+			r.updateSLOState(testLogger, instance, status, now)
+
+			assert.Equal(t, tt.wantSLIValue, status.SLIValue)
+			assert.Equal(t, tt.wantErrorBudget, status.ErrorBudgetRemaining)
+			cond := meta.FindStatusCondition(status.Conditions, tt.wantConditionType)
+			if assert.NotNil(t, cond) {
+				assert.Equal(t, tt.wantConditionStatus, cond.Status)
+			}
+		})
+	}
+}
+
+func sloHistoryHandler(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}
+}
+
 func defaultSLO() *v1alpha1.DatadogSLO {
 	return &v1alpha1.DatadogSLO{
 		TypeMeta: metav1.TypeMeta{
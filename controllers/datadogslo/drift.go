@@ -0,0 +1,60 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslo
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+const driftDetectedReason = "DriftDetected"
+
+func driftPolicy(instance *v1alpha1.DatadogSLO) v1alpha1.DatadogDriftPolicy {
+	if instance.Spec.ControllerOptions == nil {
+		return v1alpha1.DatadogDriftPolicyEnforce
+	}
+	return instance.Spec.ControllerOptions.DriftPolicy.OrDefault()
+}
+
+// checkDrift compares the SLO in Datadog with the DatadogSLO spec and reports the differences in the Drifted
+// condition, without updating the SLO. A warning event is recorded when the differences change.
+func (r *Reconciler) checkDrift(logger logr.Logger, instance *v1alpha1.DatadogSLO, slo *datadogV1.SLOResponseData, status *v1alpha1.DatadogSLOStatus, now metav1.Time, hash string) error {
+	_, desired := buildSLO(instance)
+	drift, err := comparison.FindDrift(desired, slo)
+	if err != nil {
+		logger.Error(err, "error checking SLO drift", "SLO ID", instance.Status.ID)
+		updateErrStatus(status, now, v1alpha1.DatadogSLOSyncStatusUpdateError, "CheckingSLODrift", err)
+		return err
+	}
+
+	status.SyncStatus = v1alpha1.DatadogSLOSyncStatusOK
+	status.CurrentHash = hash
+
+	if len(drift) == 0 {
+		condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeDrifted, metav1.ConditionFalse, "NoDrift", "SLO matches the DatadogSLO spec")
+		return nil
+	}
+
+	msg := fmt.Sprintf("SLO differs from the DatadogSLO spec: %s", strings.Join(drift, ", "))
+	if previous := meta.FindStatusCondition(status.Conditions, string(condition.DatadogConditionTypeDrifted)); previous == nil || previous.Status != metav1.ConditionTrue || previous.Message != msg {
+		logger.Info("SLO drifted from the DatadogSLO spec", "SLO ID", instance.Status.ID, "fields", drift)
+		r.recorder.Event(instance, corev1.EventTypeWarning, driftDetectedReason, msg)
+	}
+	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeDrifted, metav1.ConditionTrue, driftDetectedReason, msg)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogslo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+)
+
+func Test_checkDrift(t *testing.T) {
+	recorder := record.NewFakeRecorder(5)
+	r := &Reconciler{
+		log:      zap.New(zap.UseDevMode(true)),
+		recorder: recorder,
+	}
+	now := metav1.NewTime(time.Now())
+
+	instance := defaultSLO()
+	instance.Spec.ControllerOptions = &v1alpha1.DatadogSLOControllerOptions{DriftPolicy: v1alpha1.DatadogDriftPolicyDetect}
+	instance.Status.ID = "SLO123"
+	status := instance.Status.DeepCopy()
+
+	// No drift
+	assert.NoError(t, r.checkDrift(r.log, instance, testSLOResponseData(t, "Test SLO", 99), status, now, "hash"))
+	driftCondition := meta.FindStatusCondition(status.Conditions, string(condition.DatadogConditionTypeDrifted))
+	assert.NotNil(t, driftCondition)
+	assert.Equal(t, metav1.ConditionFalse, driftCondition.Status)
+	assert.Equal(t, "hash", status.CurrentHash)
+	assert.Empty(t, recorder.Events)
+
+	// The SLO was edited in Datadog
+	assert.NoError(t, r.checkDrift(r.log, instance, testSLOResponseData(t, "Edited SLO", 99.5), status, now, "hash"))
+	driftCondition = meta.FindStatusCondition(status.Conditions, string(condition.DatadogConditionTypeDrifted))
+	assert.Equal(t, metav1.ConditionTrue, driftCondition.Status)
+	assert.Equal(t, "SLO differs from the DatadogSLO spec: name, thresholds[0].target", driftCondition.Message)
+	assert.Equal(t, "Warning DriftDetected SLO differs from the DatadogSLO spec: name, thresholds[0].target", <-recorder.Events)
+
+	// The same drift is only reported once
+	assert.NoError(t, r.checkDrift(r.log, instance, testSLOResponseData(t, "Edited SLO", 99.5), status, now, "hash"))
+	assert.Empty(t, recorder.Events)
+}
+
+func testSLOResponseData(t *testing.T, name string, target float64) *datadogV1.SLOResponseData {
+	slo := &datadogV1.SLOResponseData{}
+	assert.NoError(t, json.Unmarshal(mustMarshal(t, map[string]interface{}{
+		"id":          "SLO123",
+		"name":        name,
+		"type":        "metric",
+		"description": "",
+		"tags":        []string{},
+		"query": map[string]interface{}{
+			"numerator":   "sum:my.custom.count.metric{type:good_events}.as_count()",
+			"denominator": "sum:my.custom.count.metric{*}.as_count()",
+		},
+		"thresholds": []map[string]interface{}{
+			{"timeframe": "30d", "target": target, "target_display": "99."},
+		},
+	}), slo))
+
+	return slo
+}
@@ -7,12 +7,15 @@ package datadogslo
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
 )
@@ -39,6 +42,16 @@ func buildSLO(crdSLO *v1alpha1.DatadogSLO) (*datadogV1.ServiceLevelObjectiveRequ
 			sloReq.SetMonitorIds(crdSLO.Spec.MonitorIDs)
 			sloReq.SetGroups(crdSLO.Spec.Groups)
 		}
+		if crdSLO.Spec.Type == v1alpha1.DatadogSLOTypeTimeSlice {
+			sloReq.AdditionalProperties = buildSLISpecification(crdSLO.Spec.TimeSlice)
+		}
+		if timeframe, target, warning, ok := buildPrimaryThreshold(crdSLO.Spec); ok {
+			sloReq.SetTimeframe(timeframe)
+			sloReq.SetTargetThreshold(target)
+			if warning != nil {
+				sloReq.SetWarningThreshold(*warning)
+			}
+		}
 	}
 
 	// Used for SLO updates
@@ -60,29 +73,112 @@ func buildSLO(crdSLO *v1alpha1.DatadogSLO) (*datadogV1.ServiceLevelObjectiveRequ
 			slo.SetMonitorIds(crdSLO.Spec.MonitorIDs)
 			slo.SetGroups(crdSLO.Spec.Groups)
 		}
+		if crdSLO.Spec.Type == v1alpha1.DatadogSLOTypeTimeSlice {
+			slo.AdditionalProperties = buildSLISpecification(crdSLO.Spec.TimeSlice)
+		}
+		if timeframe, target, warning, ok := buildPrimaryThreshold(crdSLO.Spec); ok {
+			slo.SetTimeframe(timeframe)
+			slo.SetTargetThreshold(target)
+			if warning != nil {
+				slo.SetWarningThreshold(*warning)
+			}
+		}
 	}
 
 	return sloReq, slo
 }
 
 func buildThreshold(sloSpec v1alpha1.DatadogSLOSpec) []datadogV1.SLOThreshold {
+	if len(sloSpec.Thresholds) > 0 {
+		thresholds := make([]datadogV1.SLOThreshold, 0, len(sloSpec.Thresholds))
+		for _, threshold := range sloSpec.Thresholds {
+			thresholds = append(thresholds, newThreshold(threshold.Timeframe, threshold.Target, threshold.Warning))
+		}
+		return thresholds
+	}
+
 	// Convert DatadogSLOSpec Timeframe, TargetThreshold, and WarningThreshold to datadogV1.SLOThreshold
 	// (returned as a single-item list) for backwards compatibility.
+	return []datadogV1.SLOThreshold{newThreshold(sloSpec.Timeframe, sloSpec.TargetThreshold, sloSpec.WarningThreshold)}
+}
 
-	timeframe, _ := datadogV1.NewSLOTimeframeFromValue(string(sloSpec.Timeframe))
+func newThreshold(sloTimeframe v1alpha1.DatadogSLOTimeFrame, target resource.Quantity, warning *resource.Quantity) datadogV1.SLOThreshold {
+	timeframe, _ := datadogV1.NewSLOTimeframeFromValue(string(sloTimeframe))
 
 	var warningThreshold *float64
-	if sloSpec.WarningThreshold != nil {
-		approxFloat := sloSpec.WarningThreshold.AsApproximateFloat64()
+	if warning != nil {
+		approxFloat := warning.AsApproximateFloat64()
 		warningThreshold = &approxFloat
 	}
 
-	threshold := datadogV1.SLOThreshold{
-		Target:    sloSpec.TargetThreshold.AsApproximateFloat64(),
+	return datadogV1.SLOThreshold{
+		Target:    target.AsApproximateFloat64(),
 		Timeframe: *timeframe,
 		Warning:   warningThreshold,
 	}
-	return []datadogV1.SLOThreshold{threshold}
+}
+
+// buildPrimaryThreshold returns the primary threshold of an SLO defined with a list of thresholds.
+// It returns false for SLOs defined with a single threshold, for which the primary threshold is implicit.
+func buildPrimaryThreshold(sloSpec v1alpha1.DatadogSLOSpec) (datadogV1.SLOTimeframe, float64, *float64, bool) {
+	if len(sloSpec.Thresholds) == 0 {
+		return "", 0, nil, false
+	}
+
+	primary := sloSpec.PrimaryTimeframe()
+	for _, threshold := range buildThreshold(sloSpec) {
+		if string(threshold.Timeframe) == string(primary) {
+			return threshold.Timeframe, threshold.Target, threshold.Warning, true
+		}
+	}
+
+	return "", 0, nil, false
+}
+
+// buildSLISpecification returns the SLI specification of a time-slice SLO. The pinned API client
+// doesn't model time-slice SLOs, so it is sent as an additional property of the SLO payload.
+func buildSLISpecification(timeSlice *v1alpha1.DatadogSLOTimeSlice) map[string]interface{} {
+	if timeSlice == nil {
+		return nil
+	}
+
+	spec := map[string]interface{}{
+		"comparator": string(timeSlice.Comparator),
+		"threshold":  timeSlice.Threshold.AsApproximateFloat64(),
+		"query": map[string]interface{}{
+			"formulas": []interface{}{
+				map[string]interface{}{"formula": "query1"},
+			},
+			"queries": []interface{}{
+				map[string]interface{}{
+					"data_source": "metrics",
+					"name":        "query1",
+					"query":       timeSlice.Query,
+				},
+			},
+		},
+	}
+	if timeSlice.QueryIntervalSeconds != nil {
+		spec["query_interval_seconds"] = *timeSlice.QueryIntervalSeconds
+	}
+
+	return map[string]interface{}{
+		"sli_specification": map[string]interface{}{
+			"time_slice": spec,
+		},
+	}
+}
+
+// getSLOID returns the ID of an SLO. SLOs of a type that the API client doesn't model, like time-slice SLOs,
+// are only available as an unparsed object.
+func getSLOID(slo datadogV1.ServiceLevelObjective) string {
+	if id, ok := slo.GetIdOk(); ok && id != nil {
+		return *id
+	}
+	if id, ok := slo.UnparsedObject["id"].(string); ok {
+		return id
+	}
+	return ""
 }
 
 func createSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, crdSLO *v1alpha1.DatadogSLO) (datadogV1.ServiceLevelObjective, error) {
@@ -113,6 +209,41 @@ func updateSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi
 	return sloListResponse, nil
 }
 
+func getSLOHistory(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, sloID string, from, to time.Time) (datadogV1.SLOHistoryResponseData, error) {
+	history, _, err := client.GetSLOHistory(auth, sloID, from.Unix(), to.Unix())
+	if err != nil {
+		return datadogV1.SLOHistoryResponseData{}, translateClientError(err, "error getting SLO history")
+	}
+
+	data := history.GetData()
+	if overall, ok := data.UnparsedObject["overall"]; ok {
+		// The history of SLOs of a type that the API client doesn't model, like time-slice SLOs, is left unparsed
+		raw, err := json.Marshal(overall)
+		if err != nil {
+			return datadogV1.SLOHistoryResponseData{}, fmt.Errorf("error parsing SLO history: %w", err)
+		}
+		var sliData datadogV1.SLOHistorySLIData
+		if err = json.Unmarshal(raw, &sliData); err != nil {
+			return datadogV1.SLOHistoryResponseData{}, fmt.Errorf("error parsing SLO history: %w", err)
+		}
+		data = datadogV1.SLOHistoryResponseData{Overall: &sliData}
+	}
+
+	return data, nil
+}
+
+// timeframeDuration returns the duration of an SLO timeframe.
+func timeframeDuration(timeframe v1alpha1.DatadogSLOTimeFrame) time.Duration {
+	switch timeframe {
+	case v1alpha1.DatadogSLOTimeFrame90d:
+		return 90 * 24 * time.Hour
+	case v1alpha1.DatadogSLOTimeFrame30d:
+		return 30 * 24 * time.Hour
+	default:
+		return 7 * 24 * time.Hour
+	}
+}
+
 func deleteSLO(auth context.Context, client *datadogV1.ServiceLevelObjectivesApi, sloID string) error {
 	force := "false"
 	optionalParams := datadogV1.DeleteSLOOptionalParameters{
@@ -6,6 +6,7 @@
 package datadogslo
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
@@ -14,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
 )
 
 func Test_buildThreshold(t *testing.T) {
@@ -52,6 +54,27 @@ func Test_buildThreshold(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "List of thresholds",
+			mockSpec: v1alpha1.DatadogSLOSpec{
+				Name: "test",
+				Thresholds: []v1alpha1.DatadogSLOThreshold{
+					{Timeframe: "7d", Target: resource.MustParse("99"), Warning: ptrResourceQuantity(resource.MustParse("99.5"))},
+					{Timeframe: "30d", Target: resource.MustParse("99.9")},
+				},
+			},
+			expectedResult: []datadogV1.SLOThreshold{
+				{
+					Target:    99,
+					Timeframe: datadogV1.SLOTimeframe("7d"),
+					Warning:   float64Ptr(99.5),
+				},
+				{
+					Target:    99.9,
+					Timeframe: datadogV1.SLOTimeframe("30d"),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -66,6 +89,56 @@ func Test_buildThreshold(t *testing.T) {
 	}
 }
 
+func Test_buildSLO(t *testing.T) {
+	crdSLO := &v1alpha1.DatadogSLO{
+		Spec: v1alpha1.DatadogSLOSpec{
+			Name: "test",
+			Type: v1alpha1.DatadogSLOTypeTimeSlice,
+			TimeSlice: &v1alpha1.DatadogSLOTimeSlice{
+				Query:                "avg:trace.servlet.request.duration{env:prod}",
+				Comparator:           v1alpha1.DatadogSLOTimeSliceComparatorLess,
+				Threshold:            resource.MustParse("0.5"),
+				QueryIntervalSeconds: apiutils.NewInt32Pointer(60),
+			},
+			Timeframe: "30d",
+			Thresholds: []v1alpha1.DatadogSLOThreshold{
+				{Timeframe: "7d", Target: resource.MustParse("99")},
+				{Timeframe: "30d", Target: resource.MustParse("99.9"), Warning: ptrResourceQuantity(resource.MustParse("99.95"))},
+			},
+		},
+	}
+
+	sloReq, slo := buildSLO(crdSLO)
+
+	assert.Equal(t, datadogV1.SLOType("time_slice"), sloReq.GetType())
+	assert.Len(t, sloReq.GetThresholds(), 2)
+	assert.Equal(t, datadogV1.SLOTimeframe("30d"), sloReq.GetTimeframe())
+	assert.Equal(t, 99.9, sloReq.GetTargetThreshold())
+	assert.Equal(t, 99.95, sloReq.GetWarningThreshold())
+	assert.Equal(t, sloReq.AdditionalProperties, slo.AdditionalProperties)
+
+	payload, err := json.Marshal(sloReq)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"time_slice": {
+			"comparator": "<",
+			"threshold": 0.5,
+			"query_interval_seconds": 60,
+			"query": {
+				"formulas": [{"formula": "query1"}],
+				"queries": [{"data_source": "metrics", "name": "query1", "query": "avg:trace.servlet.request.duration{env:prod}"}]
+			}
+		}
+	}`, string(mustMarshal(t, sloReq.AdditionalProperties["sli_specification"])))
+	assert.Contains(t, string(payload), `"sli_specification"`)
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return b
+}
+
 func float64Ptr(f float64) *float64 {
 	return &f
 }
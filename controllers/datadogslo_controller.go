@@ -28,6 +28,7 @@ type DatadogSLOReconciler struct {
 	Log         logr.Logger
 	Scheme      *runtime.Scheme
 	Recorder    record.EventRecorder
+	Options     datadogslo.ReconcilerOptions
 	internal    *datadogslo.Reconciler
 }
 
@@ -41,7 +42,7 @@ func (r *DatadogSLOReconciler) Reconcile(ctx context.Context, req reconcile.Requ
 }
 
 func (r *DatadogSLOReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	r.internal = datadogslo.NewReconciler(r.Client, r.DDClient, r.VersionInfo, r.Log, r.Recorder)
+	r.internal = datadogslo.NewReconciler(r.Options, r.Client, r.DDClient, r.VersionInfo, r.Log, r.Recorder)
 
 	builder := ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.DatadogSLO{})
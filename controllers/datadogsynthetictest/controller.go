@@ -0,0 +1,287 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/controllers/finalizer"
+	"github.com/DataDog/datadog-operator/controllers/utils"
+	ctrutils "github.com/DataDog/datadog-operator/pkg/controller/utils"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/comparison"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/condition"
+	"github.com/DataDog/datadog-operator/pkg/controller/utils/datadog"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+const (
+	defaultRequeuePeriod          = 60 * time.Second
+	defaultErrRequeuePeriod       = 5 * time.Second
+	defaultForceSyncPeriod        = 60 * time.Minute
+	datadogSyntheticTestKind      = "DatadogSyntheticTest"
+	datadogSyntheticTestFinalizer = "finalizer.synthetictest.datadoghq.com"
+)
+
+// Reconciler reconciles a DatadogSyntheticTest object
+type Reconciler struct {
+	client        client.Client
+	datadogClient *datadogV1.SyntheticsApi
+	datadogAuth   context.Context
+	versionInfo   *version.Info
+	log           logr.Logger
+	recorder      record.EventRecorder
+}
+
+// NewReconciler returns a new Reconciler object
+func NewReconciler(client client.Client, ddClient datadogclient.DatadogSyntheticsClient, versionInfo *version.Info, log logr.Logger, recorder record.EventRecorder) *Reconciler {
+	return &Reconciler{
+		client:        client,
+		datadogClient: ddClient.Client,
+		datadogAuth:   ddClient.Auth,
+		versionInfo:   versionInfo,
+		log:           log,
+		recorder:      recorder,
+	}
+}
+
+var _ reconcile.Reconciler = (*Reconciler)(nil)
+
+// Reconcile is similar to reconciler.Reconcile interface, but taking a context
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internalReconcile(ctx, req)
+}
+
+func (r *Reconciler) internalReconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger := r.log.WithValues("datadogsynthetictest", req.NamespacedName)
+	logger.Info("Reconciling DatadogSyntheticTest")
+	now := metav1.NewTime(time.Now())
+
+	// Get instance
+	instance := &v1alpha1.DatadogSyntheticTest{}
+	var result ctrl.Result
+	var err error
+	if err = r.client.Get(ctx, req.NamespacedName, instance); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{RequeueAfter: defaultErrRequeuePeriod}, err
+	}
+
+	final := finalizer.NewFinalizer(
+		logger,
+		r.client,
+		r.deleteResource(logger, instance),
+		defaultRequeuePeriod,
+		defaultErrRequeuePeriod,
+	)
+	if result, err = final.HandleFinalizer(ctx, instance, instance.Status.PublicID, datadogSyntheticTestFinalizer); ctrutils.ShouldReturn(result, err) {
+		return result, err
+	}
+
+	status := instance.Status.DeepCopy()
+
+	// Validate the DatadogSyntheticTest spec
+	if err = v1alpha1.IsValidDatadogSyntheticTest(&instance.Spec); err != nil {
+		logger.Error(err, "invalid DatadogSyntheticTest")
+		updateErrStatus(status, now, v1alpha1.DatadogSyntheticTestSyncStatusValidateError, "ValidatingSyntheticTest", err)
+		return r.updateStatusIfNeeded(logger, instance, status, result)
+	}
+
+	instanceSpecHash, err := comparison.GenerateMD5ForSpec(&instance.Spec)
+	if err != nil {
+		logger.Error(err, "error generating hash")
+		updateErrStatus(status, now, v1alpha1.DatadogSyntheticTestSyncStatusUpdateError, "GeneratingSyntheticTestSpecHash", err)
+		return r.updateStatusIfNeeded(logger, instance, status, result)
+	}
+
+	shouldCreate := false
+	shouldUpdate := false
+
+	if status.PublicID == "" {
+		shouldCreate = true
+	} else if instanceSpecHash != status.CurrentHash {
+		logger.V(1).Info("DatadogSyntheticTest manifest has changed")
+		shouldUpdate = true
+	} else if status.LastForceSyncTime == nil || (defaultForceSyncPeriod-now.Sub(status.LastForceSyncTime.Time)) <= 0 {
+		// Periodically force a sync with the API test to ensure parity
+		// Get the test to make sure it exists before trying any updates. If it doesn't, set shouldCreate
+		if _, err = getSyntheticTest(r.datadogAuth, r.datadogClient, status.PublicID); err != nil {
+			logger.Error(err, "error getting synthetic test", "Public ID", status.PublicID)
+			if strings.Contains(err.Error(), ctrutils.NotFoundString) {
+				shouldCreate = true
+			} else {
+				updateErrStatus(status, now, v1alpha1.DatadogSyntheticTestSyncStatusGetError, "GettingSyntheticTest", err)
+				result.RequeueAfter = defaultErrRequeuePeriod
+			}
+		} else {
+			shouldUpdate = true
+		}
+		status.LastForceSyncTime = &now
+	}
+
+	if shouldCreate {
+		err = r.create(logger, instance, status, now, instanceSpecHash)
+	} else if shouldUpdate {
+		err = r.update(logger, instance, status, now, instanceSpecHash)
+	}
+	if err != nil {
+		result.RequeueAfter = defaultErrRequeuePeriod
+	}
+
+	// Periodically refresh the state of the last result of the test
+	if err == nil && status.PublicID != "" && (status.StateLastUpdateTime == nil || (defaultRequeuePeriod-now.Sub(status.StateLastUpdateTime.Time)) <= 0) {
+		r.updateResultState(logger, status, now)
+	}
+
+	// If reconcile was successful, requeue with period defaultRequeuePeriod
+	if !result.Requeue && result.RequeueAfter == 0 {
+		result.RequeueAfter = defaultRequeuePeriod
+	}
+
+	return r.updateStatusIfNeeded(logger, instance, status, result)
+}
+
+func (r *Reconciler) create(logger logr.Logger, instance *v1alpha1.DatadogSyntheticTest, status *v1alpha1.DatadogSyntheticTestStatus, now metav1.Time, hash string) error {
+	logger.V(1).Info("Synthetic test public ID is not set; creating synthetic test in Datadog")
+
+	// Create the test in Datadog
+	test, err := createSyntheticTest(r.datadogAuth, r.datadogClient, instance)
+	if err != nil {
+		logger.Error(err, "error creating synthetic test")
+		updateErrStatus(status, now, v1alpha1.DatadogSyntheticTestSyncStatusCreateError, "CreatingSyntheticTest", err)
+		return err
+	}
+
+	// Set condition and status
+	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeCreated, metav1.ConditionTrue, "CreatingSyntheticTest", "DatadogSyntheticTest Created")
+	creator, created := getSyntheticTestMetadata(test)
+	status.SyncStatus = v1alpha1.DatadogSyntheticTestSyncStatusOK
+	status.PublicID = test.GetPublicId()
+	status.MonitorID = test.GetMonitorId()
+	status.Creator = creator
+	if created != nil {
+		createdTime := metav1.NewTime(*created)
+		status.Created = &createdTime
+	}
+	status.LastResultState = ""
+	status.LastResultTime = nil
+	status.StateLastUpdateTime = nil
+	status.LastForceSyncTime = &now
+	status.CurrentHash = hash
+
+	logger.Info("Created a new synthetic test", "Public ID", status.PublicID)
+	r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.CreationEvent))
+
+	return nil
+}
+
+func (r *Reconciler) update(logger logr.Logger, instance *v1alpha1.DatadogSyntheticTest, status *v1alpha1.DatadogSyntheticTestStatus, now metav1.Time, hash string) error {
+	test, err := updateSyntheticTest(r.datadogAuth, r.datadogClient, instance)
+	if err != nil {
+		logger.Error(err, "error updating synthetic test", "Public ID", status.PublicID)
+		updateErrStatus(status, now, v1alpha1.DatadogSyntheticTestSyncStatusUpdateError, "UpdatingSyntheticTest", err)
+		return err
+	}
+	r.recordEvent(instance, buildEventInfo(instance.Name, instance.Namespace, datadog.UpdateEvent))
+
+	// Set condition and status
+	condition.UpdateStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeUpdated, metav1.ConditionTrue, "UpdatingSyntheticTest", "DatadogSyntheticTest Updated")
+	if monitorID := test.GetMonitorId(); monitorID != 0 {
+		status.MonitorID = monitorID
+	}
+	status.SyncStatus = v1alpha1.DatadogSyntheticTestSyncStatusOK
+	status.CurrentHash = hash
+
+	logger.Info("Updated synthetic test", "Public ID", status.PublicID)
+	return nil
+}
+
+// updateResultState fetches the latest results of the test and reports the state of the most recent one.
+func (r *Reconciler) updateResultState(logger logr.Logger, status *v1alpha1.DatadogSyntheticTestStatus, now metav1.Time) {
+	latest, err := getLatestResult(r.datadogAuth, r.datadogClient, status.PublicID)
+	if err != nil {
+		logger.Error(err, "error getting synthetic test results", "Public ID", status.PublicID)
+		updateErrStatus(status, now, v1alpha1.DatadogSyntheticTestSyncStatusGetError, "GettingSyntheticTestResults", err)
+		return
+	}
+	status.StateLastUpdateTime = &now
+
+	if latest == nil {
+		// The test hasn't run yet
+		return
+	}
+
+	result := latest.GetResult()
+	if result.GetPassed() {
+		status.LastResultState = v1alpha1.DatadogSyntheticTestResultStatePassed
+	} else {
+		status.LastResultState = v1alpha1.DatadogSyntheticTestResultStateFailed
+	}
+	// The check time is a timestamp in milliseconds
+	checkTime := metav1.NewTime(time.UnixMilli(int64(latest.GetCheckTime())))
+	status.LastResultTime = &checkTime
+}
+
+func updateErrStatus(status *v1alpha1.DatadogSyntheticTestStatus, now metav1.Time, syncStatus v1alpha1.DatadogSyntheticTestSyncStatus, reason string, err error) {
+	condition.UpdateFailureStatusConditions(&status.Conditions, now, condition.DatadogConditionTypeError, reason, err)
+	status.SyncStatus = syncStatus
+}
+
+func (r *Reconciler) updateStatusIfNeeded(logger logr.Logger, instance *v1alpha1.DatadogSyntheticTest, status *v1alpha1.DatadogSyntheticTestStatus, result ctrl.Result) (ctrl.Result, error) {
+	if !apiequality.Semantic.DeepEqual(&instance.Status, status) {
+		instance.Status = *status
+		if err := r.client.Status().Update(context.TODO(), instance); err != nil {
+			if apierrors.IsConflict(err) {
+				logger.Error(err, "unable to update DatadogSyntheticTest status due to update conflict")
+				return ctrl.Result{Requeue: true, RequeueAfter: defaultErrRequeuePeriod}, nil
+			}
+			logger.Error(err, "unable to update DatadogSyntheticTest status")
+			return ctrl.Result{Requeue: true, RequeueAfter: defaultRequeuePeriod}, err
+		}
+	}
+	return result, nil
+}
+
+func (r *Reconciler) deleteResource(logger logr.Logger, instance *v1alpha1.DatadogSyntheticTest) finalizer.ResourceDeleteFunc {
+	return func(ctx context.Context, k8sObj client.Object, datadogID string) error {
+		if datadogID != "" {
+			if err := deleteSyntheticTest(r.datadogAuth, r.datadogClient, datadogID); err != nil && !strings.Contains(err.Error(), ctrutils.NotFoundString) {
+				logger.Error(err, "error deleting synthetic test", "Public ID", datadogID)
+				return err
+			}
+			logger.Info("Successfully deleted synthetic test", "Public ID", datadogID)
+		}
+		r.recordEvent(instance, buildEventInfo(k8sObj.GetName(), k8sObj.GetNamespace(), datadog.DeletionEvent))
+		return nil
+	}
+}
+
+// buildEventInfo creates a new EventInfo instance.
+func buildEventInfo(name, ns string, eventType datadog.EventType) utils.EventInfo {
+	return utils.BuildEventInfo(name, ns, datadogSyntheticTestKind, eventType)
+}
+
+// recordEvent wraps the manager event recorder.
+func (r *Reconciler) recordEvent(test runtime.Object, info utils.EventInfo) {
+	r.recorder.Event(test, corev1.EventTypeNormal, info.GetReason(), info.GetMessage())
+}
@@ -0,0 +1,253 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+)
+
+const (
+	resourceNamespace = "default"
+	resourceName      = "synthetic-test"
+)
+
+// TestReconciler_Reconcile tests the Reconcile method of the Reconciler
+func TestReconciler_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	testLogger := zap.New(zap.UseDevMode(true))
+	s := scheme.Scheme
+	s.AddKnownTypes(v1alpha1.GroupVersion, &v1alpha1.DatadogSyntheticTest{}, &v1alpha1.DatadogSyntheticTestList{})
+
+	type mockedFields struct {
+		k8sClient client.Client
+	}
+	tests := []struct {
+		name                 string
+		request              ctrl.Request
+		expectedResult       ctrl.Result
+		mockOn               func(t *testing.T, m *mockedFields)
+		datadogClientHandler http.HandlerFunc
+		wantStatus           func(t *testing.T, status v1alpha1.DatadogSyntheticTestStatus)
+	}{
+		{
+			name:    "Create synthetic test when not exists",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultSyntheticTest())
+			},
+			datadogClientHandler: syntheticTestHandler("abc-def-ghi", 123, true),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogSyntheticTestStatus) {
+				assert.Equal(t, "abc-def-ghi", status.PublicID)
+				assert.Equal(t, int64(123), status.MonitorID)
+				assert.Equal(t, "test@example.com", status.Creator)
+				assert.NotNil(t, status.Created)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestResultStatePassed, status.LastResultState)
+				assert.NotNil(t, status.LastResultTime)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestSyncStatusOK, status.SyncStatus)
+				assert.NotEmpty(t, status.CurrentHash)
+			},
+		},
+		{
+			name:    "Return empty result when synthetic test is not found",
+			request: newRequest(),
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			}),
+			expectedResult: ctrl.Result{},
+		},
+		{
+			name:    "Return Error and Requeue result when creating synthetic test is failed",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				_ = m.k8sClient.Create(context.TODO(), defaultSyntheticTest())
+			},
+			datadogClientHandler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "invalid data", http.StatusBadRequest)
+			}),
+			expectedResult: ctrl.Result{RequeueAfter: defaultErrRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogSyntheticTestStatus) {
+				assert.Empty(t, status.PublicID)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestSyncStatusCreateError, status.SyncStatus)
+			},
+		},
+		{
+			name:    "Update synthetic test when the spec has changed",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				st := defaultSyntheticTest()
+				st.Status.PublicID = "abc-def-ghi"
+				st.Status.CurrentHash = "outdated"
+				_ = m.k8sClient.Create(context.TODO(), st)
+			},
+			datadogClientHandler: syntheticTestHandler("abc-def-ghi", 123, false),
+			expectedResult:       ctrl.Result{RequeueAfter: defaultRequeuePeriod},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogSyntheticTestStatus) {
+				assert.Equal(t, "abc-def-ghi", status.PublicID)
+				assert.Equal(t, int64(123), status.MonitorID)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestResultStateFailed, status.LastResultState)
+				assert.NotEqual(t, "outdated", status.CurrentHash)
+			},
+		},
+		{
+			name:    "Invalid synthetic test",
+			request: newRequest(),
+			mockOn: func(t *testing.T, m *mockedFields) {
+				st := defaultSyntheticTest()
+				st.Spec.Locations = nil
+				_ = m.k8sClient.Create(context.TODO(), st)
+			},
+			datadogClientHandler: syntheticTestHandler("abc-def-ghi", 123, true),
+			expectedResult:       ctrl.Result{},
+			wantStatus: func(t *testing.T, status v1alpha1.DatadogSyntheticTestStatus) {
+				assert.Empty(t, status.PublicID)
+				assert.Equal(t, v1alpha1.DatadogSyntheticTestSyncStatusValidateError, status.SyncStatus)
+			},
+		},
+	}
+
+	// Iterate through test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpServer := httptest.NewServer(tt.datadogClientHandler)
+			defer httpServer.Close()
+
+			testConfig := datadogapi.NewConfiguration()
+			testConfig.HTTPClient = httpServer.Client()
+			apiClient := datadogapi.NewAPIClient(testConfig)
+			client := datadogV1.NewSyntheticsApi(apiClient)
+			testAuth := setupTestAuth(httpServer.URL)
+
+			m := mockedFields{
+				k8sClient: fake.NewClientBuilder().WithScheme(s).Build(),
+			}
+			if tt.mockOn != nil {
+				tt.mockOn(t, &m)
+			}
+			recorder := record.NewFakeRecorder(5)
+			r := &Reconciler{
+				client:        m.k8sClient,
+				datadogClient: client,
+				datadogAuth:   testAuth,
+				recorder:      recorder,
+				log:           testLogger,
+				versionInfo:   &version.Info{},
+			}
+
+			res, _ := r.Reconcile(ctx, tt.request)
+			assert.Equal(t, tt.expectedResult, res)
+
+			if tt.wantStatus != nil {
+				st := &v1alpha1.DatadogSyntheticTest{}
+				assert.NoError(t, m.k8sClient.Get(ctx, tt.request.NamespacedName, st))
+				tt.wantStatus(t, st.Status)
+			}
+		})
+	}
+}
+
+func newRequest() ctrl.Request {
+	return ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+	}
+}
+
+func defaultSyntheticTest() *v1alpha1.DatadogSyntheticTest {
+	return &v1alpha1.DatadogSyntheticTest{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DatadogSyntheticTest",
+			APIVersion: fmt.Sprintf("%s/%s", v1alpha1.GroupVersion.Group, v1alpha1.GroupVersion.Version),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: resourceNamespace,
+			Name:      resourceName,
+		},
+		Spec: v1alpha1.DatadogSyntheticTestSpec{
+			Name:      "Check example.com",
+			Subtype:   v1alpha1.DatadogSyntheticTestSubtypeHTTP,
+			Request:   &v1alpha1.DatadogSyntheticTestRequest{Method: "GET", URL: "https://example.com"},
+			Locations: []string{"aws:eu-west-1"},
+			Assertions: []v1alpha1.DatadogSyntheticTestAssertion{
+				{Type: "statusCode", Operator: "is", Target: "200"},
+			},
+		},
+	}
+}
+
+// syntheticTestHandler stubs the synthetics API: it returns the latest results of the test on the results
+// endpoint, and the test itself on the other endpoints.
+func syntheticTestHandler(publicID string, monitorID int64, passed bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/results") {
+			fmt.Fprintf(w, `{"results": [{"check_time": 1683000000000, "result": {"passed": %t}}, {"check_time": 1682000000000, "result": {"passed": %t}}]}`, passed, !passed)
+			return
+		}
+		fmt.Fprintf(w, `{
+			"public_id": %q,
+			"monitor_id": %d,
+			"type": "api",
+			"subtype": "http",
+			"name": "Check example.com",
+			"message": "example.com is down",
+			"config": {
+				"request": {"method": "GET", "url": "https://example.com"},
+				"assertions": [{"type": "statusCode", "operator": "is", "target": 200}]
+			},
+			"locations": ["aws:eu-west-1"],
+			"options": {"tick_every": 300},
+			"created_at": "2023-05-01T22:00:00.000000+00:00",
+			"creator": {"email": "test@example.com"}
+		}`, publicID, monitorID)
+	}
+}
+
+func setupTestAuth(apiURL string) context.Context {
+	testAuth := context.WithValue(
+		context.Background(),
+		datadogapi.ContextAPIKeys,
+		map[string]datadogapi.APIKey{
+			"apiKeyAuth": {
+				Key: "DUMMY_API_KEY",
+			},
+			"appKeyAuth": {
+				Key: "DUMMY_APP_KEY",
+			},
+		},
+	)
+	parsedAPIURL, _ := url.Parse(apiURL)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerIndex, 1)
+	testAuth = context.WithValue(testAuth, datadogapi.ContextServerVariables, map[string]string{
+		"name":     parsedAPIURL.Host,
+		"protocol": parsedAPIURL.Scheme,
+	})
+
+	return testAuth
+}
@@ -0,0 +1,376 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/controllers/utils"
+)
+
+const (
+	syntheticTestType           = "api"
+	syntheticTestStatusLive     = "live"
+	syntheticTestStatusPaused   = "paused"
+	jsonPathAssertionOperator   = "validatesJSONPath"
+	defaultJSONPathOperator     = "is"
+	multistepRequestStepSubtype = "http"
+)
+
+// The payload types below mirror the JSON representation of synthetic API tests. The request, assertion, and step
+// models of the API client are unions that differ from one subtype to another, so the test is built through its
+// JSON representation rather than through the client models.
+type syntheticTestPayload struct {
+	Type      string               `json:"type"`
+	Subtype   string               `json:"subtype"`
+	Name      string               `json:"name"`
+	Message   string               `json:"message"`
+	Locations []string             `json:"locations"`
+	Tags      []string             `json:"tags"`
+	Status    string               `json:"status"`
+	Config    syntheticTestConfig  `json:"config"`
+	Options   syntheticTestOptions `json:"options"`
+}
+
+type syntheticTestConfig struct {
+	Request    *syntheticTestRequest    `json:"request,omitempty"`
+	Assertions []syntheticTestAssertion `json:"assertions,omitempty"`
+	Steps      []syntheticTestStep      `json:"steps,omitempty"`
+}
+
+type syntheticTestRequest struct {
+	Method        string            `json:"method,omitempty"`
+	URL           string            `json:"url,omitempty"`
+	Host          string            `json:"host,omitempty"`
+	Port          *int64            `json:"port,omitempty"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	Body          string            `json:"body,omitempty"`
+	Timeout       *int64            `json:"timeout,omitempty"`
+	DNSServer     string            `json:"dnsServer,omitempty"`
+	DNSServerPort *int32            `json:"dnsServerPort,omitempty"`
+	Service       string            `json:"service,omitempty"`
+}
+
+type syntheticTestAssertion struct {
+	Type     string      `json:"type"`
+	Operator string      `json:"operator"`
+	Property string      `json:"property,omitempty"`
+	Target   interface{} `json:"target,omitempty"`
+}
+
+type syntheticTestJSONPathTarget struct {
+	JSONPath    string      `json:"jsonPath"`
+	Operator    string      `json:"operator"`
+	TargetValue interface{} `json:"targetValue,omitempty"`
+}
+
+type syntheticTestStep struct {
+	Name            string                        `json:"name"`
+	Subtype         string                        `json:"subtype"`
+	Request         syntheticTestRequest          `json:"request"`
+	Assertions      []syntheticTestAssertion      `json:"assertions"`
+	ExtractedValues []syntheticTestExtractedValue `json:"extractedValues,omitempty"`
+	AllowFailure    *bool                         `json:"allowFailure,omitempty"`
+	IsCritical      *bool                         `json:"isCritical,omitempty"`
+}
+
+type syntheticTestExtractedValue struct {
+	Name   string              `json:"name"`
+	Type   string              `json:"type"`
+	Field  string              `json:"field,omitempty"`
+	Parser syntheticTestParser `json:"parser"`
+}
+
+type syntheticTestParser struct {
+	Type  string `json:"type"`
+	Value string `json:"value,omitempty"`
+}
+
+type syntheticTestOptions struct {
+	TickEvery          *int64                       `json:"tick_every,omitempty"`
+	MinFailureDuration *int64                       `json:"min_failure_duration,omitempty"`
+	MinLocationFailed  *int64                       `json:"min_location_failed,omitempty"`
+	Retry              *syntheticTestRetry          `json:"retry,omitempty"`
+	MonitorOptions     *syntheticTestMonitorOptions `json:"monitor_options,omitempty"`
+	MonitorPriority    *int32                       `json:"monitor_priority,omitempty"`
+	FollowRedirects    *bool                        `json:"follow_redirects,omitempty"`
+	AcceptSelfSigned   *bool                        `json:"accept_self_signed,omitempty"`
+	AllowInsecure      *bool                        `json:"allow_insecure,omitempty"`
+}
+
+type syntheticTestRetry struct {
+	Count    *int64 `json:"count,omitempty"`
+	Interval *int64 `json:"interval,omitempty"`
+}
+
+type syntheticTestMonitorOptions struct {
+	RenotifyInterval *int64 `json:"renotify_interval,omitempty"`
+}
+
+// syntheticTestMetadata holds the metadata of a test returned by the API that the API client doesn't model.
+type syntheticTestMetadata struct {
+	CreatedAt string `json:"created_at,omitempty"`
+	Creator   struct {
+		Email string `json:"email,omitempty"`
+	} `json:"creator,omitempty"`
+}
+
+func buildSyntheticTest(crdTest *v1alpha1.DatadogSyntheticTest) (*datadogV1.SyntheticsAPITest, error) {
+	spec := crdTest.Spec
+
+	tags := append([]string{}, spec.Tags...)
+	tags = append(tags, utils.GetTagsToAdd(tags)...)
+
+	status := syntheticTestStatusLive
+	if spec.Paused {
+		status = syntheticTestStatusPaused
+	}
+
+	payload := syntheticTestPayload{
+		Type:      syntheticTestType,
+		Subtype:   string(spec.Subtype),
+		Name:      spec.Name,
+		Message:   spec.Message,
+		Locations: spec.Locations,
+		Tags:      tags,
+		Status:    status,
+		Options:   buildOptions(spec.Options),
+	}
+
+	if spec.Subtype == v1alpha1.DatadogSyntheticTestSubtypeMulti {
+		for _, step := range spec.Steps {
+			payload.Config.Steps = append(payload.Config.Steps, buildStep(step))
+		}
+	} else {
+		if spec.Request != nil {
+			request := buildRequest(*spec.Request)
+			payload.Config.Request = &request
+		}
+		payload.Config.Assertions = buildAssertions(spec.Assertions)
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error building synthetic test: %w", err)
+	}
+	test := &datadogV1.SyntheticsAPITest{}
+	if err = json.Unmarshal(raw, test); err != nil {
+		return nil, fmt.Errorf("error building synthetic test: %w", err)
+	}
+
+	return test, nil
+}
+
+func buildRequest(request v1alpha1.DatadogSyntheticTestRequest) syntheticTestRequest {
+	return syntheticTestRequest{
+		Method:        request.Method,
+		URL:           request.URL,
+		Host:          request.Host,
+		Port:          request.Port,
+		Headers:       request.Headers,
+		Body:          request.Body,
+		Timeout:       request.Timeout,
+		DNSServer:     request.DNSServer,
+		DNSServerPort: request.DNSServerPort,
+		Service:       request.Service,
+	}
+}
+
+func buildAssertions(assertions []v1alpha1.DatadogSyntheticTestAssertion) []syntheticTestAssertion {
+	built := make([]syntheticTestAssertion, 0, len(assertions))
+	for _, assertion := range assertions {
+		a := syntheticTestAssertion{
+			Type:     assertion.Type,
+			Operator: assertion.Operator,
+			Property: assertion.Property,
+			Target:   buildTarget(assertion.Target),
+		}
+		if assertion.JSONPath != "" {
+			operator := assertion.JSONPathOperator
+			if operator == "" {
+				operator = defaultJSONPathOperator
+			}
+			a.Operator = jsonPathAssertionOperator
+			a.Target = syntheticTestJSONPathTarget{
+				JSONPath:    assertion.JSONPath,
+				Operator:    operator,
+				TargetValue: buildTarget(assertion.Target),
+			}
+		}
+		built = append(built, a)
+	}
+
+	return built
+}
+
+// buildTarget returns the target of an assertion, as a number when it is numeric.
+func buildTarget(target string) interface{} {
+	if target == "" {
+		return nil
+	}
+	if number, err := strconv.ParseFloat(target, 64); err == nil {
+		return number
+	}
+
+	return target
+}
+
+func buildStep(step v1alpha1.DatadogSyntheticTestStep) syntheticTestStep {
+	built := syntheticTestStep{
+		Name:         step.Name,
+		Subtype:      multistepRequestStepSubtype,
+		Request:      buildRequest(step.Request),
+		Assertions:   buildAssertions(step.Assertions),
+		AllowFailure: step.AllowFailure,
+		IsCritical:   step.IsCritical,
+	}
+	for _, value := range step.ExtractedValues {
+		built.ExtractedValues = append(built.ExtractedValues, syntheticTestExtractedValue{
+			Name:  value.Name,
+			Type:  value.Type,
+			Field: value.Field,
+			Parser: syntheticTestParser{
+				Type:  value.ParserType,
+				Value: value.ParserValue,
+			},
+		})
+	}
+
+	return built
+}
+
+func buildOptions(options v1alpha1.DatadogSyntheticTestOptions) syntheticTestOptions {
+	built := syntheticTestOptions{
+		TickEvery:          options.TickEvery,
+		MinFailureDuration: options.MinFailureDuration,
+		MinLocationFailed:  options.MinLocationFailed,
+		MonitorPriority:    options.MonitorPriority,
+		FollowRedirects:    options.FollowRedirects,
+		AcceptSelfSigned:   options.AcceptSelfSigned,
+		AllowInsecure:      options.AllowInsecure,
+	}
+	if options.RetryCount != nil || options.RetryInterval != nil {
+		built.Retry = &syntheticTestRetry{
+			Count:    options.RetryCount,
+			Interval: options.RetryInterval,
+		}
+	}
+	if options.RenotifyInterval != nil {
+		built.MonitorOptions = &syntheticTestMonitorOptions{
+			RenotifyInterval: options.RenotifyInterval,
+		}
+	}
+
+	return built
+}
+
+// getSyntheticTestMetadata returns the creator and the creation time of a test.
+func getSyntheticTestMetadata(test datadogV1.SyntheticsAPITest) (string, *time.Time) {
+	raw, err := json.Marshal(test)
+	if err != nil {
+		return "", nil
+	}
+	metadata := syntheticTestMetadata{}
+	if err = json.Unmarshal(raw, &metadata); err != nil {
+		return "", nil
+	}
+	created, err := time.Parse(time.RFC3339, metadata.CreatedAt)
+	if err != nil {
+		return metadata.Creator.Email, nil
+	}
+
+	return metadata.Creator.Email, &created
+}
+
+func createSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, crdTest *v1alpha1.DatadogSyntheticTest) (datadogV1.SyntheticsAPITest, error) {
+	test, err := buildSyntheticTest(crdTest)
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, err
+	}
+	created, _, err := client.CreateSyntheticsAPITest(auth, *test)
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, translateClientError(err, "error creating synthetic test")
+	}
+
+	return created, nil
+}
+
+func getSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, publicID string) (datadogV1.SyntheticsAPITest, error) {
+	test, _, err := client.GetAPITest(auth, publicID)
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, translateClientError(err, "error getting synthetic test")
+	}
+
+	return test, nil
+}
+
+func updateSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, crdTest *v1alpha1.DatadogSyntheticTest) (datadogV1.SyntheticsAPITest, error) {
+	test, err := buildSyntheticTest(crdTest)
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, err
+	}
+	updated, _, err := client.UpdateAPITest(auth, crdTest.Status.PublicID, *test)
+	if err != nil {
+		return datadogV1.SyntheticsAPITest{}, translateClientError(err, "error updating synthetic test")
+	}
+
+	return updated, nil
+}
+
+func deleteSyntheticTest(auth context.Context, client *datadogV1.SyntheticsApi, publicID string) error {
+	payload := datadogV1.NewSyntheticsDeleteTestsPayload()
+	payload.SetPublicIds([]string{publicID})
+	if _, _, err := client.DeleteTests(auth, *payload); err != nil {
+		return translateClientError(err, "error deleting synthetic test")
+	}
+
+	return nil
+}
+
+// getLatestResult returns the most recent result of a test, or nil if the test has no results yet.
+func getLatestResult(auth context.Context, client *datadogV1.SyntheticsApi, publicID string) (*datadogV1.SyntheticsAPITestResultShort, error) {
+	results, _, err := client.GetAPITestLatestResults(auth, publicID)
+	if err != nil {
+		return nil, translateClientError(err, "error getting synthetic test results")
+	}
+
+	var latest *datadogV1.SyntheticsAPITestResultShort
+	for i, result := range results.GetResults() {
+		if latest == nil || result.GetCheckTime() > latest.GetCheckTime() {
+			latest = &results.Results[i]
+		}
+	}
+
+	return latest, nil
+}
+
+func translateClientError(err error, msg string) error {
+	if msg == "" {
+		msg = "an error occurred"
+	}
+
+	var apiErr datadogapi.GenericOpenAPIError
+	var errURL *url.Error
+	if errors.As(err, &apiErr) {
+		return fmt.Errorf(msg+": %w: %s", err, apiErr.Body())
+	}
+
+	if errors.As(err, &errURL) {
+		return fmt.Errorf(msg+" (url.Error): %s", errURL)
+	}
+
+	return fmt.Errorf(msg+": %w", err)
+}
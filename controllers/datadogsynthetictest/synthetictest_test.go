@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogsynthetictest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	apiutils "github.com/DataDog/datadog-operator/apis/utils"
+)
+
+func Test_buildSyntheticTest(t *testing.T) {
+	st := defaultSyntheticTest()
+	st.Spec.Message = "example.com is down @team"
+	st.Spec.Tags = []string{"env:staging"}
+	st.Spec.Paused = true
+	st.Spec.Assertions = append(st.Spec.Assertions, v1alpha1.DatadogSyntheticTestAssertion{
+		Type: "body", Operator: "validatesJSONPath", JSONPath: "$.status", Target: "ok",
+	})
+	st.Spec.Options = v1alpha1.DatadogSyntheticTestOptions{
+		TickEvery:        apiutils.NewInt64Pointer(300),
+		RetryCount:       apiutils.NewInt64Pointer(2),
+		RenotifyInterval: apiutils.NewInt64Pointer(60),
+	}
+
+	test, err := buildSyntheticTest(st)
+	require.NoError(t, err)
+	payload := toMap(t, test)
+
+	assert.Equal(t, "api", payload["type"])
+	assert.Equal(t, "http", payload["subtype"])
+	assert.Equal(t, "Check example.com", payload["name"])
+	assert.Equal(t, "example.com is down @team", payload["message"])
+	assert.Equal(t, "paused", payload["status"])
+	assert.Equal(t, []interface{}{"aws:eu-west-1"}, payload["locations"])
+	assert.Equal(t, []interface{}{"env:staging", "generated:kubernetes"}, payload["tags"])
+
+	config := payload["config"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"method": "GET", "url": "https://example.com"}, config["request"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"type": "statusCode", "operator": "is", "target": float64(200)},
+		map[string]interface{}{"type": "body", "operator": "validatesJSONPath", "target": map[string]interface{}{
+			"jsonPath": "$.status", "operator": "is", "targetValue": "ok",
+		}},
+	}, config["assertions"])
+
+	options := payload["options"].(map[string]interface{})
+	assert.Equal(t, float64(300), options["tick_every"])
+	assert.Equal(t, map[string]interface{}{"count": float64(2)}, options["retry"])
+	assert.Equal(t, map[string]interface{}{"renotify_interval": float64(60)}, options["monitor_options"])
+	assert.NotContains(t, options, "min_location_failed")
+}
+
+func Test_buildSyntheticTestMultistep(t *testing.T) {
+	st := defaultSyntheticTest()
+	st.Spec.Subtype = v1alpha1.DatadogSyntheticTestSubtypeMulti
+	st.Spec.Request = nil
+	st.Spec.Assertions = nil
+	st.Spec.Steps = []v1alpha1.DatadogSyntheticTestStep{
+		{
+			Name:       "Login",
+			Request:    v1alpha1.DatadogSyntheticTestRequest{Method: "POST", URL: "https://example.com/login"},
+			Assertions: []v1alpha1.DatadogSyntheticTestAssertion{{Type: "statusCode", Operator: "is", Target: "200"}},
+			ExtractedValues: []v1alpha1.DatadogSyntheticTestExtractedValue{
+				{Name: "TOKEN", Type: "http_body", ParserType: "json_path", ParserValue: "$.token"},
+			},
+			IsCritical: apiutils.NewBoolPointer(true),
+		},
+	}
+
+	test, err := buildSyntheticTest(st)
+	require.NoError(t, err)
+	payload := toMap(t, test)
+
+	assert.Equal(t, "multi", payload["subtype"])
+	assert.Equal(t, "live", payload["status"])
+	config := payload["config"].(map[string]interface{})
+	assert.NotContains(t, config, "request")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"name":       "Login",
+			"subtype":    "http",
+			"request":    map[string]interface{}{"method": "POST", "url": "https://example.com/login"},
+			"assertions": []interface{}{map[string]interface{}{"type": "statusCode", "operator": "is", "target": float64(200)}},
+			"extractedValues": []interface{}{map[string]interface{}{
+				"name": "TOKEN", "type": "http_body", "parser": map[string]interface{}{"type": "json_path", "value": "$.token"},
+			}},
+			"isCritical": true,
+		},
+	}, config["steps"])
+}
+
+func toMap(t *testing.T, v interface{}) map[string]interface{} {
+	raw, err := json.Marshal(v)
+	require.NoError(t, err)
+	out := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(raw, &out))
+
+	return out
+}
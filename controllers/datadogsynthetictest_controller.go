@@ -0,0 +1,58 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/DataDog/datadog-operator/apis/datadoghq/v1alpha1"
+	"github.com/DataDog/datadog-operator/controllers/datadogsynthetictest"
+	"github.com/DataDog/datadog-operator/pkg/datadogclient"
+)
+
+// DatadogSyntheticTestReconciler reconciles a DatadogSyntheticTest object.
+type DatadogSyntheticTestReconciler struct {
+	Client      client.Client
+	DDClient    datadogclient.DatadogSyntheticsClient
+	VersionInfo *version.Info
+	Log         logr.Logger
+	Scheme      *runtime.Scheme
+	Recorder    record.EventRecorder
+	internal    *datadogsynthetictest.Reconciler
+}
+
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogsynthetictests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogsynthetictests/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=datadoghq.com,resources=datadogsynthetictests/finalizers,verbs=get;list;watch;create;update;patch;delete
+
+// Reconcile loop for DatadogSyntheticTest.
+func (r *DatadogSyntheticTestReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return r.internal.Reconcile(ctx, req)
+}
+
+// SetupWithManager creates a new DatadogSyntheticTest controller.
+func (r *DatadogSyntheticTestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.internal = datadogsynthetictest.NewReconciler(r.Client, r.DDClient, r.VersionInfo, r.Log, r.Recorder)
+
+	builder := ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.DatadogSyntheticTest{})
+
+	err := builder.Complete(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+var _ reconcile.Reconciler = (*DatadogSyntheticTestReconciler)(nil)
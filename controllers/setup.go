@@ -15,6 +15,7 @@ import (
 
 	"github.com/DataDog/datadog-operator/controllers/datadogagent"
 	componentagent "github.com/DataDog/datadog-operator/controllers/datadogagent/component/agent"
+	"github.com/DataDog/datadog-operator/controllers/datadogslo"
 	"github.com/DataDog/datadog-operator/pkg/config"
 	"github.com/DataDog/datadog-operator/pkg/datadogclient"
 	"github.com/DataDog/datadog-operator/pkg/kubernetes"
@@ -26,21 +27,28 @@ import (
 )
 
 const (
-	agentControllerName   = "DatadogAgent"
-	monitorControllerName = "DatadogMonitor"
-	sloControllerName     = "DatadogSLO"
+	agentControllerName         = "DatadogAgent"
+	monitorControllerName       = "DatadogMonitor"
+	sloControllerName           = "DatadogSLO"
+	downtimeControllerName      = "DatadogDowntime"
+	syntheticTestControllerName = "DatadogSyntheticTest"
+	dashboardControllerName     = "DatadogDashboard"
 )
 
 // SetupOptions defines options for setting up controllers to ease testing
 type SetupOptions struct {
-	SupportExtendedDaemonset ExtendedDaemonsetOptions
-	SupportCilium            bool
-	Creds                    config.Creds
-	DatadogAgentEnabled      bool
-	DatadogMonitorEnabled    bool
-	DatadogSLOEnabled        bool
-	OperatorMetricsEnabled   bool
-	V2APIEnabled             bool
+	SupportExtendedDaemonset    ExtendedDaemonsetOptions
+	SupportCilium               bool
+	CredsManager                *config.CredentialManager
+	DatadogAgentEnabled         bool
+	DatadogMonitorEnabled       bool
+	DatadogSLOEnabled           bool
+	DatadogSLOOptions           DatadogSLOOptions
+	DatadogDowntimeEnabled      bool
+	DatadogSyntheticTestEnabled bool
+	DatadogDashboardEnabled     bool
+	OperatorMetricsEnabled      bool
+	V2APIEnabled                bool
 }
 
 // ExtendedDaemonsetOptions defines ExtendedDaemonset options
@@ -57,12 +65,20 @@ type ExtendedDaemonsetOptions struct {
 	CanaryAutoFailMaxRestarts  int
 }
 
+// DatadogSLOOptions defines DatadogSLO controller options
+type DatadogSLOOptions struct {
+	StateRefreshPeriod time.Duration
+}
+
 type starterFunc func(logr.Logger, manager.Manager, *version.Info, kubernetes.PlatformInfo, SetupOptions) error
 
 var controllerStarters = map[string]starterFunc{
-	agentControllerName:   startDatadogAgent,
-	monitorControllerName: startDatadogMonitor,
-	sloControllerName:     startDatadogSLO,
+	agentControllerName:         startDatadogAgent,
+	monitorControllerName:       startDatadogMonitor,
+	sloControllerName:           startDatadogSLO,
+	downtimeControllerName:      startDatadogDowntime,
+	syntheticTestControllerName: startDatadogSyntheticTest,
+	dashboardControllerName:     startDatadogDashboard,
 }
 
 // SetupControllers starts all controllers (also used by e2e tests)
@@ -146,7 +162,7 @@ func startDatadogMonitor(logger logr.Logger, mgr manager.Manager, vInfo *version
 		return nil
 	}
 
-	ddClient, err := datadogclient.InitDatadogMonitorClient(logger, options.Creds)
+	ddClient, err := datadogclient.InitDatadogMonitorClient(logger, options.CredsManager)
 	if err != nil {
 		return fmt.Errorf("unable to create Datadog API Client: %w", err)
 	}
@@ -167,7 +183,7 @@ func startDatadogSLO(logger logr.Logger, mgr manager.Manager, info *version.Info
 		return nil
 	}
 
-	ddClient, err := datadogclient.InitDatadogSLOClient(logger, options.Creds)
+	ddClient, err := datadogclient.InitDatadogSLOClient(logger, options.CredsManager)
 	if err != nil {
 		return fmt.Errorf("unable to create Datadog API Client: %w", err)
 	}
@@ -179,6 +195,78 @@ func startDatadogSLO(logger logr.Logger, mgr manager.Manager, info *version.Info
 		Log:         ctrl.Log.WithName("controllers").WithName(sloControllerName),
 		Scheme:      mgr.GetScheme(),
 		Recorder:    mgr.GetEventRecorderFor(sloControllerName),
+		Options: datadogslo.ReconcilerOptions{
+			StateRefreshPeriod: options.DatadogSLOOptions.StateRefreshPeriod,
+		},
+	}
+
+	return controller.SetupWithManager(mgr)
+}
+
+func startDatadogDowntime(logger logr.Logger, mgr manager.Manager, info *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
+	if !options.DatadogDowntimeEnabled {
+		logger.Info("Feature disabled, not starting the controller", "controller", downtimeControllerName)
+		return nil
+	}
+
+	ddClient, err := datadogclient.InitDatadogDowntimeClient(logger, options.CredsManager)
+	if err != nil {
+		return fmt.Errorf("unable to create Datadog API Client: %w", err)
+	}
+
+	controller := &DatadogDowntimeReconciler{
+		Client:      mgr.GetClient(),
+		DDClient:    ddClient,
+		VersionInfo: info,
+		Log:         ctrl.Log.WithName("controllers").WithName(downtimeControllerName),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor(downtimeControllerName),
+	}
+
+	return controller.SetupWithManager(mgr)
+}
+
+func startDatadogSyntheticTest(logger logr.Logger, mgr manager.Manager, info *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
+	if !options.DatadogSyntheticTestEnabled {
+		logger.Info("Feature disabled, not starting the controller", "controller", syntheticTestControllerName)
+		return nil
+	}
+
+	ddClient, err := datadogclient.InitDatadogSyntheticsClient(logger, options.CredsManager)
+	if err != nil {
+		return fmt.Errorf("unable to create Datadog API Client: %w", err)
+	}
+
+	controller := &DatadogSyntheticTestReconciler{
+		Client:      mgr.GetClient(),
+		DDClient:    ddClient,
+		VersionInfo: info,
+		Log:         ctrl.Log.WithName("controllers").WithName(syntheticTestControllerName),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor(syntheticTestControllerName),
+	}
+
+	return controller.SetupWithManager(mgr)
+}
+
+func startDatadogDashboard(logger logr.Logger, mgr manager.Manager, info *version.Info, pInfo kubernetes.PlatformInfo, options SetupOptions) error {
+	if !options.DatadogDashboardEnabled {
+		logger.Info("Feature disabled, not starting the controller", "controller", dashboardControllerName)
+		return nil
+	}
+
+	ddClient, err := datadogclient.InitDatadogDashboardClient(logger, options.CredsManager)
+	if err != nil {
+		return fmt.Errorf("unable to create Datadog API Client: %w", err)
+	}
+
+	controller := &DatadogDashboardReconciler{
+		Client:      mgr.GetClient(),
+		DDClient:    ddClient,
+		VersionInfo: info,
+		Log:         ctrl.Log.WithName("controllers").WithName(dashboardControllerName),
+		Scheme:      mgr.GetScheme(),
+		Recorder:    mgr.GetEventRecorderFor(dashboardControllerName),
 	}
 
 	return controller.SetupWithManager(mgr)
@@ -10,6 +10,7 @@ package controllers
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -98,11 +99,13 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).ToNot(HaveOccurred())
 
+	os.Setenv(config.DDAPIKeyEnvVar, "dummy_api_key")
+	os.Setenv(config.DDAppKeyEnvVar, "dummy_app_key")
 	options := SetupOptions{
 		SupportExtendedDaemonset: ExtendedDaemonsetOptions{
 			Enabled: false,
 		},
-		Creds:                 config.Creds{APIKey: "dummy_api_key", AppKey: "dummy_app_key"},
+		CredsManager:          config.NewCredentialManager(),
 		DatadogAgentEnabled:   true,
 		DatadogMonitorEnabled: true,
 	}
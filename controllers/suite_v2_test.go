@@ -14,6 +14,7 @@ package controllers
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -99,11 +100,13 @@ var _ = BeforeSuite(func() {
 		Scheme: scheme.Scheme,
 	})
 
+	os.Setenv(config.DDAPIKeyEnvVar, "dummy_api_key")
+	os.Setenv(config.DDAppKeyEnvVar, "dummy_app_key")
 	options := SetupOptions{
 		SupportExtendedDaemonset: ExtendedDaemonsetOptions{
 			Enabled: false,
 		},
-		Creds:                 config.Creds{APIKey: "dummy_api_key", AppKey: "dummy_app_key"},
+		CredsManager:          config.NewCredentialManager(),
 		DatadogAgentEnabled:   true,
 		DatadogMonitorEnabled: true,
 		V2APIEnabled:          true,
@@ -6,6 +6,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
@@ -14,10 +15,14 @@ import (
 	"strings"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	klog "k8s.io/klog/v2"
 	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
@@ -114,6 +119,10 @@ type options struct {
 	datadogAgentEnabled           bool
 	datadogMonitorEnabled         bool
 	datadogSLOEnabled             bool
+	datadogSLOStateRefreshPeriod  time.Duration
+	datadogDowntimeEnabled        bool
+	datadogSyntheticTestEnabled   bool
+	datadogDashboardEnabled       bool
 	operatorMetricsEnabled        bool
 	webhookEnabled                bool
 	v2APIEnabled                  bool
@@ -122,6 +131,10 @@ type options struct {
 	// Secret Backend options
 	secretBackendCommand string
 	secretBackendArgs    stringSlice
+
+	// Credentials options
+	credentialsSecret        string
+	credentialsRefreshPeriod time.Duration
 }
 
 func (opts *options) Parse() {
@@ -142,13 +155,19 @@ func (opts *options) Parse() {
 	// Custom flags
 	flag.StringVar(&opts.secretBackendCommand, "secretBackendCommand", "", "Secret backend command")
 	flag.Var(&opts.secretBackendArgs, "secretBackendArgs", "Space separated arguments of the secret backend command")
+	flag.StringVar(&opts.credentialsSecret, "credentialsSecret", "", "Secret containing the api_key and app_key used by the operator, in the namespace/name format. Default: read from the environment")
+	flag.DurationVar(&opts.credentialsRefreshPeriod, "credentialsRefreshPeriod", time.Minute, "Period between two reloads of the operator credentials")
 	flag.BoolVar(&opts.supportCilium, "supportCilium", false, "Support usage of Cilium network policies.")
 	flag.BoolVar(&opts.datadogAgentEnabled, "datadogAgentEnabled", true, "Enable the DatadogAgent controller")
 	flag.BoolVar(&opts.datadogMonitorEnabled, "datadogMonitorEnabled", false, "Enable the DatadogMonitor controller")
 	flag.BoolVar(&opts.datadogSLOEnabled, "datadogSLOEnabled", false, "Enable the DatadogSLO controller")
+	flag.DurationVar(&opts.datadogSLOStateRefreshPeriod, "datadogSLOStateRefreshPeriod", 5*time.Minute, "Period between two refreshes of the DatadogSLO SLI value and error budget")
+	flag.BoolVar(&opts.datadogDowntimeEnabled, "datadogDowntimeEnabled", false, "Enable the DatadogDowntime controller")
+	flag.BoolVar(&opts.datadogSyntheticTestEnabled, "datadogSyntheticTestEnabled", false, "Enable the DatadogSyntheticTest controller")
+	flag.BoolVar(&opts.datadogDashboardEnabled, "datadogDashboardEnabled", false, "Enable the DatadogDashboard controller")
 	flag.BoolVar(&opts.operatorMetricsEnabled, "operatorMetricsEnabled", true, "Enable sending operator metrics to Datadog")
 	flag.BoolVar(&opts.v2APIEnabled, "v2APIEnabled", true, "Enable the v2 api")
-	flag.BoolVar(&opts.webhookEnabled, "webhookEnabled", false, "Enable CRD conversion webhook.")
+	flag.BoolVar(&opts.webhookEnabled, "webhookEnabled", false, "Enable CRD conversion webhook and DatadogAgent validating webhook.")
 	flag.IntVar(&opts.maximumGoroutines, "maximumGoroutines", defaultMaximumGoroutines, "Override health check threshold for maximum number of goroutines.")
 
 	// ExtendedDaemonset configuration
@@ -240,10 +259,33 @@ func run(opts *options) error {
 	customSetupHealthChecks(setupLog, mgr, &opts.maximumGoroutines)
 	customSetupEndpoints(opts.pprofActive, mgr)
 
-	creds, err := config.NewCredentialManager().GetCredentials()
+	credsManager := config.NewCredentialManager()
+	if opts.credentialsSecret != "" {
+		namespace, name, splitErr := cache.SplitMetaNamespaceKey(opts.credentialsSecret)
+		if splitErr != nil || namespace == "" {
+			return setupErrorf(setupLog, fmt.Errorf("invalid credentials Secret %q", opts.credentialsSecret), "Unable to get credentials")
+		}
+		credsManager.UseSecret(mgr.GetAPIReader(), types.NamespacedName{Namespace: namespace, Name: name})
+	}
+	// The rotations of the credentials read from the environment are reported on the operator Pod
+	var operatorPod runtime.Object
+	if podName, podNamespace := os.Getenv(config.PodNameEnvVar), os.Getenv(config.PodNamespaceEnvVar); podName != "" && podNamespace != "" {
+		operatorPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: podName, Namespace: podNamespace}}
+	}
+	credsManager.UseEventRecorder(mgr.GetEventRecorderFor("datadog-operator"), operatorPod)
+	_, err = credsManager.GetCredentials()
 	if err != nil && opts.datadogMonitorEnabled {
 		return setupErrorf(setupLog, err, "Unable to get credentials for DatadogMonitor")
 	}
+	if opts.datadogMonitorEnabled || opts.datadogSLOEnabled || opts.datadogDowntimeEnabled || opts.datadogSyntheticTestEnabled || opts.datadogDashboardEnabled {
+		// Reload the credentials periodically to pick up their rotation
+		if err = mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			credsManager.Watch(ctx, setupLog.WithName("credentials"), opts.credentialsRefreshPeriod)
+			return nil
+		})); err != nil {
+			return setupErrorf(setupLog, err, "Unable to watch the credentials")
+		}
+	}
 
 	options := controllers.SetupOptions{
 		SupportExtendedDaemonset: controllers.ExtendedDaemonsetOptions{
@@ -257,13 +299,19 @@ func run(opts *options) error {
 			CanaryAutoFailMaxRestarts:  opts.edsCanaryAutoFailMaxRestarts,
 			MaxPodSchedulerFailure:     opts.edsMaxPodSchedulerFailure,
 		},
-		SupportCilium:          opts.supportCilium,
-		Creds:                  creds,
-		DatadogAgentEnabled:    opts.datadogAgentEnabled,
-		DatadogMonitorEnabled:  opts.datadogMonitorEnabled,
-		DatadogSLOEnabled:      opts.datadogSLOEnabled,
-		OperatorMetricsEnabled: opts.operatorMetricsEnabled,
-		V2APIEnabled:           opts.v2APIEnabled,
+		SupportCilium:         opts.supportCilium,
+		CredsManager:          credsManager,
+		DatadogAgentEnabled:   opts.datadogAgentEnabled,
+		DatadogMonitorEnabled: opts.datadogMonitorEnabled,
+		DatadogSLOEnabled:     opts.datadogSLOEnabled,
+		DatadogSLOOptions: controllers.DatadogSLOOptions{
+			StateRefreshPeriod: opts.datadogSLOStateRefreshPeriod,
+		},
+		DatadogDowntimeEnabled:      opts.datadogDowntimeEnabled,
+		DatadogSyntheticTestEnabled: opts.datadogSyntheticTestEnabled,
+		DatadogDashboardEnabled:     opts.datadogDashboardEnabled,
+		OperatorMetricsEnabled:      opts.operatorMetricsEnabled,
+		V2APIEnabled:                opts.v2APIEnabled,
 	}
 
 	if err = controllers.SetupControllers(setupLog, mgr, options); err != nil {
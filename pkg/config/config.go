@@ -19,12 +19,22 @@ const (
 	// which specifies the Namespace to watch.
 	// An empty value means the operator is running with cluster scope.
 	WatchNamespaceEnvVar = "WATCH_NAMESPACE"
+	// PodNameEnvVar is the constant for env variable POD_NAME which is the name of the operator Pod.
+	PodNameEnvVar = "POD_NAME"
+	// PodNamespaceEnvVar is the constant for env variable POD_NAMESPACE which is the Namespace of the operator Pod.
+	PodNamespaceEnvVar = "POD_NAMESPACE"
 	// DDAPIKeyEnvVar is the constant for the env variable DD_API_KEY which is the fallback
 	// API key to use if a resource does not have it defined in its spec.
 	DDAPIKeyEnvVar = "DD_API_KEY"
 	// DDAppKeyEnvVar is the constant for the env variable DD_APP_KEY which is the fallback
 	// App key to use if a resource does not have it defined in its spec.
 	DDAppKeyEnvVar = "DD_APP_KEY"
+	// DDAPIKeyFileEnvVar is the constant for the env variable DD_API_KEY_FILE which is the path of a file
+	// containing the API key, for instance a mounted Secret. It takes precedence over DD_API_KEY.
+	DDAPIKeyFileEnvVar = "DD_API_KEY_FILE"
+	// DDAppKeyFileEnvVar is the constant for the env variable DD_APP_KEY_FILE which is the path of a file
+	// containing the App key, for instance a mounted Secret. It takes precedence over DD_APP_KEY.
+	DDAppKeyFileEnvVar = "DD_APP_KEY_FILE"
 	// DDURLEnvVar is the constant for the env variable DD_URL which is the
 	// host of the Datadog intake server to send data to.
 	DDURLEnvVar = "DD_URL"
@@ -6,15 +6,33 @@
 package config
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
+	apicommon "github.com/DataDog/datadog-operator/apis/datadoghq/common"
 	"github.com/DataDog/datadog-operator/pkg/secrets"
 
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultMinRefreshInterval is the minimum interval between two reloads of the credentials,
+	// to not overload the credentials source when several API calls are rejected at the same time.
+	defaultMinRefreshInterval = 10 * time.Second
+
+	credentialsRotatedReason = "CredentialsRotated"
 )
 
 // Creds holds the api and app keys.
@@ -23,12 +41,32 @@ type Creds struct {
 	AppKey string
 }
 
+// CredentialChangeCallback is called with the new credentials when they are rotated.
+type CredentialChangeCallback func(Creds)
+
 // CredentialManager provides the credentials from the operator configuration.
+// The credentials are read from the DD_API_KEY and DD_APP_KEY environment variables by default,
+// from the files referenced by DD_API_KEY_FILE and DD_APP_KEY_FILE if they are set,
+// or from a Kubernetes Secret configured with UseSecret.
 type CredentialManager struct {
 	secretBackend    secrets.Decryptor
 	creds            Creds
+	rawCreds         Creds
 	credsMutex       sync.Mutex
 	decryptorBackoff wait.Backoff
+
+	secretReader client.Reader
+	secretName   types.NamespacedName
+
+	recorder    record.EventRecorder
+	eventObject runtime.Object
+
+	callbacks      []CredentialChangeCallback
+	callbacksMutex sync.Mutex
+
+	refreshMutex       sync.Mutex
+	lastRefresh        time.Time
+	minRefreshInterval time.Duration
 }
 
 // NewCredentialManager returns a CredentialManager.
@@ -42,9 +80,32 @@ func NewCredentialManager() *CredentialManager {
 			Factor:   5.0,
 			Cap:      20 * time.Second,
 		},
+		minRefreshInterval: defaultMinRefreshInterval,
 	}
 }
 
+// UseSecret configures the CredentialManager to read the credentials from the `api_key` and `app_key`
+// keys of a Kubernetes Secret.
+func (cm *CredentialManager) UseSecret(reader client.Reader, name types.NamespacedName) {
+	cm.secretReader = reader
+	cm.secretName = name
+}
+
+// UseEventRecorder configures the CredentialManager to report the credentials rotations as events.
+// The events are recorded on the credentials Secret when UseSecret is used, on the given object
+// (typically the operator Pod) otherwise. The object can be nil to only report the rotations of the Secret.
+func (cm *CredentialManager) UseEventRecorder(recorder record.EventRecorder, object runtime.Object) {
+	cm.recorder = recorder
+	cm.eventObject = object
+}
+
+// RegisterCallback registers a function called when the credentials are rotated.
+func (cm *CredentialManager) RegisterCallback(callback CredentialChangeCallback) {
+	cm.callbacksMutex.Lock()
+	defer cm.callbacksMutex.Unlock()
+	cm.callbacks = append(cm.callbacks, callback)
+}
+
 // GetCredentials returns the API and APP keys respectively from the operator configurations.
 // This function tries to decrypt the secrets using the secret backend if needed.
 // It returns an error if the creds aren't configured or if the secret backend fails to decrypt.
@@ -53,13 +114,124 @@ func (cm *CredentialManager) GetCredentials() (Creds, error) {
 		return creds, nil
 	}
 
-	apiKey := os.Getenv(DDAPIKeyEnvVar)
-	appKey := os.Getenv(DDAppKeyEnvVar)
+	rawCreds, err := cm.readRawCredentials()
+	if err != nil {
+		return Creds{}, err
+	}
+
+	creds, err := cm.decryptCredentials(rawCreds)
+	if err != nil {
+		return Creds{}, err
+	}
+	cm.cacheCreds(creds)
+	cm.cacheRawCreds(rawCreds)
+
+	return creds, nil
+}
+
+// Refresh reloads the credentials from their source, and decrypts them again with the secret backend if needed.
+// It should be called when the Datadog API rejects the cached credentials.
+// It returns true if the credentials changed, in which case the registered callbacks are called.
+func (cm *CredentialManager) Refresh() (bool, error) {
+	return cm.refresh(false)
+}
+
+// Watch reloads the credentials every period until the context is done.
+// The secret backend is only called again when the credentials source changed.
+func (cm *CredentialManager) Watch(ctx context.Context, logger logr.Logger, period time.Duration) {
+	wait.UntilWithContext(ctx, func(context.Context) {
+		if _, err := cm.refresh(true); err != nil {
+			logger.Error(err, "Unable to reload the Datadog credentials")
+		}
+	}, period)
+}
+
+func (cm *CredentialManager) refresh(onlyIfSourceChanged bool) (bool, error) {
+	cm.refreshMutex.Lock()
+	defer cm.refreshMutex.Unlock()
+
+	if !onlyIfSourceChanged {
+		if time.Since(cm.lastRefresh) < cm.minRefreshInterval {
+			return false, nil
+		}
+		cm.lastRefresh = time.Now()
+	}
+
+	rawCreds, err := cm.readRawCredentials()
+	if err != nil {
+		return false, err
+	}
+	if onlyIfSourceChanged && rawCreds == cm.getRawCredsFromCache() {
+		return false, nil
+	}
 
-	if apiKey == "" || appKey == "" {
+	creds, err := cm.decryptCredentials(rawCreds)
+	if err != nil {
+		return false, err
+	}
+	cm.cacheRawCreds(rawCreds)
+
+	previous, found := cm.getCredsFromCache()
+	if found && previous == creds {
+		return false, nil
+	}
+	cm.cacheCreds(creds)
+	if !found {
+		// First load of the credentials, nothing was rotated
+		return false, nil
+	}
+	cm.notifyCredentialsRotated(creds)
+
+	return true, nil
+}
+
+// readRawCredentials reads the credentials from their source, they can be encrypted.
+func (cm *CredentialManager) readRawCredentials() (Creds, error) {
+	var creds Creds
+	if cm.secretReader != nil {
+		secret := &corev1.Secret{}
+		if err := cm.secretReader.Get(context.TODO(), cm.secretName, secret); err != nil {
+			return Creds{}, fmt.Errorf("unable to get the credentials Secret %s: %w", cm.secretName, err)
+		}
+		creds.APIKey = string(secret.Data[apicommon.DefaultAPIKeyKey])
+		creds.AppKey = string(secret.Data[apicommon.DefaultAPPKeyKey])
+	} else {
+		var err error
+		if creds.APIKey, err = readKey(DDAPIKeyEnvVar, DDAPIKeyFileEnvVar); err != nil {
+			return Creds{}, err
+		}
+		if creds.AppKey, err = readKey(DDAppKeyEnvVar, DDAppKeyFileEnvVar); err != nil {
+			return Creds{}, err
+		}
+	}
+
+	if creds.APIKey == "" || creds.AppKey == "" {
 		return Creds{}, errors.New("empty API key and/or App key")
 	}
 
+	return creds, nil
+}
+
+// readKey reads a key from the file referenced by the fileEnvVar environment variable if it is set,
+// from the envVar environment variable otherwise.
+func readKey(envVar, fileEnvVar string) (string, error) {
+	path := os.Getenv(fileEnvVar)
+	if path == "" {
+		return os.Getenv(envVar), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read %s: %w", fileEnvVar, err)
+	}
+
+	return strings.TrimSpace(string(data)), nil
+}
+
+// decryptCredentials decrypts the credentials with the secret backend if needed.
+func (cm *CredentialManager) decryptCredentials(rawCreds Creds) (Creds, error) {
+	apiKey, appKey := rawCreds.APIKey, rawCreds.AppKey
+
 	var encrypted []string
 	if secrets.IsEnc(apiKey) {
 		encrypted = append(encrypted, apiKey)
@@ -89,10 +261,34 @@ func (cm *CredentialManager) GetCredentials() (Creds, error) {
 		}
 	}
 
-	creds := Creds{APIKey: apiKey, AppKey: appKey}
-	cm.cacheCreds(creds)
+	return Creds{APIKey: apiKey, AppKey: appKey}, nil
+}
 
-	return creds, nil
+func (cm *CredentialManager) notifyCredentialsRotated(creds Creds) {
+	if cm.recorder != nil {
+		object, source := cm.eventObject, "the environment"
+		switch {
+		case cm.secretReader != nil:
+			secret := &corev1.Secret{}
+			secret.Namespace = cm.secretName.Namespace
+			secret.Name = cm.secretName.Name
+			object, source = secret, "the Secret"
+		case os.Getenv(DDAPIKeyFileEnvVar) != "" || os.Getenv(DDAppKeyFileEnvVar) != "":
+			source = "the files"
+		}
+		if object != nil {
+			cm.recorder.Event(object, corev1.EventTypeNormal, credentialsRotatedReason, fmt.Sprintf("Datadog API and App keys reloaded from %s", source))
+		}
+	}
+
+	cm.callbacksMutex.Lock()
+	callbacks := make([]CredentialChangeCallback, len(cm.callbacks))
+	copy(callbacks, cm.callbacks)
+	cm.callbacksMutex.Unlock()
+
+	for _, callback := range callbacks {
+		callback(creds)
+	}
 }
 
 func (cm *CredentialManager) cacheCreds(creds Creds) {
@@ -110,3 +306,15 @@ func (cm *CredentialManager) getCredsFromCache() (Creds, bool) {
 
 	return Creds{}, false
 }
+
+func (cm *CredentialManager) cacheRawCreds(rawCreds Creds) {
+	cm.credsMutex.Lock()
+	defer cm.credsMutex.Unlock()
+	cm.rawCreds = rawCreds
+}
+
+func (cm *CredentialManager) getRawCredsFromCache() Creds {
+	cm.credsMutex.Lock()
+	defer cm.credsMutex.Unlock()
+	return cm.rawCreds
+}
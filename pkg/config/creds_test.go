@@ -6,12 +6,22 @@
 package config
 
 import (
+	"context"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/DataDog/datadog-operator/pkg/secrets"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func Test_getCredentials(t *testing.T) {
@@ -213,3 +223,147 @@ func Test_getCredentials(t *testing.T) {
 		})
 	}
 }
+
+func Test_getCredentialsFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	apiKeyFile := filepath.Join(dir, "api_key")
+	appKeyFile := filepath.Join(dir, "app_key")
+	assert.NoError(t, os.WriteFile(apiKeyFile, []byte("foo\n"), 0o600))
+	assert.NoError(t, os.WriteFile(appKeyFile, []byte("bar"), 0o600))
+
+	t.Setenv(DDAPIKeyEnvVar, "ignored")
+	t.Setenv(DDAPIKeyFileEnvVar, apiKeyFile)
+	t.Setenv(DDAppKeyFileEnvVar, appKeyFile)
+
+	credsManager := NewCredentialManager()
+	got, err := credsManager.GetCredentials()
+	assert.NoError(t, err)
+	assert.EqualValues(t, Creds{APIKey: "foo", AppKey: "bar"}, got)
+}
+
+func Test_refreshCredentials(t *testing.T) {
+	t.Setenv(DDAPIKeyEnvVar, "foo")
+	t.Setenv(DDAppKeyEnvVar, "bar")
+
+	credsManager := NewCredentialManager()
+	credsManager.minRefreshInterval = 0
+	var rotated []Creds
+	credsManager.RegisterCallback(func(creds Creds) {
+		rotated = append(rotated, creds)
+	})
+
+	_, err := credsManager.GetCredentials()
+	assert.NoError(t, err)
+
+	// Source unchanged
+	changed, err := credsManager.refresh(true)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+
+	// Source rotated
+	t.Setenv(DDAPIKeyEnvVar, "baz")
+	changed, err = credsManager.refresh(true)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	got, err := credsManager.GetCredentials()
+	assert.NoError(t, err)
+	assert.EqualValues(t, Creds{APIKey: "baz", AppKey: "bar"}, got)
+	assert.Equal(t, []Creds{{APIKey: "baz", AppKey: "bar"}}, rotated)
+
+	// Forced refresh, rate limited
+	credsManager.minRefreshInterval = time.Hour
+	t.Setenv(DDAPIKeyEnvVar, "qux")
+	changed, err = credsManager.Refresh()
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	changed, err = credsManager.Refresh()
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Len(t, rotated, 2)
+}
+
+func Test_credentialsRotatedEvent(t *testing.T) {
+	operatorPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "datadog-operator", Namespace: "datadog"}}
+	secretName := types.NamespacedName{Namespace: "datadog", Name: "datadog-credentials"}
+
+	tests := []struct {
+		name        string
+		setupFunc   func(*testing.T, *CredentialManager) (rotate func())
+		eventObject runtime.Object
+		wantEvent   string
+	}{
+		{
+			name: "environment",
+			setupFunc: func(t *testing.T, cm *CredentialManager) func() {
+				t.Setenv(DDAPIKeyEnvVar, "foo")
+				t.Setenv(DDAppKeyEnvVar, "bar")
+				return func() { t.Setenv(DDAPIKeyEnvVar, "baz") }
+			},
+			eventObject: operatorPod,
+			wantEvent:   "Normal CredentialsRotated Datadog API and App keys reloaded from the environment",
+		},
+		{
+			name: "environment, unknown operator Pod",
+			setupFunc: func(t *testing.T, cm *CredentialManager) func() {
+				t.Setenv(DDAPIKeyEnvVar, "foo")
+				t.Setenv(DDAppKeyEnvVar, "bar")
+				return func() { t.Setenv(DDAPIKeyEnvVar, "baz") }
+			},
+		},
+		{
+			name: "files",
+			setupFunc: func(t *testing.T, cm *CredentialManager) func() {
+				dir := t.TempDir()
+				apiKeyFile := filepath.Join(dir, "api_key")
+				appKeyFile := filepath.Join(dir, "app_key")
+				require.NoError(t, os.WriteFile(apiKeyFile, []byte("foo"), 0o600))
+				require.NoError(t, os.WriteFile(appKeyFile, []byte("bar"), 0o600))
+				t.Setenv(DDAPIKeyFileEnvVar, apiKeyFile)
+				t.Setenv(DDAppKeyFileEnvVar, appKeyFile)
+				return func() { require.NoError(t, os.WriteFile(apiKeyFile, []byte("baz"), 0o600)) }
+			},
+			eventObject: operatorPod,
+			wantEvent:   "Normal CredentialsRotated Datadog API and App keys reloaded from the files",
+		},
+		{
+			name: "Secret",
+			setupFunc: func(t *testing.T, cm *CredentialManager) func() {
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: secretName.Namespace, Name: secretName.Name},
+					Data:       map[string][]byte{"api_key": []byte("foo"), "app_key": []byte("bar")},
+				}
+				c := fake.NewClientBuilder().WithObjects(secret).Build()
+				cm.UseSecret(c, secretName)
+				return func() {
+					secret.Data["api_key"] = []byte("baz")
+					require.NoError(t, c.Update(context.TODO(), secret))
+				}
+			},
+			eventObject: operatorPod,
+			wantEvent:   "Normal CredentialsRotated Datadog API and App keys reloaded from the Secret",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			credsManager := NewCredentialManager()
+			recorder := record.NewFakeRecorder(1)
+			credsManager.UseEventRecorder(recorder, tt.eventObject)
+			rotate := tt.setupFunc(t, credsManager)
+
+			_, err := credsManager.GetCredentials()
+			require.NoError(t, err)
+			rotate()
+			changed, err := credsManager.refresh(true)
+			require.NoError(t, err)
+			assert.True(t, changed)
+
+			select {
+			case event := <-recorder.Events:
+				assert.Equal(t, tt.wantEvent, event)
+			default:
+				assert.Empty(t, tt.wantEvent, "No event recorded")
+			}
+		})
+	}
+}
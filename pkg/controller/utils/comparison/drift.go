@@ -0,0 +1,148 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package comparison
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// floatTolerance is the relative tolerance used to compare numbers, which may have been rounded by the API
+const floatTolerance = 1e-9
+
+// FindDrift compares the JSON representations of desired and actual, and returns the sorted paths of the
+// fields set in desired whose value differs in actual.
+// Fields only set in actual are ignored, as they are usually defaults set by the API, and zero values are
+// considered equal to missing fields. Lists of scalar values are compared regardless of their order.
+func FindDrift(desired, actual interface{}) ([]string, error) {
+	desiredValue, err := toJSONValue(desired)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the desired object: %w", err)
+	}
+	actualValue, err := toJSONValue(actual)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the actual object: %w", err)
+	}
+
+	drift := []string{}
+	findDrift("", desiredValue, actualValue, &drift)
+	sort.Strings(drift)
+
+	return drift, nil
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err = json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func findDrift(path string, desired, actual interface{}, drift *[]string) {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		a, _ := actual.(map[string]interface{})
+		for key, value := range d {
+			findDrift(joinPath(path, key), value, a[key], drift)
+		}
+	case []interface{}:
+		a, _ := actual.([]interface{})
+		if isScalarList(d) && isScalarList(a) {
+			if !equalScalarLists(d, a) {
+				*drift = append(*drift, path)
+			}
+			return
+		}
+		if len(d) != len(a) {
+			*drift = append(*drift, path)
+			return
+		}
+		for i := range d {
+			findDrift(fmt.Sprintf("%s[%d]", path, i), d[i], a[i], drift)
+		}
+	default:
+		if !equalScalars(desired, actual) {
+			*drift = append(*drift, path)
+		}
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func isScalarList(list []interface{}) bool {
+	for _, value := range list {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return false
+		}
+	}
+	return true
+}
+
+func equalScalarLists(desired, actual []interface{}) bool {
+	if len(desired) != len(actual) {
+		return false
+	}
+
+	d := make([]string, 0, len(desired))
+	for _, value := range desired {
+		d = append(d, fmt.Sprint(value))
+	}
+	a := make([]string, 0, len(actual))
+	for _, value := range actual {
+		a = append(a, fmt.Sprint(value))
+	}
+	sort.Strings(d)
+	sort.Strings(a)
+
+	return reflect.DeepEqual(d, a)
+}
+
+func equalScalars(desired, actual interface{}) bool {
+	if isZero(desired) && isZero(actual) {
+		return true
+	}
+
+	d, desiredIsFloat := desired.(float64)
+	a, actualIsFloat := actual.(float64)
+	if desiredIsFloat && actualIsFloat {
+		return math.Abs(d-a) <= floatTolerance*math.Max(math.Abs(d), math.Abs(a))
+	}
+
+	return reflect.DeepEqual(desired, actual)
+}
+
+func isZero(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
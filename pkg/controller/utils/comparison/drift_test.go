@@ -0,0 +1,92 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package comparison
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDrift(t *testing.T) {
+	type threshold struct {
+		Timeframe string  `json:"timeframe"`
+		Target    float64 `json:"target"`
+		Display   string  `json:"target_display,omitempty"`
+	}
+	type object struct {
+		Name       string                 `json:"name"`
+		Priority   *int64                 `json:"priority"`
+		Tags       []string               `json:"tags,omitempty"`
+		Options    map[string]interface{} `json:"options,omitempty"`
+		Thresholds []threshold            `json:"thresholds,omitempty"`
+	}
+	priority := int64(0)
+
+	tests := []struct {
+		name    string
+		desired object
+		actual  object
+		want    []string
+	}{
+		{
+			name:    "same object",
+			desired: object{Name: "foo", Tags: []string{"a", "b"}, Options: map[string]interface{}{"timeout_h": 1}},
+			actual:  object{Name: "foo", Tags: []string{"a", "b"}, Options: map[string]interface{}{"timeout_h": 1}},
+			want:    []string{},
+		},
+		{
+			name:    "fields only set in actual are ignored",
+			desired: object{Name: "foo"},
+			actual:  object{Name: "foo", Tags: []string{"a"}, Options: map[string]interface{}{"notify_audit": false}},
+			want:    []string{},
+		},
+		{
+			name:    "zero values are equal to missing fields",
+			desired: object{Name: "foo", Priority: &priority},
+			actual:  object{Name: "foo"},
+			want:    []string{},
+		},
+		{
+			name:    "scalar lists are compared regardless of their order",
+			desired: object{Name: "foo", Tags: []string{"a", "b"}},
+			actual:  object{Name: "foo", Tags: []string{"b", "a"}},
+			want:    []string{},
+		},
+		{
+			name:    "numbers are compared with a tolerance",
+			desired: object{Name: "foo", Thresholds: []threshold{{Timeframe: "7d", Target: 99.9}}},
+			actual:  object{Name: "foo", Thresholds: []threshold{{Timeframe: "7d", Target: 99.90000000000001, Display: "99.9"}}},
+			want:    []string{},
+		},
+		{
+			name:    "drifted fields",
+			desired: object{Name: "foo", Tags: []string{"a", "b"}, Options: map[string]interface{}{"timeout_h": 1, "thresholds": map[string]interface{}{"critical": 90}}},
+			actual:  object{Name: "bar", Tags: []string{"a"}, Options: map[string]interface{}{"timeout_h": 1, "thresholds": map[string]interface{}{"critical": 95}}},
+			want:    []string{"name", "options.thresholds.critical", "tags"},
+		},
+		{
+			name:    "drifted list of objects",
+			desired: object{Name: "foo", Thresholds: []threshold{{Timeframe: "7d", Target: 99.9}, {Timeframe: "30d", Target: 99}}},
+			actual:  object{Name: "foo", Thresholds: []threshold{{Timeframe: "7d", Target: 99.5}, {Timeframe: "30d", Target: 99}}},
+			want:    []string{"thresholds[0].target"},
+		},
+		{
+			name:    "list of objects with a different length",
+			desired: object{Name: "foo", Thresholds: []threshold{{Timeframe: "7d", Target: 99.9}, {Timeframe: "30d", Target: 99}}},
+			actual:  object{Name: "foo", Thresholds: []threshold{{Timeframe: "7d", Target: 99.9}}},
+			want:    []string{"thresholds"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FindDrift(tt.desired, tt.actual)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -21,6 +21,10 @@ const (
 	DatadogConditionTypeUpdated Type = "Updated"
 	// DatadogConditionTypeError means the  Datadog CRD has error
 	DatadogConditionTypeError Type = "Error"
+	// DatadogConditionTypeErrorBudgetExhausted means the error budget of the Datadog SLO is exhausted
+	DatadogConditionTypeErrorBudgetExhausted Type = "ErrorBudgetExhausted"
+	// DatadogConditionTypeDrifted means the Datadog object differs from the Datadog CRD spec
+	DatadogConditionTypeDrifted Type = "Drifted"
 )
 
 // UpdateFailureStatusConditions is a generic method to update the failure StatusConditions.
@@ -0,0 +1,113 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package datadogclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/go-logr/logr"
+
+	datadogapi "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/DataDog/datadog-operator/pkg/config"
+)
+
+// credentialsContext is an authentication context whose API and App keys can be updated in place,
+// so that the API clients keep working after a rotation of the credentials.
+type credentialsContext struct {
+	context.Context
+	mutex sync.RWMutex
+	keys  map[string]datadogapi.APIKey
+}
+
+func newCredentialsContext(parent context.Context, creds config.Creds) *credentialsContext {
+	ctx := &credentialsContext{Context: parent}
+	ctx.update(creds)
+	return ctx
+}
+
+// Value returns the current API and App keys for the datadogapi.ContextAPIKeys key,
+// and delegates to the parent context for the other keys.
+func (c *credentialsContext) Value(key interface{}) interface{} {
+	if key == datadogapi.ContextAPIKeys {
+		c.mutex.RLock()
+		defer c.mutex.RUnlock()
+		return c.keys
+	}
+	return c.Context.Value(key)
+}
+
+func (c *credentialsContext) update(creds config.Creds) {
+	keys := map[string]datadogapi.APIKey{
+		"apiKeyAuth": {
+			Key: creds.APIKey,
+		},
+		"appKeyAuth": {
+			Key: creds.AppKey,
+		},
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.keys = keys
+}
+
+// forbiddenRoundTripper reloads the credentials when the Datadog API rejects them,
+// the next requests are sent with the new credentials if they were rotated.
+type forbiddenRoundTripper struct {
+	next         http.RoundTripper
+	logger       logr.Logger
+	credsManager *config.CredentialManager
+}
+
+func (rt *forbiddenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusForbidden {
+		return resp, err
+	}
+
+	changed, refreshErr := rt.credsManager.Refresh()
+	switch {
+	case refreshErr != nil:
+		rt.logger.Error(refreshErr, "Datadog API returned 403, unable to reload the credentials")
+	case changed:
+		rt.logger.Info("Datadog API returned 403, credentials reloaded")
+	}
+
+	return resp, err
+}
+
+// newAPIClient returns a Datadog API client and its authentication context.
+// The authentication context is updated when the credentials managed by credsManager are rotated.
+func newAPIClient(logger logr.Logger, credsManager *config.CredentialManager) (*datadogapi.APIClient, context.Context, error) {
+	creds, err := credsManager.GetCredentials()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error obtaining API key and/or app key: %w", err)
+	}
+
+	auth, err := setupAuth(logger, creds)
+	if err != nil {
+		return nil, nil, err
+	}
+	credsCtx := newCredentialsContext(auth, creds)
+	credsManager.RegisterCallback(func(newCreds config.Creds) {
+		credsCtx.update(newCreds)
+		logger.Info("Datadog API client credentials rotated")
+	})
+
+	configV1 := datadogapi.NewConfiguration()
+	configV1.HTTPClient = &http.Client{
+		Transport: &forbiddenRoundTripper{
+			next:         http.DefaultTransport,
+			logger:       logger,
+			credsManager: credsManager,
+		},
+	}
+
+	return datadogapi.NewAPIClient(configV1), credsCtx, nil
+}
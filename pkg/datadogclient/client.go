@@ -7,7 +7,6 @@ package datadogclient
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -31,19 +30,13 @@ type DatadogMonitorClient struct {
 }
 
 // InitDatadogMonitorClient initializes the Datadog Monitor API Client and establishes credentials.
-func InitDatadogMonitorClient(logger logr.Logger, creds config.Creds) (DatadogMonitorClient, error) {
-	if creds.APIKey == "" || creds.AppKey == "" {
-		return DatadogMonitorClient{}, errors.New("error obtaining API key and/or app key")
-	}
-
-	configV1 := datadogapi.NewConfiguration()
-	apiClient := datadogapi.NewAPIClient(configV1)
-	client := datadogV1.NewMonitorsApi(apiClient)
-
-	authV1, err := setupAuth(logger, creds)
+// The credentials of the client are updated when they are rotated in the CredentialManager.
+func InitDatadogMonitorClient(logger logr.Logger, credsManager *config.CredentialManager) (DatadogMonitorClient, error) {
+	apiClient, authV1, err := newAPIClient(logger, credsManager)
 	if err != nil {
 		return DatadogMonitorClient{}, err
 	}
+	client := datadogV1.NewMonitorsApi(apiClient)
 
 	return DatadogMonitorClient{Client: client, Auth: authV1}, nil
 }
@@ -55,21 +48,65 @@ type DatadogSLOClient struct {
 }
 
 // InitDatadogSLOClient initializes the Datadog SLO API Client and establishes credentials.
-func InitDatadogSLOClient(logger logr.Logger, creds config.Creds) (DatadogSLOClient, error) {
-	if creds.APIKey == "" || creds.AppKey == "" {
-		return DatadogSLOClient{}, errors.New("error obtaining API key and/or app key")
+func InitDatadogSLOClient(logger logr.Logger, credsManager *config.CredentialManager) (DatadogSLOClient, error) {
+	apiClient, authV1, err := newAPIClient(logger, credsManager)
+	if err != nil {
+		return DatadogSLOClient{}, err
 	}
-
-	configV1 := datadogapi.NewConfiguration()
-	apiClient := datadogapi.NewAPIClient(configV1)
 	client := datadogV1.NewServiceLevelObjectivesApi(apiClient)
 
-	authV1, err := setupAuth(logger, creds)
+	return DatadogSLOClient{Client: client, Auth: authV1}, nil
+}
+
+// DatadogDowntimeClient contains the Datadog Downtime API Client and Authentication context.
+type DatadogDowntimeClient struct {
+	Client *datadogV1.DowntimesApi
+	Auth   context.Context
+}
+
+// InitDatadogDowntimeClient initializes the Datadog Downtime API Client and establishes credentials.
+func InitDatadogDowntimeClient(logger logr.Logger, credsManager *config.CredentialManager) (DatadogDowntimeClient, error) {
+	apiClient, authV1, err := newAPIClient(logger, credsManager)
 	if err != nil {
-		return DatadogSLOClient{}, err
+		return DatadogDowntimeClient{}, err
 	}
+	client := datadogV1.NewDowntimesApi(apiClient)
 
-	return DatadogSLOClient{Client: client, Auth: authV1}, nil
+	return DatadogDowntimeClient{Client: client, Auth: authV1}, nil
+}
+
+// DatadogSyntheticsClient contains the Datadog Synthetics API Client and Authentication context.
+type DatadogSyntheticsClient struct {
+	Client *datadogV1.SyntheticsApi
+	Auth   context.Context
+}
+
+// InitDatadogSyntheticsClient initializes the Datadog Synthetics API Client and establishes credentials.
+func InitDatadogSyntheticsClient(logger logr.Logger, credsManager *config.CredentialManager) (DatadogSyntheticsClient, error) {
+	apiClient, authV1, err := newAPIClient(logger, credsManager)
+	if err != nil {
+		return DatadogSyntheticsClient{}, err
+	}
+	client := datadogV1.NewSyntheticsApi(apiClient)
+
+	return DatadogSyntheticsClient{Client: client, Auth: authV1}, nil
+}
+
+// DatadogDashboardClient contains the Datadog Dashboards API Client and Authentication context.
+type DatadogDashboardClient struct {
+	Client *datadogV1.DashboardsApi
+	Auth   context.Context
+}
+
+// InitDatadogDashboardClient initializes the Datadog Dashboards API Client and establishes credentials.
+func InitDatadogDashboardClient(logger logr.Logger, credsManager *config.CredentialManager) (DatadogDashboardClient, error) {
+	apiClient, authV1, err := newAPIClient(logger, credsManager)
+	if err != nil {
+		return DatadogDashboardClient{}, err
+	}
+	client := datadogV1.NewDashboardsApi(apiClient)
+
+	return DatadogDashboardClient{Client: client, Auth: authV1}, nil
 }
 
 func setupAuth(logger logr.Logger, creds config.Creds) (context.Context, error) {
@@ -7,6 +7,8 @@ package equality
 
 import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
@@ -51,6 +53,8 @@ func IsEqualObject(kind kubernetes.ObjectKind, a, b client.Object) bool {
 		return IsEqualServiceAccounts(a, b)
 	case kubernetes.PodDisruptionBudgetsKind:
 		return IsEqualPodDisruptionBudgets(a, b)
+	case kubernetes.HorizontalPodAutoscalersKind:
+		return IsEqualHorizontalPodAutoscalers(a, b)
 	case kubernetes.NetworkPoliciesKind:
 		return IsEqualNetworkPolicies(a, b)
 	case kubernetes.PodSecurityPoliciesKind:
@@ -192,6 +196,24 @@ func IsEqualPodDisruptionBudgets(objA, objB client.Object) bool {
 	return false
 }
 
+// IsEqualHorizontalPodAutoscalers return true if the two HorizontalPodAutoscalers are equal
+func IsEqualHorizontalPodAutoscalers(objA, objB client.Object) bool {
+	a, okA := objA.(*autoscalingv2.HorizontalPodAutoscaler)
+	b, okB := objB.(*autoscalingv2.HorizontalPodAutoscaler)
+
+	if okA && okB && a != nil && b != nil {
+		return apiequality.Semantic.DeepEqual(a.Spec, b.Spec)
+	} else {
+		ax, okA := objA.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+		bx, okB := objB.(*autoscalingv2beta2.HorizontalPodAutoscaler)
+		if okA && okB && ax != nil && bx != nil {
+			return apiequality.Semantic.DeepEqual(ax.Spec, bx.Spec)
+		}
+	}
+
+	return false
+}
+
 // IsEqualNetworkPolicies return true if the two NetworkPolicies are equal
 func IsEqualNetworkPolicies(objA, objB client.Object) bool {
 	a, okA := objA.(*networkingv1.NetworkPolicy)
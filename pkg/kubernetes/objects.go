@@ -43,6 +43,8 @@ func ObjectFromKind(kind ObjectKind, platformInfo PlatformInfo) client.Object {
 		return &corev1.ServiceAccount{}
 	case PodDisruptionBudgetsKind:
 		return platformInfo.CreatePDBObject()
+	case HorizontalPodAutoscalersKind:
+		return platformInfo.CreateHPAObject()
 	case NetworkPoliciesKind:
 		return &networkingv1.NetworkPolicy{}
 	case PodSecurityPoliciesKind:
@@ -42,6 +42,8 @@ func ObjectListFromKind(kind ObjectKind, platformInfo PlatformInfo) client.Objec
 		return &corev1.ServiceAccountList{}
 	case PodDisruptionBudgetsKind:
 		return platformInfo.CreatePDBObjectList()
+	case HorizontalPodAutoscalersKind:
+		return platformInfo.CreateHPAObjectList()
 	case NetworkPoliciesKind:
 		return &networkingv1.NetworkPolicyList{}
 	case PodSecurityPoliciesKind:
@@ -1,6 +1,10 @@
 package kubernetes
 
 import (
+	"strings"
+
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	autoscalingv2beta2 "k8s.io/api/autoscaling/v2beta2"
 	policyv1 "k8s.io/api/policy/v1"
 	policyv1beta1 "k8s.io/api/policy/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -8,6 +12,22 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ControlPlaneProvider is the kind of control plane of a cluster
+type ControlPlaneProvider string
+
+const (
+	// DefaultControlPlaneProvider is a control plane running as static pods on the control plane nodes, like kubeadm-based distributions.
+	DefaultControlPlaneProvider ControlPlaneProvider = "default"
+	// OpenShiftControlPlaneProvider is the control plane of an OpenShift cluster.
+	OpenShiftControlPlaneProvider ControlPlaneProvider = "openshift"
+	// ManagedControlPlaneProvider is a control plane managed by a cloud provider, only the API server is exposed.
+	ManagedControlPlaneProvider ControlPlaneProvider = "managed"
+)
+
+// managedControlPlaneVersionMarkers are the markers of the managed control planes in the version of the server:
+// EKS (`v1.24.10-eks-48e63af`), GKE (`v1.25.7-gke.1000`), IKS (`v1.26.4+IKS`) and ACK (`v1.26.3-aliyun.1`).
+var managedControlPlaneVersionMarkers = []string{"-eks-", "-gke.", "+IKS", "-aliyun."}
+
 type PlatformInfo struct {
 	versionInfo          *version.Info
 	apiPreferredVersions map[string]string
@@ -88,6 +108,28 @@ func (platformInfo *PlatformInfo) CreatePDBObjectList() client.ObjectList {
 	}
 }
 
+func (platformInfo *PlatformInfo) UseV2Beta2HPA() bool {
+	preferredVersion := platformInfo.apiPreferredVersions["HorizontalPodAutoscaler"]
+
+	// autoscaling/v2 is the preferred version from Kubernetes 1.23; before, autoscaling/v1 is preferred and
+	// autoscaling/v2beta2 is the most recent version supporting custom metrics.
+	return preferredVersion != "" && preferredVersion != "autoscaling/v2"
+}
+
+func (platformInfo *PlatformInfo) CreateHPAObject() client.Object {
+	if platformInfo.UseV2Beta2HPA() {
+		return &autoscalingv2beta2.HorizontalPodAutoscaler{}
+	}
+	return &autoscalingv2.HorizontalPodAutoscaler{}
+}
+
+func (platformInfo *PlatformInfo) CreateHPAObjectList() client.ObjectList {
+	if platformInfo.UseV2Beta2HPA() {
+		return &autoscalingv2beta2.HorizontalPodAutoscalerList{}
+	}
+	return &autoscalingv2.HorizontalPodAutoscalerList{}
+}
+
 func (platformInfo *PlatformInfo) GetAgentResourcesKind(withCiliumResources bool) []ObjectKind {
 	return getResourcesKind(withCiliumResources, platformInfo.supportsPSP())
 }
@@ -120,3 +162,23 @@ func (platformInfo *PlatformInfo) GetApiVersions(name string) (preferred string,
 	other = platformInfo.apiOtherVersions[name]
 	return preferred, other
 }
+
+// GetControlPlaneProvider returns the kind of control plane of the cluster.
+// OpenShift is detected with its ClusterVersion resource, EKS, GKE, IKS and ACK managed control planes with the version of the server.
+// The version of the server of other managed control planes, like AKS, doesn't identify them.
+func (platformInfo *PlatformInfo) GetControlPlaneProvider() ControlPlaneProvider {
+	if preferred, _ := platformInfo.GetApiVersions("ClusterVersion"); strings.HasPrefix(preferred, "config.openshift.io/") {
+		return OpenShiftControlPlaneProvider
+	}
+
+	if platformInfo.versionInfo != nil {
+		gitVersion := platformInfo.versionInfo.GitVersion
+		for _, managedVersionMarker := range managedControlPlaneVersionMarkers {
+			if strings.Contains(gitVersion, managedVersionMarker) {
+				return ManagedControlPlaneProvider
+			}
+		}
+	}
+
+	return DefaultControlPlaneProvider
+}
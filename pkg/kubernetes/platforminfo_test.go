@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
 )
 
 func Test_createPlatformInfoFromAPIObjects(t *testing.T) {
@@ -141,6 +142,41 @@ func Test_getPDBFlag(t *testing.T) {
 	}
 }
 
+func Test_getHPAFlag(t *testing.T) {
+	tests := []struct {
+		name          string
+		preferred     map[string]string
+		useV2Beta2HPA bool
+	}{
+		{
+			name: "autoscaling/v2 preferred",
+			preferred: map[string]string{
+				"HorizontalPodAutoscaler": "autoscaling/v2",
+			},
+			useV2Beta2HPA: false,
+		},
+		{
+			name: "autoscaling/v1 preferred, before autoscaling/v2 is available",
+			preferred: map[string]string{
+				"HorizontalPodAutoscaler": "autoscaling/v1",
+			},
+			useV2Beta2HPA: true,
+		},
+		{
+			name:          "Unknown preferred version, defaults to v2",
+			preferred:     map[string]string{},
+			useV2Beta2HPA: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			platformInfo := NewPlatformInfoFromVersionMaps(nil, tt.preferred, map[string]string{})
+			assert.Equal(t, tt.useV2Beta2HPA, platformInfo.UseV2Beta2HPA())
+		})
+	}
+}
+
 func Test_getDatadogAgentVersions(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -305,3 +341,64 @@ func containsObjectKind(list []ObjectKind, s ObjectKind) bool {
 	}
 	return false
 }
+
+func Test_getControlPlaneProvider(t *testing.T) {
+	tests := []struct {
+		name       string
+		gitVersion string
+		preferred  map[string]string
+		want       ControlPlaneProvider
+	}{
+		{
+			name:       "OpenShift",
+			gitVersion: "v1.25.4+77bec7a",
+			preferred: map[string]string{
+				"ClusterVersion": "config.openshift.io/v1",
+			},
+			want: OpenShiftControlPlaneProvider,
+		},
+		{
+			name:       "EKS",
+			gitVersion: "v1.24.10-eks-48e63af",
+			preferred:  map[string]string{},
+			want:       ManagedControlPlaneProvider,
+		},
+		{
+			name:       "GKE",
+			gitVersion: "v1.25.7-gke.1000",
+			preferred:  map[string]string{},
+			want:       ManagedControlPlaneProvider,
+		},
+		{
+			name:       "IKS",
+			gitVersion: "v1.26.4+IKS",
+			preferred:  map[string]string{},
+			want:       ManagedControlPlaneProvider,
+		},
+		{
+			name:       "ACK",
+			gitVersion: "v1.26.3-aliyun.1",
+			preferred:  map[string]string{},
+			want:       ManagedControlPlaneProvider,
+		},
+		{
+			name:       "AKS",
+			gitVersion: "v1.27.3",
+			preferred:  map[string]string{},
+			want:       DefaultControlPlaneProvider,
+		},
+		{
+			name:       "kubeadm",
+			gitVersion: "v1.26.3",
+			preferred:  map[string]string{},
+			want:       DefaultControlPlaneProvider,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			platformInfo := NewPlatformInfoFromVersionMaps(&version.Info{GitVersion: tt.gitVersion}, tt.preferred, map[string]string{})
+			assert.Equal(t, tt.want, platformInfo.GetControlPlaneProvider())
+		})
+	}
+}
@@ -95,6 +95,7 @@ const (
 	UpdateVerb = "update"
 	CreateVerb = "create"
 	DeleteVerb = "delete"
+	PatchVerb  = "patch"
 
 	// Rbac resource kinds
 